@@ -1,48 +1,321 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
+	"github.com/vestamart/cart/internal/app/abandoned"
 	"github.com/vestamart/cart/internal/app/cart"
+	"github.com/vestamart/cart/internal/app/wishlist"
+	"github.com/vestamart/cart/internal/auth"
 	"github.com/vestamart/cart/internal/client"
 	"github.com/vestamart/cart/internal/config"
+	"github.com/vestamart/cart/internal/currency"
 	"github.com/vestamart/cart/internal/delivery"
+	"github.com/vestamart/cart/internal/domain"
+	"github.com/vestamart/cart/internal/events"
+	"github.com/vestamart/cart/internal/health"
+	"github.com/vestamart/cart/internal/logger"
 	"github.com/vestamart/cart/internal/mw"
+	"github.com/vestamart/cart/internal/outbox"
+	"github.com/vestamart/cart/internal/promotion"
 	"github.com/vestamart/cart/internal/repository"
+	"github.com/vestamart/cart/internal/webhook"
+	desc "github.com/vestamart/cart/pkg/api/cart/v1"
 	"github.com/vestamart/loms/pkg/api/loms/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"log"
+	"log/slog"
+	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+const (
+	readinessCacheTTL = 2 * time.Second
+	readinessTimeout  = time.Second
+	shutdownDrain     = 5 * time.Second
+	shutdownTimeout   = 10 * time.Second
+	configPollPeriod  = 5 * time.Second
 )
 
 func main() {
-	log.Println("App started")
+	configPath := flag.String("config", "config.yaml", "path to the config file")
+	flag.Parse()
 
-	cfg, err := config.LoadConfig("config.yaml")
+	slog.Info("App started")
+
+	cfg, err := config.LoadConfig(*configPath)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	clientProduct := client.NewClient(cfg.ProductClient.URL, cfg.ProductClient.Token)
+	if err = logger.Init(cfg.Log.Level); err != nil {
+		log.Fatal(err)
+	}
+
+	deadlines := client.NewDeadlines(clientTimeouts(cfg.Timeouts))
+	clientProduct := client.NewClient(cfg.ProductClient.URL, cfg.ProductClient.Token, cfg.ProductClient.RPS, deadlines)
 
-	connLOMS, err := grpc.NewClient("loms-service:"+cfg.LOMSServer.Port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	connLOMS, err := grpc.NewClient(cfg.LOMSClient.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
 		panic(err)
 	}
 	defer connLOMS.Close()
 
-	lomsClient := loms.NewLomsClient(connLOMS)
+	lomsClient := client.NewLOMSClient(loms.NewLomsClient(connLOMS), deadlines)
+
+	checker := health.NewChecker(readinessCacheTTL, readinessTimeout)
+	checker.Register("loms", health.GRPC(connLOMS, ""))
+	checker.Register("product", clientProduct.Ping)
 
 	repo := repository.NewRepository(100)
-	service := cart.NewCartService(repo, clientProduct, lomsClient)
-	server := delivery.NewServer(*service)
+	repo.SetMaxLists(cfg.Lists.MaxPerUser)
+	relay, closeSinks, err := newRelay(cfg.Outbox, repo)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer closeSinks()
+	guests := repository.NewGuestRepository(cfg.Guest.TTL, cfg.Guest.MaxCarts)
+	service := cart.NewCartService(repo, clientProduct, lomsClient).
+		WithGuestCarts(guests, domain.MergePolicy(cfg.Guest.MergePolicy)).
+		WithCheckoutPreview([]byte(cfg.Checkout.PreviewSecret), cfg.Checkout.PreviewTTL, cfg.Checkout.RequirePreview)
+	service.SetStockCheck(cfg.Features.StockCheck)
+	if cfg.Promotions.File != "" {
+		promotions, err := promotion.Load(cfg.Promotions.File)
+		if err != nil {
+			log.Fatal(err)
+		}
+		service.WithPromotions(promotions)
+	}
+
+	watcher := config.NewWatcher(*configPath, cfg, configPollPeriod)
+	limiter := mw.NewRateLimiter(rateLimits(cfg.RateLimit), cfg.RateLimit.IdleTTL, cfg.RateLimit.MaxVisitors, cfg.RateLimit.TrustForwarded)
+	watcher.Subscribe(func(cfg *config.Config) {
+		clientProduct.SetRPS(cfg.ProductClient.RPS)
+		deadlines.Set(clientTimeouts(cfg.Timeouts))
+		service.SetStockCheck(cfg.Features.StockCheck)
+		_ = logger.SetLevel(cfg.Log.Level)
+		limiter.SetLimits(rateLimits(cfg.RateLimit))
+	})
+	watchCtx, stopWatch := context.WithCancel(context.Background())
+	defer stopWatch()
+	go watcher.Run(watchCtx)
+	go limiter.Run(watchCtx)
+	hub := events.NewHub(cfg.Events.BufferSize, cfg.Events.MaxSubscribers, cfg.Events.Retention)
+	service.AddPublisher(hub)
+	go hub.Run(watchCtx)
+	go guests.Run(watchCtx)
+
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	relayDone := make(chan struct{})
+	go func() {
+		defer close(relayDone)
+		if relay != nil {
+			relay.Run(relayCtx)
+		}
+	}()
+	webhooks := webhook.NewRegistry()
+	deliveries := webhook.NewDeliveryLog(cfg.Webhooks.LogSize)
+	dispatcher := webhook.NewDispatcher(webhooks, deliveries, webhookConfig(cfg.Webhooks))
+	service.AddPublisher(dispatcher)
+	go dispatcher.Run(watchCtx)
+
+	report := abandoned.NewReport(cfg.Abandoned.ReportSize)
+	scanner := abandoned.NewScanner(repo, service, report, cfg.Abandoned.IdleAfter, cfg.Abandoned.ScanInterval)
+	scanner.OnAbandoned(dispatcher.NotifyAbandoned)
+	go scanner.Run(watchCtx)
 
-	router := delivery.NewRouter(server)
+	wishlists := repository.NewWishlistRepository(cfg.Wishlist.MaxItems)
+	wishlistService := wishlist.NewService(wishlists, clientProduct)
+	poller := wishlist.NewPoller(wishlists, lomsClient, cfg.Wishlist.Threshold, cfg.Wishlist.PollInterval)
+	poller.AddNotifier(wishlist.LogNotifier{})
+	poller.AddNotifier(dispatcher)
+	go poller.Run(watchCtx)
+
+	if cfg.Currency.RatesFile != "" {
+		rates, err := currency.Load(cfg.Currency.RatesFile, cfg.Currency.ReloadInterval)
+		if err != nil {
+			log.Fatal(err)
+		}
+		service.WithExchange(rates)
+		go rates.Run(watchCtx)
+	}
+
+	server := delivery.NewServer(*service).WithWishlist(wishlistService)
+
+	router := delivery.NewRouter(
+		server,
+		delivery.NewHealthServer(checker),
+		delivery.NewEventsServer(hub, cfg.Events.Heartbeat),
+		delivery.NewWebhooksServer(webhooks, dispatcher, deliveries),
+		delivery.NewAbandonedServer(report),
+		delivery.NewWishlistServer(wishlistService),
+	).WithRateLimit(limiter.Middleware)
+	interceptors := []grpc.UnaryServerInterceptor{mw.RequestIDGRPC, mw.PanicGRPC, mw.LoggerGRPC}
+	if cfg.Auth.Enabled {
+		verifier, err := newVerifier(cfg.Auth)
+		if err != nil {
+			log.Fatal(err)
+		}
+		router.WithAuth(mw.Auth(verifier, cfg.Auth.AdminScope))
+		interceptors = append(interceptors, mw.AuthGRPC(verifier, cfg.Auth.AdminScope))
+	} else {
+		slog.Warn("auth is disabled: /admin routes (readiness switch, webhooks, abandoned carts) answer 403 until auth.enabled is set")
+	}
 	mux := http.NewServeMux()
 	router.SetupRoutes(mux)
-	loggedMux := mw.LoggerHTTP(mux)
+	handler := mw.RequestID(mw.LoggerHTTP(mw.PanicHTTP(mux)))
+
+	httpServer := &http.Server{Addr: ":" + cfg.CartServer.Port, Handler: handler}
+	// Shutdown waits for active connections, so end the event streams first.
+	httpServer.RegisterOnShutdown(hub.Close)
+
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(append(interceptors, delivery.ValidateGRPC)...))
+	desc.RegisterCartServer(grpcServer, delivery.NewGRPCServer(*service))
+	grpcHealth := grpchealth.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, grpcHealth)
 
-	log.Print("Server running on port: " + cfg.CartServer.Port)
-	if err = http.ListenAndServe(":"+cfg.CartServer.Port, loggedMux); err != nil {
+	lis, err := net.Listen("tcp", ":"+cfg.GRPCServer.Port)
+	if err != nil {
 		log.Fatal(err)
 	}
+	go func() {
+		slog.Info("gRPC server running", "port", cfg.GRPCServer.Port)
+		if err := grpcServer.Serve(lis); err != nil {
+			log.Fatal(err)
+		}
+	}()
+
+	go func() {
+		slog.Info("Server running", "port", cfg.CartServer.Port)
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatal(err)
+		}
+	}()
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+	<-stop
+
+	// Fail readiness first so the orchestrator stops routing traffic here
+	// before the listener goes away.
+	slog.Info("Shutting down")
+	checker.SetReady(false)
+	grpcHealth.Shutdown()
+	time.Sleep(shutdownDrain)
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := httpServer.Shutdown(ctx); err != nil {
+		slog.Error("shutdown", "err", err)
+	}
+	grpcServer.GracefulStop()
+
+	// No more cart changes can arrive; let the relay flush the outbox.
+	stopRelay()
+	<-relayDone
+}
+
+func clientTimeouts(t config.TimeoutsConfig) client.Timeouts {
+	return client.Timeouts{
+		ExistItem:    t.ExistItem,
+		GetProduct:   t.GetProduct,
+		StocksInfo:   t.StocksInfo,
+		OrderCreate:  t.OrderCreate,
+		MinRemaining: t.MinRemaining,
+	}
+}
+
+// rateLimits returns no limits when rate limiting is disabled, so it can be
+// switched on and off by reload.
+func rateLimits(cfg config.RateLimitConfig) map[mw.RouteClass]mw.Limit {
+	if !cfg.Enabled {
+		return nil
+	}
+	return map[mw.RouteClass]mw.Limit{
+		mw.ClassRead:     {RPS: cfg.Read.RPS, Burst: cfg.Read.Burst},
+		mw.ClassWrite:    {RPS: cfg.Write.RPS, Burst: cfg.Write.Burst},
+		mw.ClassCheckout: {RPS: cfg.Checkout.RPS, Burst: cfg.Checkout.Burst},
+	}
+}
+
+func webhookConfig(cfg config.WebhooksConfig) webhook.Config {
+	return webhook.Config{
+		Timeout:          cfg.Timeout,
+		MaxAttempts:      cfg.MaxAttempts,
+		Backoff:          cfg.Backoff,
+		MaxBackoff:       cfg.MaxBackoff,
+		BreakerThreshold: cfg.BreakerThreshold,
+		BreakerCooldown:  cfg.BreakerCooldown,
+		QueueSize:        cfg.QueueSize,
+		Workers:          cfg.Workers,
+	}
+}
+
+// newRelay enables the repository outbox and builds its relay, or returns a
+// nil relay if the outbox is off.
+func newRelay(cfg config.OutboxConfig, repo *repository.InMemoryCartRepository) (*outbox.Relay, func(), error) {
+	if cfg.Sink == "none" {
+		return nil, func() {}, nil
+	}
+
+	sink, err := outbox.NewJSONLSink(cfg.Path)
+	if err != nil {
+		return nil, nil, err
+	}
+	closers := []func() error{sink.Close}
+	var deadLetter outbox.Sink
+	if cfg.DeadLetterPath != "" {
+		dl, err := outbox.NewDeadLetterSink(cfg.DeadLetterPath)
+		if err != nil {
+			_ = sink.Close()
+			return nil, nil, err
+		}
+		deadLetter = dl
+		closers = append(closers, dl.Close)
+	}
+
+	repo.EnableOutbox()
+	relay := outbox.NewRelay(repo, sink, deadLetter, outbox.RelayConfig{
+		BatchSize:   cfg.BatchSize,
+		Interval:    cfg.Interval,
+		MaxAttempts: cfg.MaxAttempts,
+		Backoff:     cfg.Backoff,
+		MaxBackoff:  cfg.MaxBackoff,
+	})
+	closeSinks := func() {
+		for _, c := range closers {
+			_ = c()
+		}
+	}
+
+	return relay, closeSinks, nil
+}
+
+func newVerifier(cfg config.AuthConfig) (*auth.Verifier, error) {
+	keys := auth.NewKeys()
+	if cfg.HS256Secret != "" {
+		keys.AddHMAC("", []byte(cfg.HS256Secret))
+	}
+	if cfg.RS256PublicKeyFile != "" {
+		key, err := auth.LoadRSAPublicKey(cfg.RS256PublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		keys.AddRSA("", key)
+	}
+	if cfg.JWKSFile != "" {
+		if err := auth.LoadJWKS(cfg.JWKSFile, keys); err != nil {
+			return nil, err
+		}
+	}
+
+	return auth.NewVerifier(keys, cfg.Issuer, cfg.Audience, cfg.Leeway), nil
 }
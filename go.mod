@@ -6,7 +6,9 @@ require (
 	github.com/gojuno/minimock/v3 v3.4.5
 	github.com/stretchr/testify v1.10.0
 	github.com/vestamart/loms v0.0.0-20250322104406-3f18970b75b0
+	golang.org/x/time v0.11.0
 	google.golang.org/grpc v1.71.0
+	google.golang.org/protobuf v1.36.5
 	gopkg.in/yaml.v3 v3.0.1
 )
 
@@ -18,5 +20,4 @@ require (
 	golang.org/x/sys v0.29.0 // indirect
 	golang.org/x/text v0.21.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250115164207-1a7da9e5054f // indirect
-	google.golang.org/protobuf v1.36.5 // indirect
 )
@@ -0,0 +1,44 @@
+package abandoned
+
+import (
+	"github.com/vestamart/cart/internal/domain"
+	"sync"
+)
+
+// Report keeps the last size abandoned carts, newest first.
+type Report struct {
+	mu    sync.RWMutex
+	size  int
+	carts []domain.AbandonedCart
+}
+
+func NewReport(size int) *Report {
+	return &Report{size: size}
+}
+
+func (r *Report) Add(cart domain.AbandonedCart) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.carts = append(r.carts, cart)
+	if len(r.carts) > r.size {
+		r.carts = r.carts[len(r.carts)-r.size:]
+	}
+}
+
+// Page returns the page-th page (starting at 1) of pageSize carts and the
+// total number of carts in the report.
+func (r *Report) Page(page, pageSize int) ([]domain.AbandonedCart, int) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	total := len(r.carts)
+	from := min((page-1)*pageSize, total)
+	to := min(from+pageSize, total)
+
+	carts := make([]domain.AbandonedCart, 0, to-from)
+	for i := from; i < to; i++ {
+		carts = append(carts, r.carts[total-1-i])
+	}
+	return carts, total
+}
@@ -0,0 +1,121 @@
+package abandoned
+
+import (
+	"context"
+	"github.com/vestamart/cart/internal/domain"
+	"log/slog"
+	"time"
+)
+
+type Store interface {
+	IdleCarts(ctx context.Context, before time.Time) ([]domain.IdleCart, error)
+}
+
+// CartReader prices a cart. It is implemented by the cart service.
+type CartReader interface {
+	GetCart(ctx context.Context, userID uint64) (*domain.UserCart, error)
+}
+
+// Handler is called once per abandonment episode of a cart.
+type Handler func(ctx context.Context, cart domain.AbandonedCart)
+
+// Scanner periodically looks for carts that have not changed for idleAfter.
+// An episode ends when the cart changes, so a cart is reported again only
+// after it has been touched and left idle once more.
+type Scanner struct {
+	store     Store
+	carts     CartReader
+	report    *Report
+	idleAfter time.Duration
+	interval  time.Duration
+	handlers  []Handler
+	now       func() time.Time
+
+	// reported holds the cart version of the current episode per user. It is
+	// only used by the scanning goroutine.
+	reported map[uint64]uint64
+}
+
+func NewScanner(store Store, carts CartReader, report *Report, idleAfter, interval time.Duration) *Scanner {
+	return &Scanner{
+		store:     store,
+		carts:     carts,
+		report:    report,
+		idleAfter: idleAfter,
+		interval:  interval,
+		now:       time.Now,
+		reported:  make(map[uint64]uint64),
+	}
+}
+
+// OnAbandoned registers h. It must be called before Run.
+func (s *Scanner) OnAbandoned(h Handler) {
+	s.handlers = append(s.handlers, h)
+}
+
+func (s *Scanner) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Scan(ctx); err != nil {
+				slog.Error("abandoned carts: scan", "err", err)
+			}
+		}
+	}
+}
+
+func (s *Scanner) Scan(ctx context.Context) error {
+	idle, err := s.store.IdleCarts(ctx, s.now().Add(-s.idleAfter))
+	if err != nil {
+		return err
+	}
+
+	current := make(map[uint64]uint64, len(idle))
+	for _, cart := range idle {
+		if version, ok := s.reported[cart.UserID]; ok && version == cart.Version {
+			current[cart.UserID] = version
+			continue
+		}
+
+		abandoned, ok := s.price(ctx, cart)
+		if !ok {
+			// Not marked as reported, so the next scan tries again.
+			continue
+		}
+		current[cart.UserID] = cart.Version
+		s.report.Add(abandoned)
+		for _, h := range s.handlers {
+			h(ctx, abandoned)
+		}
+	}
+	s.reported = current
+
+	return nil
+}
+
+// price reads the idle cart through the cart service to get its contents
+// and total value. It fails if the cart changed since it was found idle.
+func (s *Scanner) price(ctx context.Context, idle domain.IdleCart) (domain.AbandonedCart, bool) {
+	cart, err := s.carts.GetCart(ctx, idle.UserID)
+	if err != nil {
+		slog.Warn("abandoned carts: get cart", "user", idle.UserID, "err", err)
+		return domain.AbandonedCart{}, false
+	}
+	if cart.Version != idle.Version {
+		return domain.AbandonedCart{}, false
+	}
+
+	return domain.AbandonedCart{
+		UserID:     idle.UserID,
+		Items:      cart.Items,
+		TotalPrice: cart.TotalPrice,
+		Version:    cart.Version,
+		IdleSince:  idle.UpdatedAt,
+		DetectedAt: s.now(),
+	}, true
+}
@@ -0,0 +1,108 @@
+package abandoned
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/cart/internal/domain"
+)
+
+type fakeStore struct {
+	carts []domain.IdleCart
+}
+
+func (s *fakeStore) IdleCarts(context.Context, time.Time) ([]domain.IdleCart, error) {
+	return s.carts, nil
+}
+
+type fakeReader struct {
+	carts map[uint64]*domain.UserCart
+	err   error
+}
+
+func (r *fakeReader) GetCart(_ context.Context, userID uint64) (*domain.UserCart, error) {
+	return r.carts[userID], r.err
+}
+
+func rub(amount int64) domain.Money {
+	return domain.NewMoney(amount, domain.DefaultCurrency)
+}
+
+func TestScanner_OncePerEpisode(t *testing.T) {
+	store := &fakeStore{carts: []domain.IdleCart{{UserID: 1, Version: 3}}}
+	reader := &fakeReader{carts: map[uint64]*domain.UserCart{
+		1: {Items: []domain.CartItem{{Sku: 10, Count: 2, Price: rub(50)}}, TotalPrice: rub(100), Version: 3},
+	}}
+	report := NewReport(10)
+	scanner := NewScanner(store, reader, report, time.Hour, time.Minute)
+	var reported []domain.AbandonedCart
+	scanner.OnAbandoned(func(_ context.Context, cart domain.AbandonedCart) { reported = append(reported, cart) })
+
+	ctx := context.Background()
+	require.NoError(t, scanner.Scan(ctx))
+	require.NoError(t, scanner.Scan(ctx))
+	require.Len(t, reported, 1, "an idle cart is reported once")
+	assert.Equal(t, rub(100), reported[0].TotalPrice)
+
+	// The user touched the cart and left it again: a new episode.
+	store.carts = []domain.IdleCart{{UserID: 1, Version: 5}}
+	reader.carts[1] = &domain.UserCart{TotalPrice: rub(150), Version: 5}
+	require.NoError(t, scanner.Scan(ctx))
+	assert.Len(t, reported, 2)
+
+	carts, total := report.Page(1, 10)
+	assert.Equal(t, 2, total)
+	assert.Equal(t, uint64(5), carts[0].Version, "newest first")
+}
+
+func TestScanner_RetriesUnpricedCarts(t *testing.T) {
+	store := &fakeStore{carts: []domain.IdleCart{{UserID: 1, Version: 3}}}
+	reader := &fakeReader{err: errors.New("product service down")}
+	report := NewReport(10)
+	scanner := NewScanner(store, reader, report, time.Hour, time.Minute)
+
+	ctx := context.Background()
+	require.NoError(t, scanner.Scan(ctx))
+	_, total := report.Page(1, 10)
+	assert.Equal(t, 0, total)
+
+	reader.err = nil
+	reader.carts = map[uint64]*domain.UserCart{1: {TotalPrice: rub(100), Version: 3}}
+	require.NoError(t, scanner.Scan(ctx))
+	_, total = report.Page(1, 10)
+	assert.Equal(t, 1, total)
+}
+
+func TestReport_Page(t *testing.T) {
+	report := NewReport(3)
+	for userID := uint64(1); userID <= 5; userID++ {
+		report.Add(domain.AbandonedCart{UserID: userID})
+	}
+
+	tests := []struct {
+		name     string
+		page     int
+		pageSize int
+		expected []uint64
+	}{
+		{name: "First page", page: 1, pageSize: 2, expected: []uint64{5, 4}},
+		{name: "Last page", page: 2, pageSize: 2, expected: []uint64{3}},
+		{name: "Past the end", page: 3, pageSize: 2, expected: []uint64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			carts, total := report.Page(tt.page, tt.pageSize)
+			assert.Equal(t, 3, total, "the oldest carts are dropped")
+			userIDs := make([]uint64, 0, len(carts))
+			for _, cart := range carts {
+				userIDs = append(userIDs, cart.UserID)
+			}
+			assert.Equal(t, tt.expected, userIDs)
+		})
+	}
+}
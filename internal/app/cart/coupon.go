@@ -0,0 +1,35 @@
+package cart
+
+import (
+	"context"
+	"github.com/vestamart/cart/internal/domain"
+)
+
+// ApplyCoupon applies a coupon of the promotions to the cart, replacing the
+// previous one, and returns the repriced cart.
+func (s *Service) ApplyCoupon(ctx context.Context, userID uint64, code string) (*domain.UserCart, error) {
+	if s.promotions == nil {
+		return nil, domain.ErrCouponNotFound
+	}
+	code, ok := s.promotions.Coupon(code)
+	if !ok {
+		return nil, domain.ErrCouponNotFound
+	}
+
+	if err := s.repository.SetCoupon(ctx, userID, code); err != nil {
+		return nil, err
+	}
+	s.publish(domain.CartEvent{Type: domain.EventCouponApplied, UserID: userID, List: domain.DefaultList, Coupon: code})
+
+	return s.GetCart(ctx, userID)
+}
+
+// RemoveCoupon removes the coupon of the cart, if any.
+func (s *Service) RemoveCoupon(ctx context.Context, userID uint64) error {
+	if err := s.repository.RemoveCoupon(ctx, userID); err != nil {
+		return err
+	}
+	s.publish(domain.CartEvent{Type: domain.EventCouponRemoved, UserID: userID, List: domain.DefaultList})
+
+	return nil
+}
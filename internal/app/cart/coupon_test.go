@@ -0,0 +1,67 @@
+package cart
+
+import (
+	"context"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/cart/internal/app/cart/mock"
+	"github.com/vestamart/cart/internal/domain"
+	"github.com/vestamart/cart/internal/promotion"
+	"testing"
+)
+
+func TestCartService_ApplyCoupon(t *testing.T) {
+	promotions, err := promotion.New([]promotion.Rule{
+		{ID: "spring10", Type: promotion.Percent, Coupon: "SPRING10", Percent: 10},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		code          string
+		prepareMocks  func(repoMock *mock.CartRepositoryMock, productMock *mock.ProductServiceMock)
+		expectedTotal domain.Money
+		expectedErr   error
+	}{
+		{
+			name: "Known coupon - cart repriced",
+			code: "spring10",
+			prepareMocks: func(repoMock *mock.CartRepositoryMock, productMock *mock.ProductServiceMock) {
+				repoMock.SetCouponMock.Expect(minimock.AnyContext, 456, "SPRING10").Return(nil)
+				repoMock.GetVersionMock.Return(4, nil)
+				repoMock.GetCartMock.Return(map[int64]uint16{123: 2}, nil)
+				repoMock.GetCouponMock.Return("SPRING10", nil)
+				productMock.GetProductMock.Return(&domain.ProductServiceResponse{Name: "Test Product", Price: 100}, nil)
+			},
+			expectedTotal: rub(180),
+		},
+		{
+			name:        "Unknown coupon - error",
+			code:        "WINTER",
+			expectedErr: domain.ErrCouponNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			repoMock := mock.NewCartRepositoryMock(mc)
+			productMock := mock.NewProductServiceMock(mc)
+			service := NewCartService(repoMock, productMock, nil).WithPromotions(promotions)
+			if tt.prepareMocks != nil {
+				tt.prepareMocks(repoMock, productMock)
+			}
+
+			cart, err := service.ApplyCoupon(context.Background(), 456, tt.code)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, rub(200), cart.Subtotal)
+			assert.Equal(t, tt.expectedTotal, cart.TotalPrice)
+			assert.Equal(t, []domain.Discount{{Promotion: "spring10", Amount: rub(20)}}, cart.Discounts)
+		})
+	}
+}
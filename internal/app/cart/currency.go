@@ -0,0 +1,91 @@
+package cart
+
+import (
+	"context"
+	"github.com/vestamart/cart/internal/domain"
+	"strings"
+)
+
+// Exchange looks up exchange rates.
+type Exchange interface {
+	Rate(from, to string) (domain.ExchangeRate, error)
+}
+
+// WithExchange lets GetCartIn convert carts with the rates of e.
+func (s *Service) WithExchange(e Exchange) *Service {
+	s.exchange = e
+	return s
+}
+
+// GetCartIn returns the cart with its amounts in currency, a case
+// insensitive ISO code. An empty currency or the currency of the cart returns
+// it as is.
+func (s *Service) GetCartIn(ctx context.Context, userID uint64, currency string) (*domain.UserCart, error) {
+	cart, err := s.GetCart(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	currency = strings.ToUpper(currency)
+	if currency == "" || currency == cart.TotalPrice.Currency {
+		return cart, nil
+	}
+	if s.exchange == nil {
+		return nil, domain.ErrUnknownCurrency.WithMsg("no rates table for %q", currency)
+	}
+
+	rate, err := s.exchange.Rate(cart.TotalPrice.Currency, currency)
+	if err != nil {
+		return nil, err
+	}
+	if err = convertCart(cart, rate); err != nil {
+		return nil, err
+	}
+	return cart, nil
+}
+
+// convertCart converts the prices and discounts of cart and recomputes the
+// totals from them, so that they add up after rounding.
+func convertCart(cart *domain.UserCart, rate domain.ExchangeRate) error {
+	subtotal := domain.NewMoney(0, rate.To)
+	discounts := subtotal
+	var line domain.Money
+	var err error
+
+	for i := range cart.Items {
+		item := &cart.Items[i]
+		if item.Price, err = rate.Convert(item.Price); err != nil {
+			return err
+		}
+		if line, err = item.Price.Mul(int64(item.Count)); err != nil {
+			return err
+		}
+		if subtotal, err = subtotal.Add(line); err != nil {
+			return err
+		}
+		if item.Discount.IsZero() {
+			continue
+		}
+		if item.Discount, err = rate.Convert(item.Discount); err != nil {
+			return err
+		}
+		if discounts, err = discounts.Add(item.Discount); err != nil {
+			return err
+		}
+	}
+	for i := range cart.Discounts {
+		d := &cart.Discounts[i]
+		if d.Amount, err = rate.Convert(d.Amount); err != nil {
+			return err
+		}
+		if discounts, err = discounts.Add(d.Amount); err != nil {
+			return err
+		}
+	}
+
+	cart.Subtotal = subtotal
+	if cart.TotalPrice, err = subtotal.Sub(discounts); err != nil {
+		return err
+	}
+	cart.Rate = &rate
+	return nil
+}
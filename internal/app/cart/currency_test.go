@@ -0,0 +1,111 @@
+package cart
+
+import (
+	"context"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/cart/internal/app/cart/mock"
+	"github.com/vestamart/cart/internal/currency"
+	"github.com/vestamart/cart/internal/domain"
+	"github.com/vestamart/cart/internal/promotion"
+	"testing"
+	"time"
+)
+
+func TestCartService_GetCartIn(t *testing.T) {
+	rates, err := currency.Parse([]byte(`source: test
+base: RUB
+currencies:
+  RUB:
+    minor_units: 0
+  USD:
+    rate: "0.0125"
+    minor_units: 2
+    rounding: half_even
+`), time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	promotions, err := promotion.New([]promotion.Rule{
+		{ID: "spring10", Type: promotion.Percent, Coupon: "SPRING10", Percent: 10},
+	})
+	require.NoError(t, err)
+	usd := func(amount int64) domain.Money { return domain.NewMoney(amount, "USD") }
+
+	tests := []struct {
+		name          string
+		currency      string
+		exchange      Exchange
+		expectedPrice domain.Money
+		expectedCart  *domain.UserCart
+		expectedErr   error
+	}{
+		{
+			name:          "Other currency - converted, totals from rounded amounts",
+			currency:      "usd",
+			exchange:      rates,
+			expectedPrice: usd(416),
+			expectedCart: &domain.UserCart{
+				Subtotal:   usd(1248),
+				Discounts:  []domain.Discount{{Promotion: "spring10", Amount: usd(124)}},
+				TotalPrice: usd(1124),
+			},
+		},
+		{
+			name:          "Cart currency - unchanged",
+			currency:      "RUB",
+			exchange:      rates,
+			expectedPrice: rub(333),
+			expectedCart: &domain.UserCart{
+				Subtotal:   rub(999),
+				Discounts:  []domain.Discount{{Promotion: "spring10", Amount: rub(99)}},
+				TotalPrice: rub(900),
+			},
+		},
+		{
+			name:        "Currency not in the table - error",
+			currency:    "GBP",
+			exchange:    rates,
+			expectedErr: domain.ErrUnknownCurrency,
+		},
+		{
+			name:        "No rates table - error",
+			currency:    "USD",
+			expectedErr: domain.ErrUnknownCurrency,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			repoMock := mock.NewCartRepositoryMock(mc)
+			productMock := mock.NewProductServiceMock(mc)
+			service := NewCartService(repoMock, productMock, nil).WithPromotions(promotions)
+			if tt.exchange != nil {
+				service.WithExchange(tt.exchange)
+			}
+
+			repoMock.GetVersionMock.Return(2, nil)
+			repoMock.GetCartMock.Return(map[int64]uint16{123: 3}, nil)
+			repoMock.GetCouponMock.Return("SPRING10", nil)
+			productMock.GetProductMock.Return(&domain.ProductServiceResponse{Name: "Test Product", Price: 333}, nil)
+
+			cart, err := service.GetCartIn(context.Background(), 456, tt.currency)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPrice, cart.Items[0].Price)
+			assert.Equal(t, tt.expectedCart.Subtotal, cart.Subtotal)
+			assert.Equal(t, tt.expectedCart.Discounts, cart.Discounts)
+			assert.Equal(t, tt.expectedCart.TotalPrice, cart.TotalPrice)
+			if tt.expectedCart.TotalPrice.Currency != domain.DefaultCurrency {
+				require.NotNil(t, cart.Rate)
+				assert.Equal(t, "test", cart.Rate.Source)
+				assert.Equal(t, "0.0125", cart.Rate.Rate)
+			} else {
+				assert.Nil(t, cart.Rate)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package cart
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"github.com/vestamart/cart/internal/domain"
+	"github.com/vestamart/cart/internal/localErr"
+)
+
+// AddToGuestCart adds to the guest cart of token and returns the token to use
+// from now on. A new token is issued when token is empty or its cart expired.
+func (s *Service) AddToGuestCart(ctx context.Context, token string, skuID int64, count uint16) (string, error) {
+	if skuID < 1 {
+		return "", localErr.ErrInvalidArgument.WithMsg("skuID must be greater than 0")
+	}
+	if err := s.checkItem(ctx, skuID, count); err != nil {
+		return "", err
+	}
+
+	if token != "" {
+		if _, err := s.guests.GetGuestCart(ctx, token); err != nil {
+			token = ""
+		}
+	}
+	if token == "" {
+		var err error
+		if token, err = newGuestToken(); err != nil {
+			return "", err
+		}
+	}
+
+	if err := s.guests.AddToGuestCart(ctx, token, skuID, count); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+func (s *Service) RemoveFromGuestCart(ctx context.Context, token string, skuID int64) error {
+	return s.guests.RemoveFromGuestCart(ctx, token, skuID)
+}
+
+func (s *Service) GetGuestCart(ctx context.Context, token string) (*domain.UserCart, error) {
+	items, err := s.guests.GetGuestCart(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return s.price(ctx, items)
+}
+
+// MergeGuestCart folds the guest cart of token into the cart of userID and
+// deletes the guest cart. Skus in both carts are merged by policy, or by the
+// configured policy if it is empty: sum adds the counts, max keeps the larger
+// one and prefer_guest takes the guest count, which can be lower than the
+// user's. A count above the user's is checked like AddToCart and lowered to
+// the stock left in LOMS, never below the user's count, and reported in the
+// result. A sum above 65535 fails with ErrCountOverflow.
+func (s *Service) MergeGuestCart(ctx context.Context, userID uint64, token string, policy domain.MergePolicy) (*domain.MergeResult, error) {
+	if policy == "" {
+		policy = s.mergePolicy
+	}
+	if !policy.Valid() {
+		return nil, localErr.ErrInvalidArgument.WithMsg("unknown merge policy %q", policy)
+	}
+
+	guestItems, err := s.guests.GetGuestCart(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	version, err := s.repository.GetVersion(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err = domain.CheckVersion(ctx, version); err != nil {
+		return nil, err
+	}
+	userItems, err := s.repository.GetCart(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var result domain.MergeResult
+	merged := make(map[int64]uint16, len(guestItems))
+	for sku, guestCount := range guestItems {
+		have := userItems[sku]
+		count, err := policy.Merge(have, guestCount)
+		if err != nil {
+			return nil, err
+		}
+		if count > have {
+			stock, checked, err := s.stock(ctx, sku)
+			if err != nil {
+				return nil, err
+			}
+			if checked && !enoughStock(stock, count) {
+				// stock <= count here, so stock-1 is the largest count
+				// enoughStock allows. The user keeps at least what they had.
+				allowed := have
+				if stock > 0 {
+					allowed = max(have, uint16(stock-1))
+				}
+				result.Adjusted = append(result.Adjusted, domain.MergeAdjustment{Sku: sku, Requested: count, Count: allowed})
+				count = allowed
+			}
+		}
+		if count != have {
+			merged[sku] = count
+		}
+	}
+
+	// The merge is computed from the version read above, so it must not
+	// overwrite a change made in the meantime.
+	if err = s.repository.MergeCart(domain.WithIfMatch(ctx, version), userID, merged); err != nil {
+		return nil, err
+	}
+	s.publish(domain.CartEvent{Type: domain.EventCartMerged, UserID: userID, List: domain.DefaultList})
+
+	if err = s.guests.DeleteGuestCart(ctx, token); err != nil {
+		return nil, err
+	}
+
+	if result.Cart, err = s.GetCart(ctx, userID); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func newGuestToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", localErr.Wrap(err, localErr.KindInternal, "generate guest token")
+	}
+	return hex.EncodeToString(b), nil
+}
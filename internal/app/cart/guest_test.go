@@ -0,0 +1,170 @@
+package cart
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/vestamart/cart/internal/app/cart/mock"
+	"github.com/vestamart/cart/internal/domain"
+	"github.com/vestamart/cart/internal/localErr"
+	"github.com/vestamart/loms/pkg/api/loms/v1"
+)
+
+func TestCartService_MergeGuestCart(t *testing.T) {
+	type mocks struct {
+		repo    *mock.CartRepositoryMock
+		guests  *mock.GuestRepositoryMock
+		loms    *mock.LomsClientMock
+		product *mock.ProductServiceMock
+	}
+
+	// merged expects the user cart {123: 2} to be merged into expected, the
+	// changed counts. stock is nil if no count grows.
+	merged := func(m mocks, guestCart map[int64]uint16, stock *uint64, expected map[int64]uint16) {
+		m.guests.GetGuestCartMock.Expect(minimock.AnyContext, "token").Return(guestCart, nil)
+		m.repo.GetVersionMock.Return(3, nil)
+		m.repo.GetCartMock.Return(map[int64]uint16{123: 2}, nil)
+		if stock != nil {
+			m.loms.StocksInfoMock.Return(&loms.StocksInfoResponse{Count: *stock}, nil)
+		}
+		m.repo.MergeCartMock.Expect(minimock.AnyContext, 456, expected).Return(nil)
+		m.guests.DeleteGuestCartMock.Expect(minimock.AnyContext, "token").Return(nil)
+		m.product.GetProductMock.Return(&domain.ProductServiceResponse{Name: "Test Product", Price: 100}, nil)
+	}
+
+	tests := []struct {
+		name         string
+		policy       domain.MergePolicy
+		ifMatch      []uint64
+		prepareMocks func(m mocks)
+		expectedAdj  []domain.MergeAdjustment
+		expectedErr  error
+	}{
+		{
+			name: "Default policy sums counts - success",
+			prepareMocks: func(m mocks) {
+				merged(m, map[int64]uint16{123: 3, 456: 1}, ptr(uint64(100)), map[int64]uint16{123: 5, 456: 1})
+			},
+		},
+		{
+			name:   "Max policy - success",
+			policy: domain.MergeMax,
+			prepareMocks: func(m mocks) {
+				merged(m, map[int64]uint16{123: 1}, nil, map[int64]uint16{})
+			},
+		},
+		{
+			name:   "Prefer guest policy lowers the user count - success",
+			policy: domain.MergePreferGuest,
+			prepareMocks: func(m mocks) {
+				merged(m, map[int64]uint16{123: 1}, nil, map[int64]uint16{123: 1})
+			},
+		},
+		{
+			name: "Not enough stock - count lowered below the stock, as in AddToCart",
+			prepareMocks: func(m mocks) {
+				merged(m, map[int64]uint16{123: 3}, ptr(uint64(4)), map[int64]uint16{123: 3})
+			},
+			expectedAdj: []domain.MergeAdjustment{{Sku: 123, Requested: 5, Count: 3}},
+		},
+		{
+			name: "No stock - the user count kept",
+			prepareMocks: func(m mocks) {
+				merged(m, map[int64]uint16{123: 3}, ptr(uint64(0)), map[int64]uint16{})
+			},
+			expectedAdj: []domain.MergeAdjustment{{Sku: 123, Requested: 5, Count: 2}},
+		},
+		{
+			name: "No stock of a guest sku - not added",
+			prepareMocks: func(m mocks) {
+				merged(m, map[int64]uint16{789: 1}, ptr(uint64(0)), map[int64]uint16{})
+			},
+			expectedAdj: []domain.MergeAdjustment{{Sku: 789, Requested: 1, Count: 0}},
+		},
+		{
+			name: "Sum above 65535 - error, nothing merged",
+			prepareMocks: func(m mocks) {
+				m.guests.GetGuestCartMock.Return(map[int64]uint16{123: math.MaxUint16}, nil)
+				m.repo.GetVersionMock.Return(3, nil)
+				m.repo.GetCartMock.Return(map[int64]uint16{123: 2}, nil)
+			},
+			expectedErr: domain.ErrCountOverflow,
+		},
+		{
+			name: "Unknown guest token - error",
+			prepareMocks: func(m mocks) {
+				m.guests.GetGuestCartMock.Return(nil, domain.ErrGuestCartNotFound)
+			},
+			expectedErr: domain.ErrGuestCartNotFound,
+		},
+		{
+			name:    "Stale version - error",
+			ifMatch: []uint64{2},
+			prepareMocks: func(m mocks) {
+				m.guests.GetGuestCartMock.Return(map[int64]uint16{123: 3}, nil)
+				m.repo.GetVersionMock.Return(3, nil)
+			},
+			expectedErr: domain.ErrVersionMismatch,
+		},
+		{
+			name:         "Unknown policy - error",
+			policy:       "min",
+			prepareMocks: func(mocks) {},
+			expectedErr:  localErr.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			m := mocks{
+				repo:    mock.NewCartRepositoryMock(mc),
+				guests:  mock.NewGuestRepositoryMock(mc),
+				loms:    mock.NewLomsClientMock(mc),
+				product: mock.NewProductServiceMock(mc),
+			}
+			service := NewCartService(m.repo, m.product, m.loms).WithGuestCarts(m.guests, domain.MergeSum)
+			tt.prepareMocks(m)
+
+			ctx := context.Background()
+			if tt.ifMatch != nil {
+				ctx = domain.WithIfMatch(ctx, tt.ifMatch...)
+			}
+			result, err := service.MergeGuestCart(ctx, 456, "token", tt.policy)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedAdj, result.Adjusted)
+		})
+	}
+}
+
+func TestCartService_AddToGuestCart(t *testing.T) {
+	mc := minimock.NewController(t)
+	repoMock := mock.NewCartRepositoryMock(mc)
+	guestMock := mock.NewGuestRepositoryMock(mc)
+	productMock := mock.NewProductServiceMock(mc)
+	lomsMock := mock.NewLomsClientMock(mc)
+	service := NewCartService(repoMock, productMock, lomsMock).WithGuestCarts(guestMock, domain.MergeSum)
+
+	productMock.ExistItemMock.Return(nil)
+	lomsMock.StocksInfoMock.Return(&loms.StocksInfoResponse{Count: 5}, nil)
+	guestMock.GetGuestCartMock.Return(nil, domain.ErrGuestCartNotFound)
+	guestMock.AddToGuestCartMock.Return(nil)
+
+	first, err := service.AddToGuestCart(context.Background(), "", 1003, 2)
+	assert.NoError(t, err)
+	assert.Len(t, first, 32)
+
+	// An expired token is replaced instead of being reused.
+	second, err := service.AddToGuestCart(context.Background(), "expired", 1003, 2)
+	assert.NoError(t, err)
+	assert.NotEqual(t, "expired", second)
+	assert.NotEqual(t, first, second)
+}
@@ -0,0 +1,112 @@
+package cart
+
+import (
+	"context"
+	"github.com/vestamart/cart/internal/domain"
+	"github.com/vestamart/cart/internal/localErr"
+)
+
+// Lists returns the lists of the user, the cart always included.
+func (s *Service) Lists(ctx context.Context, userID uint64) ([]domain.ListSummary, error) {
+	return s.repository.Lists(ctx, userID)
+}
+
+func (s *Service) GetList(ctx context.Context, userID uint64, list string) (*domain.UserCart, error) {
+	if err := domain.ValidateListName(list); err != nil {
+		return nil, err
+	}
+	version, err := s.repository.GetVersion(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.repository.GetList(ctx, userID, list)
+	if err != nil {
+		return nil, err
+	}
+
+	cart, err := s.price(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+	cart.Version = version
+	return cart, nil
+}
+
+// AddToList adds to a list, creating it on the first add. Items are checked
+// like in AddToCart, so a list can be checked out later.
+func (s *Service) AddToList(ctx context.Context, userID uint64, list string, skuID int64, count uint16) error {
+	if skuID < 1 || userID < 1 {
+		return localErr.ErrInvalidArgument.WithMsg("skuID or userID must be greater than 0")
+	}
+	if err := domain.ValidateListName(list); err != nil {
+		return err
+	}
+	if err := s.checkItem(ctx, skuID, count); err != nil {
+		return err
+	}
+
+	if err := s.repository.AddToList(ctx, userID, list, skuID, count); err != nil {
+		return err
+	}
+	s.publish(domain.CartEvent{Type: domain.EventItemAdded, UserID: userID, List: list, SkuID: skuID, Count: count})
+
+	return nil
+}
+
+func (s *Service) RemoveFromList(ctx context.Context, userID uint64, list string, skuID int64) error {
+	if err := domain.ValidateListName(list); err != nil {
+		return err
+	}
+	if err := s.repository.RemoveFromList(ctx, userID, list, skuID); err != nil {
+		return err
+	}
+	s.publish(domain.CartEvent{Type: domain.EventItemRemoved, UserID: userID, List: list, SkuID: skuID})
+
+	return nil
+}
+
+// MoveItem moves count of skuID between two lists of the user, all of it if
+// count is 0. Moving from the cart to domain.SavedList saves it for later.
+func (s *Service) MoveItem(ctx context.Context, userID uint64, from, to string, skuID int64, count uint16) error {
+	for _, list := range []string{from, to} {
+		if err := domain.ValidateListName(list); err != nil {
+			return err
+		}
+	}
+	if from == to {
+		return localErr.ErrInvalidArgument.WithMsg("cannot move an item to the list it is in")
+	}
+
+	moved, err := s.repository.MoveItem(ctx, userID, from, to, skuID, count)
+	if err != nil {
+		return err
+	}
+	s.publish(domain.CartEvent{Type: domain.EventItemMoved, UserID: userID, List: from, ToList: to, SkuID: skuID, Count: moved})
+
+	return nil
+}
+
+func (s *Service) DeleteList(ctx context.Context, userID uint64, list string) error {
+	if err := domain.ValidateListName(list); err != nil {
+		return err
+	}
+	if err := s.repository.DeleteList(ctx, userID, list); err != nil {
+		return err
+	}
+	s.publish(domain.CartEvent{Type: domain.EventCartCleared, UserID: userID, List: list})
+
+	return nil
+}
+
+// CheckoutList places an order for the content of list and removes it. The
+// cart goes through CheckoutCart, so it needs a preview like there.
+func (s *Service) CheckoutList(ctx context.Context, userID uint64, list string) (int64, error) {
+	if list == domain.DefaultList {
+		return s.CheckoutCart(ctx, userID)
+	}
+	cart, err := s.GetList(ctx, userID, list)
+	if err != nil {
+		return 0, err
+	}
+	return s.checkout(ctx, userID, list, cart)
+}
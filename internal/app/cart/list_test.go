@@ -0,0 +1,112 @@
+package cart
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/cart/internal/app/cart/mock"
+	"github.com/vestamart/cart/internal/domain"
+	"github.com/vestamart/cart/internal/localErr"
+	"github.com/vestamart/cart/internal/repository"
+	"github.com/vestamart/loms/pkg/api/loms/v1"
+	"google.golang.org/grpc"
+)
+
+func TestCartService_MoveItem(t *testing.T) {
+	mc := minimock.NewController(t)
+	repoMock := mock.NewCartRepositoryMock(mc)
+	service := NewCartService(repoMock, mock.NewProductServiceMock(mc), mock.NewLomsClientMock(mc))
+
+	tests := []struct {
+		name         string
+		from, to     string
+		prepareMocks func()
+		expectedErr  error
+	}{
+		{
+			name: "Save for later - success",
+			from: domain.DefaultList,
+			to:   domain.SavedList,
+			prepareMocks: func() {
+				repoMock.MoveItemMock.Expect(minimock.AnyContext, 456, domain.DefaultList, domain.SavedList, 123, 0).Return(2, nil)
+			},
+		},
+		{
+			name:         "Same list - error",
+			from:         domain.SavedList,
+			to:           domain.SavedList,
+			prepareMocks: func() {},
+			expectedErr:  localErr.ErrInvalidArgument,
+		},
+		{
+			name:         "Invalid list name - error",
+			from:         domain.DefaultList,
+			to:           "Office Cart",
+			prepareMocks: func() {},
+			expectedErr:  localErr.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.prepareMocks()
+			err := service.MoveItem(context.Background(), 456, tt.from, tt.to, 123, 0)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCartService_CheckoutList(t *testing.T) {
+	mc := minimock.NewController(t)
+	repoMock := mock.NewCartRepositoryMock(mc)
+	productMock := mock.NewProductServiceMock(mc)
+	lomsMock := mock.NewLomsClientMock(mc)
+	service := NewCartService(repoMock, productMock, lomsMock)
+
+	repoMock.GetVersionMock.Return(3, nil)
+	repoMock.GetListMock.Expect(minimock.AnyContext, 456, "office").Return(map[int64]uint16{123: 2}, nil)
+	productMock.GetProductMock.Return(&domain.ProductServiceResponse{Name: "Test Product", Price: 100}, nil)
+	lomsMock.OrderCreateMock.Return(&loms.OrderCreateResponse{OrderId: 7}, nil)
+	repoMock.CheckoutItemsMock.Expect(minimock.AnyContext, 456, "office", map[int64]uint16{123: 2}, 7).Return(nil)
+
+	orderID, err := service.CheckoutList(context.Background(), 456, "office")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), orderID)
+}
+
+func TestCartService_CheckoutList_KeepsItemsAddedMeanwhile(t *testing.T) {
+	for _, list := range []string{domain.DefaultList, "office"} {
+		t.Run(list, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			repo := repository.NewRepository(10)
+			productMock := mock.NewProductServiceMock(mc)
+			lomsMock := mock.NewLomsClientMock(mc)
+			service := NewCartService(repo, productMock, lomsMock)
+			ctx := context.Background()
+
+			require.NoError(t, repo.AddToList(ctx, 456, list, 123, 2))
+			productMock.GetProductMock.Return(&domain.ProductServiceResponse{Name: "Test Product", Price: 100}, nil)
+			// Another request adds to the list while the order is placed.
+			lomsMock.OrderCreateMock.Set(func(ctx context.Context, _ *loms.OrderCreateRequest, _ ...grpc.CallOption) (*loms.OrderCreateResponse, error) {
+				require.NoError(t, repo.AddToList(ctx, 456, list, 123, 1))
+				require.NoError(t, repo.AddToList(ctx, 456, list, 789, 4))
+				return &loms.OrderCreateResponse{OrderId: 7}, nil
+			})
+
+			orderID, err := service.CheckoutList(ctx, 456, list)
+			require.NoError(t, err)
+			assert.Equal(t, int64(7), orderID)
+
+			left, err := repo.GetList(ctx, 456, list)
+			require.NoError(t, err)
+			assert.Equal(t, map[int64]uint16{123: 1, 789: 4}, left)
+		})
+	}
+}
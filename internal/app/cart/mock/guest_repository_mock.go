@@ -0,0 +1,1575 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mock
+
+//go:generate minimock -i github.com/vestamart/cart/internal/app/cart.GuestRepository -o guest_repository_mock.go -n GuestRepositoryMock -p mock
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// GuestRepositoryMock implements mm_cart.GuestRepository
+type GuestRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddToGuestCart          func(ctx context.Context, token string, skuID int64, count uint16) (err error)
+	funcAddToGuestCartOrigin    string
+	inspectFuncAddToGuestCart   func(ctx context.Context, token string, skuID int64, count uint16)
+	afterAddToGuestCartCounter  uint64
+	beforeAddToGuestCartCounter uint64
+	AddToGuestCartMock          mGuestRepositoryMockAddToGuestCart
+
+	funcDeleteGuestCart          func(ctx context.Context, token string) (err error)
+	funcDeleteGuestCartOrigin    string
+	inspectFuncDeleteGuestCart   func(ctx context.Context, token string)
+	afterDeleteGuestCartCounter  uint64
+	beforeDeleteGuestCartCounter uint64
+	DeleteGuestCartMock          mGuestRepositoryMockDeleteGuestCart
+
+	funcGetGuestCart          func(ctx context.Context, token string) (m1 map[int64]uint16, err error)
+	funcGetGuestCartOrigin    string
+	inspectFuncGetGuestCart   func(ctx context.Context, token string)
+	afterGetGuestCartCounter  uint64
+	beforeGetGuestCartCounter uint64
+	GetGuestCartMock          mGuestRepositoryMockGetGuestCart
+
+	funcRemoveFromGuestCart          func(ctx context.Context, token string, skuID int64) (err error)
+	funcRemoveFromGuestCartOrigin    string
+	inspectFuncRemoveFromGuestCart   func(ctx context.Context, token string, skuID int64)
+	afterRemoveFromGuestCartCounter  uint64
+	beforeRemoveFromGuestCartCounter uint64
+	RemoveFromGuestCartMock          mGuestRepositoryMockRemoveFromGuestCart
+}
+
+// NewGuestRepositoryMock returns a mock for mm_cart.GuestRepository
+func NewGuestRepositoryMock(t minimock.Tester) *GuestRepositoryMock {
+	m := &GuestRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddToGuestCartMock = mGuestRepositoryMockAddToGuestCart{mock: m}
+	m.AddToGuestCartMock.callArgs = []*GuestRepositoryMockAddToGuestCartParams{}
+
+	m.DeleteGuestCartMock = mGuestRepositoryMockDeleteGuestCart{mock: m}
+	m.DeleteGuestCartMock.callArgs = []*GuestRepositoryMockDeleteGuestCartParams{}
+
+	m.GetGuestCartMock = mGuestRepositoryMockGetGuestCart{mock: m}
+	m.GetGuestCartMock.callArgs = []*GuestRepositoryMockGetGuestCartParams{}
+
+	m.RemoveFromGuestCartMock = mGuestRepositoryMockRemoveFromGuestCart{mock: m}
+	m.RemoveFromGuestCartMock.callArgs = []*GuestRepositoryMockRemoveFromGuestCartParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mGuestRepositoryMockAddToGuestCart struct {
+	optional           bool
+	mock               *GuestRepositoryMock
+	defaultExpectation *GuestRepositoryMockAddToGuestCartExpectation
+	expectations       []*GuestRepositoryMockAddToGuestCartExpectation
+
+	callArgs []*GuestRepositoryMockAddToGuestCartParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// GuestRepositoryMockAddToGuestCartExpectation specifies expectation struct of the GuestRepository.AddToGuestCart
+type GuestRepositoryMockAddToGuestCartExpectation struct {
+	mock               *GuestRepositoryMock
+	params             *GuestRepositoryMockAddToGuestCartParams
+	paramPtrs          *GuestRepositoryMockAddToGuestCartParamPtrs
+	expectationOrigins GuestRepositoryMockAddToGuestCartExpectationOrigins
+	results            *GuestRepositoryMockAddToGuestCartResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// GuestRepositoryMockAddToGuestCartParams contains parameters of the GuestRepository.AddToGuestCart
+type GuestRepositoryMockAddToGuestCartParams struct {
+	ctx   context.Context
+	token string
+	skuID int64
+	count uint16
+}
+
+// GuestRepositoryMockAddToGuestCartParamPtrs contains pointers to parameters of the GuestRepository.AddToGuestCart
+type GuestRepositoryMockAddToGuestCartParamPtrs struct {
+	ctx   *context.Context
+	token *string
+	skuID *int64
+	count *uint16
+}
+
+// GuestRepositoryMockAddToGuestCartResults contains results of the GuestRepository.AddToGuestCart
+type GuestRepositoryMockAddToGuestCartResults struct {
+	err error
+}
+
+// GuestRepositoryMockAddToGuestCartOrigins contains origins of expectations of the GuestRepository.AddToGuestCart
+type GuestRepositoryMockAddToGuestCartExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originToken string
+	originSkuID string
+	originCount string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddToGuestCart *mGuestRepositoryMockAddToGuestCart) Optional() *mGuestRepositoryMockAddToGuestCart {
+	mmAddToGuestCart.optional = true
+	return mmAddToGuestCart
+}
+
+// Expect sets up expected params for GuestRepository.AddToGuestCart
+func (mmAddToGuestCart *mGuestRepositoryMockAddToGuestCart) Expect(ctx context.Context, token string, skuID int64, count uint16) *mGuestRepositoryMockAddToGuestCart {
+	if mmAddToGuestCart.mock.funcAddToGuestCart != nil {
+		mmAddToGuestCart.mock.t.Fatalf("GuestRepositoryMock.AddToGuestCart mock is already set by Set")
+	}
+
+	if mmAddToGuestCart.defaultExpectation == nil {
+		mmAddToGuestCart.defaultExpectation = &GuestRepositoryMockAddToGuestCartExpectation{}
+	}
+
+	if mmAddToGuestCart.defaultExpectation.paramPtrs != nil {
+		mmAddToGuestCart.mock.t.Fatalf("GuestRepositoryMock.AddToGuestCart mock is already set by ExpectParams functions")
+	}
+
+	mmAddToGuestCart.defaultExpectation.params = &GuestRepositoryMockAddToGuestCartParams{ctx, token, skuID, count}
+	mmAddToGuestCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddToGuestCart.expectations {
+		if minimock.Equal(e.params, mmAddToGuestCart.defaultExpectation.params) {
+			mmAddToGuestCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddToGuestCart.defaultExpectation.params)
+		}
+	}
+
+	return mmAddToGuestCart
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GuestRepository.AddToGuestCart
+func (mmAddToGuestCart *mGuestRepositoryMockAddToGuestCart) ExpectCtxParam1(ctx context.Context) *mGuestRepositoryMockAddToGuestCart {
+	if mmAddToGuestCart.mock.funcAddToGuestCart != nil {
+		mmAddToGuestCart.mock.t.Fatalf("GuestRepositoryMock.AddToGuestCart mock is already set by Set")
+	}
+
+	if mmAddToGuestCart.defaultExpectation == nil {
+		mmAddToGuestCart.defaultExpectation = &GuestRepositoryMockAddToGuestCartExpectation{}
+	}
+
+	if mmAddToGuestCart.defaultExpectation.params != nil {
+		mmAddToGuestCart.mock.t.Fatalf("GuestRepositoryMock.AddToGuestCart mock is already set by Expect")
+	}
+
+	if mmAddToGuestCart.defaultExpectation.paramPtrs == nil {
+		mmAddToGuestCart.defaultExpectation.paramPtrs = &GuestRepositoryMockAddToGuestCartParamPtrs{}
+	}
+	mmAddToGuestCart.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddToGuestCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddToGuestCart
+}
+
+// ExpectTokenParam2 sets up expected param token for GuestRepository.AddToGuestCart
+func (mmAddToGuestCart *mGuestRepositoryMockAddToGuestCart) ExpectTokenParam2(token string) *mGuestRepositoryMockAddToGuestCart {
+	if mmAddToGuestCart.mock.funcAddToGuestCart != nil {
+		mmAddToGuestCart.mock.t.Fatalf("GuestRepositoryMock.AddToGuestCart mock is already set by Set")
+	}
+
+	if mmAddToGuestCart.defaultExpectation == nil {
+		mmAddToGuestCart.defaultExpectation = &GuestRepositoryMockAddToGuestCartExpectation{}
+	}
+
+	if mmAddToGuestCart.defaultExpectation.params != nil {
+		mmAddToGuestCart.mock.t.Fatalf("GuestRepositoryMock.AddToGuestCart mock is already set by Expect")
+	}
+
+	if mmAddToGuestCart.defaultExpectation.paramPtrs == nil {
+		mmAddToGuestCart.defaultExpectation.paramPtrs = &GuestRepositoryMockAddToGuestCartParamPtrs{}
+	}
+	mmAddToGuestCart.defaultExpectation.paramPtrs.token = &token
+	mmAddToGuestCart.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
+
+	return mmAddToGuestCart
+}
+
+// ExpectSkuIDParam3 sets up expected param skuID for GuestRepository.AddToGuestCart
+func (mmAddToGuestCart *mGuestRepositoryMockAddToGuestCart) ExpectSkuIDParam3(skuID int64) *mGuestRepositoryMockAddToGuestCart {
+	if mmAddToGuestCart.mock.funcAddToGuestCart != nil {
+		mmAddToGuestCart.mock.t.Fatalf("GuestRepositoryMock.AddToGuestCart mock is already set by Set")
+	}
+
+	if mmAddToGuestCart.defaultExpectation == nil {
+		mmAddToGuestCart.defaultExpectation = &GuestRepositoryMockAddToGuestCartExpectation{}
+	}
+
+	if mmAddToGuestCart.defaultExpectation.params != nil {
+		mmAddToGuestCart.mock.t.Fatalf("GuestRepositoryMock.AddToGuestCart mock is already set by Expect")
+	}
+
+	if mmAddToGuestCart.defaultExpectation.paramPtrs == nil {
+		mmAddToGuestCart.defaultExpectation.paramPtrs = &GuestRepositoryMockAddToGuestCartParamPtrs{}
+	}
+	mmAddToGuestCart.defaultExpectation.paramPtrs.skuID = &skuID
+	mmAddToGuestCart.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmAddToGuestCart
+}
+
+// ExpectCountParam4 sets up expected param count for GuestRepository.AddToGuestCart
+func (mmAddToGuestCart *mGuestRepositoryMockAddToGuestCart) ExpectCountParam4(count uint16) *mGuestRepositoryMockAddToGuestCart {
+	if mmAddToGuestCart.mock.funcAddToGuestCart != nil {
+		mmAddToGuestCart.mock.t.Fatalf("GuestRepositoryMock.AddToGuestCart mock is already set by Set")
+	}
+
+	if mmAddToGuestCart.defaultExpectation == nil {
+		mmAddToGuestCart.defaultExpectation = &GuestRepositoryMockAddToGuestCartExpectation{}
+	}
+
+	if mmAddToGuestCart.defaultExpectation.params != nil {
+		mmAddToGuestCart.mock.t.Fatalf("GuestRepositoryMock.AddToGuestCart mock is already set by Expect")
+	}
+
+	if mmAddToGuestCart.defaultExpectation.paramPtrs == nil {
+		mmAddToGuestCart.defaultExpectation.paramPtrs = &GuestRepositoryMockAddToGuestCartParamPtrs{}
+	}
+	mmAddToGuestCart.defaultExpectation.paramPtrs.count = &count
+	mmAddToGuestCart.defaultExpectation.expectationOrigins.originCount = minimock.CallerInfo(1)
+
+	return mmAddToGuestCart
+}
+
+// Inspect accepts an inspector function that has same arguments as the GuestRepository.AddToGuestCart
+func (mmAddToGuestCart *mGuestRepositoryMockAddToGuestCart) Inspect(f func(ctx context.Context, token string, skuID int64, count uint16)) *mGuestRepositoryMockAddToGuestCart {
+	if mmAddToGuestCart.mock.inspectFuncAddToGuestCart != nil {
+		mmAddToGuestCart.mock.t.Fatalf("Inspect function is already set for GuestRepositoryMock.AddToGuestCart")
+	}
+
+	mmAddToGuestCart.mock.inspectFuncAddToGuestCart = f
+
+	return mmAddToGuestCart
+}
+
+// Return sets up results that will be returned by GuestRepository.AddToGuestCart
+func (mmAddToGuestCart *mGuestRepositoryMockAddToGuestCart) Return(err error) *GuestRepositoryMock {
+	if mmAddToGuestCart.mock.funcAddToGuestCart != nil {
+		mmAddToGuestCart.mock.t.Fatalf("GuestRepositoryMock.AddToGuestCart mock is already set by Set")
+	}
+
+	if mmAddToGuestCart.defaultExpectation == nil {
+		mmAddToGuestCart.defaultExpectation = &GuestRepositoryMockAddToGuestCartExpectation{mock: mmAddToGuestCart.mock}
+	}
+	mmAddToGuestCart.defaultExpectation.results = &GuestRepositoryMockAddToGuestCartResults{err}
+	mmAddToGuestCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddToGuestCart.mock
+}
+
+// Set uses given function f to mock the GuestRepository.AddToGuestCart method
+func (mmAddToGuestCart *mGuestRepositoryMockAddToGuestCart) Set(f func(ctx context.Context, token string, skuID int64, count uint16) (err error)) *GuestRepositoryMock {
+	if mmAddToGuestCart.defaultExpectation != nil {
+		mmAddToGuestCart.mock.t.Fatalf("Default expectation is already set for the GuestRepository.AddToGuestCart method")
+	}
+
+	if len(mmAddToGuestCart.expectations) > 0 {
+		mmAddToGuestCart.mock.t.Fatalf("Some expectations are already set for the GuestRepository.AddToGuestCart method")
+	}
+
+	mmAddToGuestCart.mock.funcAddToGuestCart = f
+	mmAddToGuestCart.mock.funcAddToGuestCartOrigin = minimock.CallerInfo(1)
+	return mmAddToGuestCart.mock
+}
+
+// When sets expectation for the GuestRepository.AddToGuestCart which will trigger the result defined by the following
+// Then helper
+func (mmAddToGuestCart *mGuestRepositoryMockAddToGuestCart) When(ctx context.Context, token string, skuID int64, count uint16) *GuestRepositoryMockAddToGuestCartExpectation {
+	if mmAddToGuestCart.mock.funcAddToGuestCart != nil {
+		mmAddToGuestCart.mock.t.Fatalf("GuestRepositoryMock.AddToGuestCart mock is already set by Set")
+	}
+
+	expectation := &GuestRepositoryMockAddToGuestCartExpectation{
+		mock:               mmAddToGuestCart.mock,
+		params:             &GuestRepositoryMockAddToGuestCartParams{ctx, token, skuID, count},
+		expectationOrigins: GuestRepositoryMockAddToGuestCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddToGuestCart.expectations = append(mmAddToGuestCart.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GuestRepository.AddToGuestCart return parameters for the expectation previously defined by the When method
+func (e *GuestRepositoryMockAddToGuestCartExpectation) Then(err error) *GuestRepositoryMock {
+	e.results = &GuestRepositoryMockAddToGuestCartResults{err}
+	return e.mock
+}
+
+// Times sets number of times GuestRepository.AddToGuestCart should be invoked
+func (mmAddToGuestCart *mGuestRepositoryMockAddToGuestCart) Times(n uint64) *mGuestRepositoryMockAddToGuestCart {
+	if n == 0 {
+		mmAddToGuestCart.mock.t.Fatalf("Times of GuestRepositoryMock.AddToGuestCart mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddToGuestCart.expectedInvocations, n)
+	mmAddToGuestCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddToGuestCart
+}
+
+func (mmAddToGuestCart *mGuestRepositoryMockAddToGuestCart) invocationsDone() bool {
+	if len(mmAddToGuestCart.expectations) == 0 && mmAddToGuestCart.defaultExpectation == nil && mmAddToGuestCart.mock.funcAddToGuestCart == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddToGuestCart.mock.afterAddToGuestCartCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddToGuestCart.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddToGuestCart implements mm_cart.GuestRepository
+func (mmAddToGuestCart *GuestRepositoryMock) AddToGuestCart(ctx context.Context, token string, skuID int64, count uint16) (err error) {
+	mm_atomic.AddUint64(&mmAddToGuestCart.beforeAddToGuestCartCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddToGuestCart.afterAddToGuestCartCounter, 1)
+
+	mmAddToGuestCart.t.Helper()
+
+	if mmAddToGuestCart.inspectFuncAddToGuestCart != nil {
+		mmAddToGuestCart.inspectFuncAddToGuestCart(ctx, token, skuID, count)
+	}
+
+	mm_params := GuestRepositoryMockAddToGuestCartParams{ctx, token, skuID, count}
+
+	// Record call args
+	mmAddToGuestCart.AddToGuestCartMock.mutex.Lock()
+	mmAddToGuestCart.AddToGuestCartMock.callArgs = append(mmAddToGuestCart.AddToGuestCartMock.callArgs, &mm_params)
+	mmAddToGuestCart.AddToGuestCartMock.mutex.Unlock()
+
+	for _, e := range mmAddToGuestCart.AddToGuestCartMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddToGuestCart.AddToGuestCartMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddToGuestCart.AddToGuestCartMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddToGuestCart.AddToGuestCartMock.defaultExpectation.params
+		mm_want_ptrs := mmAddToGuestCart.AddToGuestCartMock.defaultExpectation.paramPtrs
+
+		mm_got := GuestRepositoryMockAddToGuestCartParams{ctx, token, skuID, count}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddToGuestCart.t.Errorf("GuestRepositoryMock.AddToGuestCart got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddToGuestCart.AddToGuestCartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmAddToGuestCart.t.Errorf("GuestRepositoryMock.AddToGuestCart got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddToGuestCart.AddToGuestCartMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmAddToGuestCart.t.Errorf("GuestRepositoryMock.AddToGuestCart got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddToGuestCart.AddToGuestCartMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+			if mm_want_ptrs.count != nil && !minimock.Equal(*mm_want_ptrs.count, mm_got.count) {
+				mmAddToGuestCart.t.Errorf("GuestRepositoryMock.AddToGuestCart got unexpected parameter count, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddToGuestCart.AddToGuestCartMock.defaultExpectation.expectationOrigins.originCount, *mm_want_ptrs.count, mm_got.count, minimock.Diff(*mm_want_ptrs.count, mm_got.count))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddToGuestCart.t.Errorf("GuestRepositoryMock.AddToGuestCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddToGuestCart.AddToGuestCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddToGuestCart.AddToGuestCartMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddToGuestCart.t.Fatal("No results are set for the GuestRepositoryMock.AddToGuestCart")
+		}
+		return (*mm_results).err
+	}
+	if mmAddToGuestCart.funcAddToGuestCart != nil {
+		return mmAddToGuestCart.funcAddToGuestCart(ctx, token, skuID, count)
+	}
+	mmAddToGuestCart.t.Fatalf("Unexpected call to GuestRepositoryMock.AddToGuestCart. %v %v %v %v", ctx, token, skuID, count)
+	return
+}
+
+// AddToGuestCartAfterCounter returns a count of finished GuestRepositoryMock.AddToGuestCart invocations
+func (mmAddToGuestCart *GuestRepositoryMock) AddToGuestCartAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddToGuestCart.afterAddToGuestCartCounter)
+}
+
+// AddToGuestCartBeforeCounter returns a count of GuestRepositoryMock.AddToGuestCart invocations
+func (mmAddToGuestCart *GuestRepositoryMock) AddToGuestCartBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddToGuestCart.beforeAddToGuestCartCounter)
+}
+
+// Calls returns a list of arguments used in each call to GuestRepositoryMock.AddToGuestCart.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddToGuestCart *mGuestRepositoryMockAddToGuestCart) Calls() []*GuestRepositoryMockAddToGuestCartParams {
+	mmAddToGuestCart.mutex.RLock()
+
+	argCopy := make([]*GuestRepositoryMockAddToGuestCartParams, len(mmAddToGuestCart.callArgs))
+	copy(argCopy, mmAddToGuestCart.callArgs)
+
+	mmAddToGuestCart.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddToGuestCartDone returns true if the count of the AddToGuestCart invocations corresponds
+// the number of defined expectations
+func (m *GuestRepositoryMock) MinimockAddToGuestCartDone() bool {
+	if m.AddToGuestCartMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddToGuestCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddToGuestCartMock.invocationsDone()
+}
+
+// MinimockAddToGuestCartInspect logs each unmet expectation
+func (m *GuestRepositoryMock) MinimockAddToGuestCartInspect() {
+	for _, e := range m.AddToGuestCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GuestRepositoryMock.AddToGuestCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddToGuestCartCounter := mm_atomic.LoadUint64(&m.afterAddToGuestCartCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddToGuestCartMock.defaultExpectation != nil && afterAddToGuestCartCounter < 1 {
+		if m.AddToGuestCartMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to GuestRepositoryMock.AddToGuestCart at\n%s", m.AddToGuestCartMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to GuestRepositoryMock.AddToGuestCart at\n%s with params: %#v", m.AddToGuestCartMock.defaultExpectation.expectationOrigins.origin, *m.AddToGuestCartMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddToGuestCart != nil && afterAddToGuestCartCounter < 1 {
+		m.t.Errorf("Expected call to GuestRepositoryMock.AddToGuestCart at\n%s", m.funcAddToGuestCartOrigin)
+	}
+
+	if !m.AddToGuestCartMock.invocationsDone() && afterAddToGuestCartCounter > 0 {
+		m.t.Errorf("Expected %d calls to GuestRepositoryMock.AddToGuestCart at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddToGuestCartMock.expectedInvocations), m.AddToGuestCartMock.expectedInvocationsOrigin, afterAddToGuestCartCounter)
+	}
+}
+
+type mGuestRepositoryMockDeleteGuestCart struct {
+	optional           bool
+	mock               *GuestRepositoryMock
+	defaultExpectation *GuestRepositoryMockDeleteGuestCartExpectation
+	expectations       []*GuestRepositoryMockDeleteGuestCartExpectation
+
+	callArgs []*GuestRepositoryMockDeleteGuestCartParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// GuestRepositoryMockDeleteGuestCartExpectation specifies expectation struct of the GuestRepository.DeleteGuestCart
+type GuestRepositoryMockDeleteGuestCartExpectation struct {
+	mock               *GuestRepositoryMock
+	params             *GuestRepositoryMockDeleteGuestCartParams
+	paramPtrs          *GuestRepositoryMockDeleteGuestCartParamPtrs
+	expectationOrigins GuestRepositoryMockDeleteGuestCartExpectationOrigins
+	results            *GuestRepositoryMockDeleteGuestCartResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// GuestRepositoryMockDeleteGuestCartParams contains parameters of the GuestRepository.DeleteGuestCart
+type GuestRepositoryMockDeleteGuestCartParams struct {
+	ctx   context.Context
+	token string
+}
+
+// GuestRepositoryMockDeleteGuestCartParamPtrs contains pointers to parameters of the GuestRepository.DeleteGuestCart
+type GuestRepositoryMockDeleteGuestCartParamPtrs struct {
+	ctx   *context.Context
+	token *string
+}
+
+// GuestRepositoryMockDeleteGuestCartResults contains results of the GuestRepository.DeleteGuestCart
+type GuestRepositoryMockDeleteGuestCartResults struct {
+	err error
+}
+
+// GuestRepositoryMockDeleteGuestCartOrigins contains origins of expectations of the GuestRepository.DeleteGuestCart
+type GuestRepositoryMockDeleteGuestCartExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteGuestCart *mGuestRepositoryMockDeleteGuestCart) Optional() *mGuestRepositoryMockDeleteGuestCart {
+	mmDeleteGuestCart.optional = true
+	return mmDeleteGuestCart
+}
+
+// Expect sets up expected params for GuestRepository.DeleteGuestCart
+func (mmDeleteGuestCart *mGuestRepositoryMockDeleteGuestCart) Expect(ctx context.Context, token string) *mGuestRepositoryMockDeleteGuestCart {
+	if mmDeleteGuestCart.mock.funcDeleteGuestCart != nil {
+		mmDeleteGuestCart.mock.t.Fatalf("GuestRepositoryMock.DeleteGuestCart mock is already set by Set")
+	}
+
+	if mmDeleteGuestCart.defaultExpectation == nil {
+		mmDeleteGuestCart.defaultExpectation = &GuestRepositoryMockDeleteGuestCartExpectation{}
+	}
+
+	if mmDeleteGuestCart.defaultExpectation.paramPtrs != nil {
+		mmDeleteGuestCart.mock.t.Fatalf("GuestRepositoryMock.DeleteGuestCart mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteGuestCart.defaultExpectation.params = &GuestRepositoryMockDeleteGuestCartParams{ctx, token}
+	mmDeleteGuestCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteGuestCart.expectations {
+		if minimock.Equal(e.params, mmDeleteGuestCart.defaultExpectation.params) {
+			mmDeleteGuestCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteGuestCart.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteGuestCart
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GuestRepository.DeleteGuestCart
+func (mmDeleteGuestCart *mGuestRepositoryMockDeleteGuestCart) ExpectCtxParam1(ctx context.Context) *mGuestRepositoryMockDeleteGuestCart {
+	if mmDeleteGuestCart.mock.funcDeleteGuestCart != nil {
+		mmDeleteGuestCart.mock.t.Fatalf("GuestRepositoryMock.DeleteGuestCart mock is already set by Set")
+	}
+
+	if mmDeleteGuestCart.defaultExpectation == nil {
+		mmDeleteGuestCart.defaultExpectation = &GuestRepositoryMockDeleteGuestCartExpectation{}
+	}
+
+	if mmDeleteGuestCart.defaultExpectation.params != nil {
+		mmDeleteGuestCart.mock.t.Fatalf("GuestRepositoryMock.DeleteGuestCart mock is already set by Expect")
+	}
+
+	if mmDeleteGuestCart.defaultExpectation.paramPtrs == nil {
+		mmDeleteGuestCart.defaultExpectation.paramPtrs = &GuestRepositoryMockDeleteGuestCartParamPtrs{}
+	}
+	mmDeleteGuestCart.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteGuestCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteGuestCart
+}
+
+// ExpectTokenParam2 sets up expected param token for GuestRepository.DeleteGuestCart
+func (mmDeleteGuestCart *mGuestRepositoryMockDeleteGuestCart) ExpectTokenParam2(token string) *mGuestRepositoryMockDeleteGuestCart {
+	if mmDeleteGuestCart.mock.funcDeleteGuestCart != nil {
+		mmDeleteGuestCart.mock.t.Fatalf("GuestRepositoryMock.DeleteGuestCart mock is already set by Set")
+	}
+
+	if mmDeleteGuestCart.defaultExpectation == nil {
+		mmDeleteGuestCart.defaultExpectation = &GuestRepositoryMockDeleteGuestCartExpectation{}
+	}
+
+	if mmDeleteGuestCart.defaultExpectation.params != nil {
+		mmDeleteGuestCart.mock.t.Fatalf("GuestRepositoryMock.DeleteGuestCart mock is already set by Expect")
+	}
+
+	if mmDeleteGuestCart.defaultExpectation.paramPtrs == nil {
+		mmDeleteGuestCart.defaultExpectation.paramPtrs = &GuestRepositoryMockDeleteGuestCartParamPtrs{}
+	}
+	mmDeleteGuestCart.defaultExpectation.paramPtrs.token = &token
+	mmDeleteGuestCart.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
+
+	return mmDeleteGuestCart
+}
+
+// Inspect accepts an inspector function that has same arguments as the GuestRepository.DeleteGuestCart
+func (mmDeleteGuestCart *mGuestRepositoryMockDeleteGuestCart) Inspect(f func(ctx context.Context, token string)) *mGuestRepositoryMockDeleteGuestCart {
+	if mmDeleteGuestCart.mock.inspectFuncDeleteGuestCart != nil {
+		mmDeleteGuestCart.mock.t.Fatalf("Inspect function is already set for GuestRepositoryMock.DeleteGuestCart")
+	}
+
+	mmDeleteGuestCart.mock.inspectFuncDeleteGuestCart = f
+
+	return mmDeleteGuestCart
+}
+
+// Return sets up results that will be returned by GuestRepository.DeleteGuestCart
+func (mmDeleteGuestCart *mGuestRepositoryMockDeleteGuestCart) Return(err error) *GuestRepositoryMock {
+	if mmDeleteGuestCart.mock.funcDeleteGuestCart != nil {
+		mmDeleteGuestCart.mock.t.Fatalf("GuestRepositoryMock.DeleteGuestCart mock is already set by Set")
+	}
+
+	if mmDeleteGuestCart.defaultExpectation == nil {
+		mmDeleteGuestCart.defaultExpectation = &GuestRepositoryMockDeleteGuestCartExpectation{mock: mmDeleteGuestCart.mock}
+	}
+	mmDeleteGuestCart.defaultExpectation.results = &GuestRepositoryMockDeleteGuestCartResults{err}
+	mmDeleteGuestCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteGuestCart.mock
+}
+
+// Set uses given function f to mock the GuestRepository.DeleteGuestCart method
+func (mmDeleteGuestCart *mGuestRepositoryMockDeleteGuestCart) Set(f func(ctx context.Context, token string) (err error)) *GuestRepositoryMock {
+	if mmDeleteGuestCart.defaultExpectation != nil {
+		mmDeleteGuestCart.mock.t.Fatalf("Default expectation is already set for the GuestRepository.DeleteGuestCart method")
+	}
+
+	if len(mmDeleteGuestCart.expectations) > 0 {
+		mmDeleteGuestCart.mock.t.Fatalf("Some expectations are already set for the GuestRepository.DeleteGuestCart method")
+	}
+
+	mmDeleteGuestCart.mock.funcDeleteGuestCart = f
+	mmDeleteGuestCart.mock.funcDeleteGuestCartOrigin = minimock.CallerInfo(1)
+	return mmDeleteGuestCart.mock
+}
+
+// When sets expectation for the GuestRepository.DeleteGuestCart which will trigger the result defined by the following
+// Then helper
+func (mmDeleteGuestCart *mGuestRepositoryMockDeleteGuestCart) When(ctx context.Context, token string) *GuestRepositoryMockDeleteGuestCartExpectation {
+	if mmDeleteGuestCart.mock.funcDeleteGuestCart != nil {
+		mmDeleteGuestCart.mock.t.Fatalf("GuestRepositoryMock.DeleteGuestCart mock is already set by Set")
+	}
+
+	expectation := &GuestRepositoryMockDeleteGuestCartExpectation{
+		mock:               mmDeleteGuestCart.mock,
+		params:             &GuestRepositoryMockDeleteGuestCartParams{ctx, token},
+		expectationOrigins: GuestRepositoryMockDeleteGuestCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteGuestCart.expectations = append(mmDeleteGuestCart.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GuestRepository.DeleteGuestCart return parameters for the expectation previously defined by the When method
+func (e *GuestRepositoryMockDeleteGuestCartExpectation) Then(err error) *GuestRepositoryMock {
+	e.results = &GuestRepositoryMockDeleteGuestCartResults{err}
+	return e.mock
+}
+
+// Times sets number of times GuestRepository.DeleteGuestCart should be invoked
+func (mmDeleteGuestCart *mGuestRepositoryMockDeleteGuestCart) Times(n uint64) *mGuestRepositoryMockDeleteGuestCart {
+	if n == 0 {
+		mmDeleteGuestCart.mock.t.Fatalf("Times of GuestRepositoryMock.DeleteGuestCart mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteGuestCart.expectedInvocations, n)
+	mmDeleteGuestCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteGuestCart
+}
+
+func (mmDeleteGuestCart *mGuestRepositoryMockDeleteGuestCart) invocationsDone() bool {
+	if len(mmDeleteGuestCart.expectations) == 0 && mmDeleteGuestCart.defaultExpectation == nil && mmDeleteGuestCart.mock.funcDeleteGuestCart == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteGuestCart.mock.afterDeleteGuestCartCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteGuestCart.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteGuestCart implements mm_cart.GuestRepository
+func (mmDeleteGuestCart *GuestRepositoryMock) DeleteGuestCart(ctx context.Context, token string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteGuestCart.beforeDeleteGuestCartCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteGuestCart.afterDeleteGuestCartCounter, 1)
+
+	mmDeleteGuestCart.t.Helper()
+
+	if mmDeleteGuestCart.inspectFuncDeleteGuestCart != nil {
+		mmDeleteGuestCart.inspectFuncDeleteGuestCart(ctx, token)
+	}
+
+	mm_params := GuestRepositoryMockDeleteGuestCartParams{ctx, token}
+
+	// Record call args
+	mmDeleteGuestCart.DeleteGuestCartMock.mutex.Lock()
+	mmDeleteGuestCart.DeleteGuestCartMock.callArgs = append(mmDeleteGuestCart.DeleteGuestCartMock.callArgs, &mm_params)
+	mmDeleteGuestCart.DeleteGuestCartMock.mutex.Unlock()
+
+	for _, e := range mmDeleteGuestCart.DeleteGuestCartMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteGuestCart.DeleteGuestCartMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteGuestCart.DeleteGuestCartMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteGuestCart.DeleteGuestCartMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteGuestCart.DeleteGuestCartMock.defaultExpectation.paramPtrs
+
+		mm_got := GuestRepositoryMockDeleteGuestCartParams{ctx, token}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteGuestCart.t.Errorf("GuestRepositoryMock.DeleteGuestCart got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteGuestCart.DeleteGuestCartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmDeleteGuestCart.t.Errorf("GuestRepositoryMock.DeleteGuestCart got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteGuestCart.DeleteGuestCartMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteGuestCart.t.Errorf("GuestRepositoryMock.DeleteGuestCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteGuestCart.DeleteGuestCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteGuestCart.DeleteGuestCartMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteGuestCart.t.Fatal("No results are set for the GuestRepositoryMock.DeleteGuestCart")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteGuestCart.funcDeleteGuestCart != nil {
+		return mmDeleteGuestCart.funcDeleteGuestCart(ctx, token)
+	}
+	mmDeleteGuestCart.t.Fatalf("Unexpected call to GuestRepositoryMock.DeleteGuestCart. %v %v", ctx, token)
+	return
+}
+
+// DeleteGuestCartAfterCounter returns a count of finished GuestRepositoryMock.DeleteGuestCart invocations
+func (mmDeleteGuestCart *GuestRepositoryMock) DeleteGuestCartAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteGuestCart.afterDeleteGuestCartCounter)
+}
+
+// DeleteGuestCartBeforeCounter returns a count of GuestRepositoryMock.DeleteGuestCart invocations
+func (mmDeleteGuestCart *GuestRepositoryMock) DeleteGuestCartBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteGuestCart.beforeDeleteGuestCartCounter)
+}
+
+// Calls returns a list of arguments used in each call to GuestRepositoryMock.DeleteGuestCart.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteGuestCart *mGuestRepositoryMockDeleteGuestCart) Calls() []*GuestRepositoryMockDeleteGuestCartParams {
+	mmDeleteGuestCart.mutex.RLock()
+
+	argCopy := make([]*GuestRepositoryMockDeleteGuestCartParams, len(mmDeleteGuestCart.callArgs))
+	copy(argCopy, mmDeleteGuestCart.callArgs)
+
+	mmDeleteGuestCart.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteGuestCartDone returns true if the count of the DeleteGuestCart invocations corresponds
+// the number of defined expectations
+func (m *GuestRepositoryMock) MinimockDeleteGuestCartDone() bool {
+	if m.DeleteGuestCartMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteGuestCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteGuestCartMock.invocationsDone()
+}
+
+// MinimockDeleteGuestCartInspect logs each unmet expectation
+func (m *GuestRepositoryMock) MinimockDeleteGuestCartInspect() {
+	for _, e := range m.DeleteGuestCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GuestRepositoryMock.DeleteGuestCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteGuestCartCounter := mm_atomic.LoadUint64(&m.afterDeleteGuestCartCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteGuestCartMock.defaultExpectation != nil && afterDeleteGuestCartCounter < 1 {
+		if m.DeleteGuestCartMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to GuestRepositoryMock.DeleteGuestCart at\n%s", m.DeleteGuestCartMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to GuestRepositoryMock.DeleteGuestCart at\n%s with params: %#v", m.DeleteGuestCartMock.defaultExpectation.expectationOrigins.origin, *m.DeleteGuestCartMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteGuestCart != nil && afterDeleteGuestCartCounter < 1 {
+		m.t.Errorf("Expected call to GuestRepositoryMock.DeleteGuestCart at\n%s", m.funcDeleteGuestCartOrigin)
+	}
+
+	if !m.DeleteGuestCartMock.invocationsDone() && afterDeleteGuestCartCounter > 0 {
+		m.t.Errorf("Expected %d calls to GuestRepositoryMock.DeleteGuestCart at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteGuestCartMock.expectedInvocations), m.DeleteGuestCartMock.expectedInvocationsOrigin, afterDeleteGuestCartCounter)
+	}
+}
+
+type mGuestRepositoryMockGetGuestCart struct {
+	optional           bool
+	mock               *GuestRepositoryMock
+	defaultExpectation *GuestRepositoryMockGetGuestCartExpectation
+	expectations       []*GuestRepositoryMockGetGuestCartExpectation
+
+	callArgs []*GuestRepositoryMockGetGuestCartParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// GuestRepositoryMockGetGuestCartExpectation specifies expectation struct of the GuestRepository.GetGuestCart
+type GuestRepositoryMockGetGuestCartExpectation struct {
+	mock               *GuestRepositoryMock
+	params             *GuestRepositoryMockGetGuestCartParams
+	paramPtrs          *GuestRepositoryMockGetGuestCartParamPtrs
+	expectationOrigins GuestRepositoryMockGetGuestCartExpectationOrigins
+	results            *GuestRepositoryMockGetGuestCartResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// GuestRepositoryMockGetGuestCartParams contains parameters of the GuestRepository.GetGuestCart
+type GuestRepositoryMockGetGuestCartParams struct {
+	ctx   context.Context
+	token string
+}
+
+// GuestRepositoryMockGetGuestCartParamPtrs contains pointers to parameters of the GuestRepository.GetGuestCart
+type GuestRepositoryMockGetGuestCartParamPtrs struct {
+	ctx   *context.Context
+	token *string
+}
+
+// GuestRepositoryMockGetGuestCartResults contains results of the GuestRepository.GetGuestCart
+type GuestRepositoryMockGetGuestCartResults struct {
+	m1  map[int64]uint16
+	err error
+}
+
+// GuestRepositoryMockGetGuestCartOrigins contains origins of expectations of the GuestRepository.GetGuestCart
+type GuestRepositoryMockGetGuestCartExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetGuestCart *mGuestRepositoryMockGetGuestCart) Optional() *mGuestRepositoryMockGetGuestCart {
+	mmGetGuestCart.optional = true
+	return mmGetGuestCart
+}
+
+// Expect sets up expected params for GuestRepository.GetGuestCart
+func (mmGetGuestCart *mGuestRepositoryMockGetGuestCart) Expect(ctx context.Context, token string) *mGuestRepositoryMockGetGuestCart {
+	if mmGetGuestCart.mock.funcGetGuestCart != nil {
+		mmGetGuestCart.mock.t.Fatalf("GuestRepositoryMock.GetGuestCart mock is already set by Set")
+	}
+
+	if mmGetGuestCart.defaultExpectation == nil {
+		mmGetGuestCart.defaultExpectation = &GuestRepositoryMockGetGuestCartExpectation{}
+	}
+
+	if mmGetGuestCart.defaultExpectation.paramPtrs != nil {
+		mmGetGuestCart.mock.t.Fatalf("GuestRepositoryMock.GetGuestCart mock is already set by ExpectParams functions")
+	}
+
+	mmGetGuestCart.defaultExpectation.params = &GuestRepositoryMockGetGuestCartParams{ctx, token}
+	mmGetGuestCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetGuestCart.expectations {
+		if minimock.Equal(e.params, mmGetGuestCart.defaultExpectation.params) {
+			mmGetGuestCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetGuestCart.defaultExpectation.params)
+		}
+	}
+
+	return mmGetGuestCart
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GuestRepository.GetGuestCart
+func (mmGetGuestCart *mGuestRepositoryMockGetGuestCart) ExpectCtxParam1(ctx context.Context) *mGuestRepositoryMockGetGuestCart {
+	if mmGetGuestCart.mock.funcGetGuestCart != nil {
+		mmGetGuestCart.mock.t.Fatalf("GuestRepositoryMock.GetGuestCart mock is already set by Set")
+	}
+
+	if mmGetGuestCart.defaultExpectation == nil {
+		mmGetGuestCart.defaultExpectation = &GuestRepositoryMockGetGuestCartExpectation{}
+	}
+
+	if mmGetGuestCart.defaultExpectation.params != nil {
+		mmGetGuestCart.mock.t.Fatalf("GuestRepositoryMock.GetGuestCart mock is already set by Expect")
+	}
+
+	if mmGetGuestCart.defaultExpectation.paramPtrs == nil {
+		mmGetGuestCart.defaultExpectation.paramPtrs = &GuestRepositoryMockGetGuestCartParamPtrs{}
+	}
+	mmGetGuestCart.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetGuestCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetGuestCart
+}
+
+// ExpectTokenParam2 sets up expected param token for GuestRepository.GetGuestCart
+func (mmGetGuestCart *mGuestRepositoryMockGetGuestCart) ExpectTokenParam2(token string) *mGuestRepositoryMockGetGuestCart {
+	if mmGetGuestCart.mock.funcGetGuestCart != nil {
+		mmGetGuestCart.mock.t.Fatalf("GuestRepositoryMock.GetGuestCart mock is already set by Set")
+	}
+
+	if mmGetGuestCart.defaultExpectation == nil {
+		mmGetGuestCart.defaultExpectation = &GuestRepositoryMockGetGuestCartExpectation{}
+	}
+
+	if mmGetGuestCart.defaultExpectation.params != nil {
+		mmGetGuestCart.mock.t.Fatalf("GuestRepositoryMock.GetGuestCart mock is already set by Expect")
+	}
+
+	if mmGetGuestCart.defaultExpectation.paramPtrs == nil {
+		mmGetGuestCart.defaultExpectation.paramPtrs = &GuestRepositoryMockGetGuestCartParamPtrs{}
+	}
+	mmGetGuestCart.defaultExpectation.paramPtrs.token = &token
+	mmGetGuestCart.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
+
+	return mmGetGuestCart
+}
+
+// Inspect accepts an inspector function that has same arguments as the GuestRepository.GetGuestCart
+func (mmGetGuestCart *mGuestRepositoryMockGetGuestCart) Inspect(f func(ctx context.Context, token string)) *mGuestRepositoryMockGetGuestCart {
+	if mmGetGuestCart.mock.inspectFuncGetGuestCart != nil {
+		mmGetGuestCart.mock.t.Fatalf("Inspect function is already set for GuestRepositoryMock.GetGuestCart")
+	}
+
+	mmGetGuestCart.mock.inspectFuncGetGuestCart = f
+
+	return mmGetGuestCart
+}
+
+// Return sets up results that will be returned by GuestRepository.GetGuestCart
+func (mmGetGuestCart *mGuestRepositoryMockGetGuestCart) Return(m1 map[int64]uint16, err error) *GuestRepositoryMock {
+	if mmGetGuestCart.mock.funcGetGuestCart != nil {
+		mmGetGuestCart.mock.t.Fatalf("GuestRepositoryMock.GetGuestCart mock is already set by Set")
+	}
+
+	if mmGetGuestCart.defaultExpectation == nil {
+		mmGetGuestCart.defaultExpectation = &GuestRepositoryMockGetGuestCartExpectation{mock: mmGetGuestCart.mock}
+	}
+	mmGetGuestCart.defaultExpectation.results = &GuestRepositoryMockGetGuestCartResults{m1, err}
+	mmGetGuestCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetGuestCart.mock
+}
+
+// Set uses given function f to mock the GuestRepository.GetGuestCart method
+func (mmGetGuestCart *mGuestRepositoryMockGetGuestCart) Set(f func(ctx context.Context, token string) (m1 map[int64]uint16, err error)) *GuestRepositoryMock {
+	if mmGetGuestCart.defaultExpectation != nil {
+		mmGetGuestCart.mock.t.Fatalf("Default expectation is already set for the GuestRepository.GetGuestCart method")
+	}
+
+	if len(mmGetGuestCart.expectations) > 0 {
+		mmGetGuestCart.mock.t.Fatalf("Some expectations are already set for the GuestRepository.GetGuestCart method")
+	}
+
+	mmGetGuestCart.mock.funcGetGuestCart = f
+	mmGetGuestCart.mock.funcGetGuestCartOrigin = minimock.CallerInfo(1)
+	return mmGetGuestCart.mock
+}
+
+// When sets expectation for the GuestRepository.GetGuestCart which will trigger the result defined by the following
+// Then helper
+func (mmGetGuestCart *mGuestRepositoryMockGetGuestCart) When(ctx context.Context, token string) *GuestRepositoryMockGetGuestCartExpectation {
+	if mmGetGuestCart.mock.funcGetGuestCart != nil {
+		mmGetGuestCart.mock.t.Fatalf("GuestRepositoryMock.GetGuestCart mock is already set by Set")
+	}
+
+	expectation := &GuestRepositoryMockGetGuestCartExpectation{
+		mock:               mmGetGuestCart.mock,
+		params:             &GuestRepositoryMockGetGuestCartParams{ctx, token},
+		expectationOrigins: GuestRepositoryMockGetGuestCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetGuestCart.expectations = append(mmGetGuestCart.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GuestRepository.GetGuestCart return parameters for the expectation previously defined by the When method
+func (e *GuestRepositoryMockGetGuestCartExpectation) Then(m1 map[int64]uint16, err error) *GuestRepositoryMock {
+	e.results = &GuestRepositoryMockGetGuestCartResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times GuestRepository.GetGuestCart should be invoked
+func (mmGetGuestCart *mGuestRepositoryMockGetGuestCart) Times(n uint64) *mGuestRepositoryMockGetGuestCart {
+	if n == 0 {
+		mmGetGuestCart.mock.t.Fatalf("Times of GuestRepositoryMock.GetGuestCart mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetGuestCart.expectedInvocations, n)
+	mmGetGuestCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetGuestCart
+}
+
+func (mmGetGuestCart *mGuestRepositoryMockGetGuestCart) invocationsDone() bool {
+	if len(mmGetGuestCart.expectations) == 0 && mmGetGuestCart.defaultExpectation == nil && mmGetGuestCart.mock.funcGetGuestCart == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetGuestCart.mock.afterGetGuestCartCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetGuestCart.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetGuestCart implements mm_cart.GuestRepository
+func (mmGetGuestCart *GuestRepositoryMock) GetGuestCart(ctx context.Context, token string) (m1 map[int64]uint16, err error) {
+	mm_atomic.AddUint64(&mmGetGuestCart.beforeGetGuestCartCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetGuestCart.afterGetGuestCartCounter, 1)
+
+	mmGetGuestCart.t.Helper()
+
+	if mmGetGuestCart.inspectFuncGetGuestCart != nil {
+		mmGetGuestCart.inspectFuncGetGuestCart(ctx, token)
+	}
+
+	mm_params := GuestRepositoryMockGetGuestCartParams{ctx, token}
+
+	// Record call args
+	mmGetGuestCart.GetGuestCartMock.mutex.Lock()
+	mmGetGuestCart.GetGuestCartMock.callArgs = append(mmGetGuestCart.GetGuestCartMock.callArgs, &mm_params)
+	mmGetGuestCart.GetGuestCartMock.mutex.Unlock()
+
+	for _, e := range mmGetGuestCart.GetGuestCartMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmGetGuestCart.GetGuestCartMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetGuestCart.GetGuestCartMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetGuestCart.GetGuestCartMock.defaultExpectation.params
+		mm_want_ptrs := mmGetGuestCart.GetGuestCartMock.defaultExpectation.paramPtrs
+
+		mm_got := GuestRepositoryMockGetGuestCartParams{ctx, token}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetGuestCart.t.Errorf("GuestRepositoryMock.GetGuestCart got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetGuestCart.GetGuestCartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmGetGuestCart.t.Errorf("GuestRepositoryMock.GetGuestCart got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetGuestCart.GetGuestCartMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetGuestCart.t.Errorf("GuestRepositoryMock.GetGuestCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetGuestCart.GetGuestCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetGuestCart.GetGuestCartMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetGuestCart.t.Fatal("No results are set for the GuestRepositoryMock.GetGuestCart")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmGetGuestCart.funcGetGuestCart != nil {
+		return mmGetGuestCart.funcGetGuestCart(ctx, token)
+	}
+	mmGetGuestCart.t.Fatalf("Unexpected call to GuestRepositoryMock.GetGuestCart. %v %v", ctx, token)
+	return
+}
+
+// GetGuestCartAfterCounter returns a count of finished GuestRepositoryMock.GetGuestCart invocations
+func (mmGetGuestCart *GuestRepositoryMock) GetGuestCartAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetGuestCart.afterGetGuestCartCounter)
+}
+
+// GetGuestCartBeforeCounter returns a count of GuestRepositoryMock.GetGuestCart invocations
+func (mmGetGuestCart *GuestRepositoryMock) GetGuestCartBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetGuestCart.beforeGetGuestCartCounter)
+}
+
+// Calls returns a list of arguments used in each call to GuestRepositoryMock.GetGuestCart.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetGuestCart *mGuestRepositoryMockGetGuestCart) Calls() []*GuestRepositoryMockGetGuestCartParams {
+	mmGetGuestCart.mutex.RLock()
+
+	argCopy := make([]*GuestRepositoryMockGetGuestCartParams, len(mmGetGuestCart.callArgs))
+	copy(argCopy, mmGetGuestCart.callArgs)
+
+	mmGetGuestCart.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetGuestCartDone returns true if the count of the GetGuestCart invocations corresponds
+// the number of defined expectations
+func (m *GuestRepositoryMock) MinimockGetGuestCartDone() bool {
+	if m.GetGuestCartMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetGuestCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetGuestCartMock.invocationsDone()
+}
+
+// MinimockGetGuestCartInspect logs each unmet expectation
+func (m *GuestRepositoryMock) MinimockGetGuestCartInspect() {
+	for _, e := range m.GetGuestCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GuestRepositoryMock.GetGuestCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetGuestCartCounter := mm_atomic.LoadUint64(&m.afterGetGuestCartCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetGuestCartMock.defaultExpectation != nil && afterGetGuestCartCounter < 1 {
+		if m.GetGuestCartMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to GuestRepositoryMock.GetGuestCart at\n%s", m.GetGuestCartMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to GuestRepositoryMock.GetGuestCart at\n%s with params: %#v", m.GetGuestCartMock.defaultExpectation.expectationOrigins.origin, *m.GetGuestCartMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetGuestCart != nil && afterGetGuestCartCounter < 1 {
+		m.t.Errorf("Expected call to GuestRepositoryMock.GetGuestCart at\n%s", m.funcGetGuestCartOrigin)
+	}
+
+	if !m.GetGuestCartMock.invocationsDone() && afterGetGuestCartCounter > 0 {
+		m.t.Errorf("Expected %d calls to GuestRepositoryMock.GetGuestCart at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetGuestCartMock.expectedInvocations), m.GetGuestCartMock.expectedInvocationsOrigin, afterGetGuestCartCounter)
+	}
+}
+
+type mGuestRepositoryMockRemoveFromGuestCart struct {
+	optional           bool
+	mock               *GuestRepositoryMock
+	defaultExpectation *GuestRepositoryMockRemoveFromGuestCartExpectation
+	expectations       []*GuestRepositoryMockRemoveFromGuestCartExpectation
+
+	callArgs []*GuestRepositoryMockRemoveFromGuestCartParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// GuestRepositoryMockRemoveFromGuestCartExpectation specifies expectation struct of the GuestRepository.RemoveFromGuestCart
+type GuestRepositoryMockRemoveFromGuestCartExpectation struct {
+	mock               *GuestRepositoryMock
+	params             *GuestRepositoryMockRemoveFromGuestCartParams
+	paramPtrs          *GuestRepositoryMockRemoveFromGuestCartParamPtrs
+	expectationOrigins GuestRepositoryMockRemoveFromGuestCartExpectationOrigins
+	results            *GuestRepositoryMockRemoveFromGuestCartResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// GuestRepositoryMockRemoveFromGuestCartParams contains parameters of the GuestRepository.RemoveFromGuestCart
+type GuestRepositoryMockRemoveFromGuestCartParams struct {
+	ctx   context.Context
+	token string
+	skuID int64
+}
+
+// GuestRepositoryMockRemoveFromGuestCartParamPtrs contains pointers to parameters of the GuestRepository.RemoveFromGuestCart
+type GuestRepositoryMockRemoveFromGuestCartParamPtrs struct {
+	ctx   *context.Context
+	token *string
+	skuID *int64
+}
+
+// GuestRepositoryMockRemoveFromGuestCartResults contains results of the GuestRepository.RemoveFromGuestCart
+type GuestRepositoryMockRemoveFromGuestCartResults struct {
+	err error
+}
+
+// GuestRepositoryMockRemoveFromGuestCartOrigins contains origins of expectations of the GuestRepository.RemoveFromGuestCart
+type GuestRepositoryMockRemoveFromGuestCartExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originToken string
+	originSkuID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveFromGuestCart *mGuestRepositoryMockRemoveFromGuestCart) Optional() *mGuestRepositoryMockRemoveFromGuestCart {
+	mmRemoveFromGuestCart.optional = true
+	return mmRemoveFromGuestCart
+}
+
+// Expect sets up expected params for GuestRepository.RemoveFromGuestCart
+func (mmRemoveFromGuestCart *mGuestRepositoryMockRemoveFromGuestCart) Expect(ctx context.Context, token string, skuID int64) *mGuestRepositoryMockRemoveFromGuestCart {
+	if mmRemoveFromGuestCart.mock.funcRemoveFromGuestCart != nil {
+		mmRemoveFromGuestCart.mock.t.Fatalf("GuestRepositoryMock.RemoveFromGuestCart mock is already set by Set")
+	}
+
+	if mmRemoveFromGuestCart.defaultExpectation == nil {
+		mmRemoveFromGuestCart.defaultExpectation = &GuestRepositoryMockRemoveFromGuestCartExpectation{}
+	}
+
+	if mmRemoveFromGuestCart.defaultExpectation.paramPtrs != nil {
+		mmRemoveFromGuestCart.mock.t.Fatalf("GuestRepositoryMock.RemoveFromGuestCart mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveFromGuestCart.defaultExpectation.params = &GuestRepositoryMockRemoveFromGuestCartParams{ctx, token, skuID}
+	mmRemoveFromGuestCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemoveFromGuestCart.expectations {
+		if minimock.Equal(e.params, mmRemoveFromGuestCart.defaultExpectation.params) {
+			mmRemoveFromGuestCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveFromGuestCart.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveFromGuestCart
+}
+
+// ExpectCtxParam1 sets up expected param ctx for GuestRepository.RemoveFromGuestCart
+func (mmRemoveFromGuestCart *mGuestRepositoryMockRemoveFromGuestCart) ExpectCtxParam1(ctx context.Context) *mGuestRepositoryMockRemoveFromGuestCart {
+	if mmRemoveFromGuestCart.mock.funcRemoveFromGuestCart != nil {
+		mmRemoveFromGuestCart.mock.t.Fatalf("GuestRepositoryMock.RemoveFromGuestCart mock is already set by Set")
+	}
+
+	if mmRemoveFromGuestCart.defaultExpectation == nil {
+		mmRemoveFromGuestCart.defaultExpectation = &GuestRepositoryMockRemoveFromGuestCartExpectation{}
+	}
+
+	if mmRemoveFromGuestCart.defaultExpectation.params != nil {
+		mmRemoveFromGuestCart.mock.t.Fatalf("GuestRepositoryMock.RemoveFromGuestCart mock is already set by Expect")
+	}
+
+	if mmRemoveFromGuestCart.defaultExpectation.paramPtrs == nil {
+		mmRemoveFromGuestCart.defaultExpectation.paramPtrs = &GuestRepositoryMockRemoveFromGuestCartParamPtrs{}
+	}
+	mmRemoveFromGuestCart.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveFromGuestCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveFromGuestCart
+}
+
+// ExpectTokenParam2 sets up expected param token for GuestRepository.RemoveFromGuestCart
+func (mmRemoveFromGuestCart *mGuestRepositoryMockRemoveFromGuestCart) ExpectTokenParam2(token string) *mGuestRepositoryMockRemoveFromGuestCart {
+	if mmRemoveFromGuestCart.mock.funcRemoveFromGuestCart != nil {
+		mmRemoveFromGuestCart.mock.t.Fatalf("GuestRepositoryMock.RemoveFromGuestCart mock is already set by Set")
+	}
+
+	if mmRemoveFromGuestCart.defaultExpectation == nil {
+		mmRemoveFromGuestCart.defaultExpectation = &GuestRepositoryMockRemoveFromGuestCartExpectation{}
+	}
+
+	if mmRemoveFromGuestCart.defaultExpectation.params != nil {
+		mmRemoveFromGuestCart.mock.t.Fatalf("GuestRepositoryMock.RemoveFromGuestCart mock is already set by Expect")
+	}
+
+	if mmRemoveFromGuestCart.defaultExpectation.paramPtrs == nil {
+		mmRemoveFromGuestCart.defaultExpectation.paramPtrs = &GuestRepositoryMockRemoveFromGuestCartParamPtrs{}
+	}
+	mmRemoveFromGuestCart.defaultExpectation.paramPtrs.token = &token
+	mmRemoveFromGuestCart.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
+
+	return mmRemoveFromGuestCart
+}
+
+// ExpectSkuIDParam3 sets up expected param skuID for GuestRepository.RemoveFromGuestCart
+func (mmRemoveFromGuestCart *mGuestRepositoryMockRemoveFromGuestCart) ExpectSkuIDParam3(skuID int64) *mGuestRepositoryMockRemoveFromGuestCart {
+	if mmRemoveFromGuestCart.mock.funcRemoveFromGuestCart != nil {
+		mmRemoveFromGuestCart.mock.t.Fatalf("GuestRepositoryMock.RemoveFromGuestCart mock is already set by Set")
+	}
+
+	if mmRemoveFromGuestCart.defaultExpectation == nil {
+		mmRemoveFromGuestCart.defaultExpectation = &GuestRepositoryMockRemoveFromGuestCartExpectation{}
+	}
+
+	if mmRemoveFromGuestCart.defaultExpectation.params != nil {
+		mmRemoveFromGuestCart.mock.t.Fatalf("GuestRepositoryMock.RemoveFromGuestCart mock is already set by Expect")
+	}
+
+	if mmRemoveFromGuestCart.defaultExpectation.paramPtrs == nil {
+		mmRemoveFromGuestCart.defaultExpectation.paramPtrs = &GuestRepositoryMockRemoveFromGuestCartParamPtrs{}
+	}
+	mmRemoveFromGuestCart.defaultExpectation.paramPtrs.skuID = &skuID
+	mmRemoveFromGuestCart.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmRemoveFromGuestCart
+}
+
+// Inspect accepts an inspector function that has same arguments as the GuestRepository.RemoveFromGuestCart
+func (mmRemoveFromGuestCart *mGuestRepositoryMockRemoveFromGuestCart) Inspect(f func(ctx context.Context, token string, skuID int64)) *mGuestRepositoryMockRemoveFromGuestCart {
+	if mmRemoveFromGuestCart.mock.inspectFuncRemoveFromGuestCart != nil {
+		mmRemoveFromGuestCart.mock.t.Fatalf("Inspect function is already set for GuestRepositoryMock.RemoveFromGuestCart")
+	}
+
+	mmRemoveFromGuestCart.mock.inspectFuncRemoveFromGuestCart = f
+
+	return mmRemoveFromGuestCart
+}
+
+// Return sets up results that will be returned by GuestRepository.RemoveFromGuestCart
+func (mmRemoveFromGuestCart *mGuestRepositoryMockRemoveFromGuestCart) Return(err error) *GuestRepositoryMock {
+	if mmRemoveFromGuestCart.mock.funcRemoveFromGuestCart != nil {
+		mmRemoveFromGuestCart.mock.t.Fatalf("GuestRepositoryMock.RemoveFromGuestCart mock is already set by Set")
+	}
+
+	if mmRemoveFromGuestCart.defaultExpectation == nil {
+		mmRemoveFromGuestCart.defaultExpectation = &GuestRepositoryMockRemoveFromGuestCartExpectation{mock: mmRemoveFromGuestCart.mock}
+	}
+	mmRemoveFromGuestCart.defaultExpectation.results = &GuestRepositoryMockRemoveFromGuestCartResults{err}
+	mmRemoveFromGuestCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemoveFromGuestCart.mock
+}
+
+// Set uses given function f to mock the GuestRepository.RemoveFromGuestCart method
+func (mmRemoveFromGuestCart *mGuestRepositoryMockRemoveFromGuestCart) Set(f func(ctx context.Context, token string, skuID int64) (err error)) *GuestRepositoryMock {
+	if mmRemoveFromGuestCart.defaultExpectation != nil {
+		mmRemoveFromGuestCart.mock.t.Fatalf("Default expectation is already set for the GuestRepository.RemoveFromGuestCart method")
+	}
+
+	if len(mmRemoveFromGuestCart.expectations) > 0 {
+		mmRemoveFromGuestCart.mock.t.Fatalf("Some expectations are already set for the GuestRepository.RemoveFromGuestCart method")
+	}
+
+	mmRemoveFromGuestCart.mock.funcRemoveFromGuestCart = f
+	mmRemoveFromGuestCart.mock.funcRemoveFromGuestCartOrigin = minimock.CallerInfo(1)
+	return mmRemoveFromGuestCart.mock
+}
+
+// When sets expectation for the GuestRepository.RemoveFromGuestCart which will trigger the result defined by the following
+// Then helper
+func (mmRemoveFromGuestCart *mGuestRepositoryMockRemoveFromGuestCart) When(ctx context.Context, token string, skuID int64) *GuestRepositoryMockRemoveFromGuestCartExpectation {
+	if mmRemoveFromGuestCart.mock.funcRemoveFromGuestCart != nil {
+		mmRemoveFromGuestCart.mock.t.Fatalf("GuestRepositoryMock.RemoveFromGuestCart mock is already set by Set")
+	}
+
+	expectation := &GuestRepositoryMockRemoveFromGuestCartExpectation{
+		mock:               mmRemoveFromGuestCart.mock,
+		params:             &GuestRepositoryMockRemoveFromGuestCartParams{ctx, token, skuID},
+		expectationOrigins: GuestRepositoryMockRemoveFromGuestCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemoveFromGuestCart.expectations = append(mmRemoveFromGuestCart.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GuestRepository.RemoveFromGuestCart return parameters for the expectation previously defined by the When method
+func (e *GuestRepositoryMockRemoveFromGuestCartExpectation) Then(err error) *GuestRepositoryMock {
+	e.results = &GuestRepositoryMockRemoveFromGuestCartResults{err}
+	return e.mock
+}
+
+// Times sets number of times GuestRepository.RemoveFromGuestCart should be invoked
+func (mmRemoveFromGuestCart *mGuestRepositoryMockRemoveFromGuestCart) Times(n uint64) *mGuestRepositoryMockRemoveFromGuestCart {
+	if n == 0 {
+		mmRemoveFromGuestCart.mock.t.Fatalf("Times of GuestRepositoryMock.RemoveFromGuestCart mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveFromGuestCart.expectedInvocations, n)
+	mmRemoveFromGuestCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemoveFromGuestCart
+}
+
+func (mmRemoveFromGuestCart *mGuestRepositoryMockRemoveFromGuestCart) invocationsDone() bool {
+	if len(mmRemoveFromGuestCart.expectations) == 0 && mmRemoveFromGuestCart.defaultExpectation == nil && mmRemoveFromGuestCart.mock.funcRemoveFromGuestCart == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveFromGuestCart.mock.afterRemoveFromGuestCartCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveFromGuestCart.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveFromGuestCart implements mm_cart.GuestRepository
+func (mmRemoveFromGuestCart *GuestRepositoryMock) RemoveFromGuestCart(ctx context.Context, token string, skuID int64) (err error) {
+	mm_atomic.AddUint64(&mmRemoveFromGuestCart.beforeRemoveFromGuestCartCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveFromGuestCart.afterRemoveFromGuestCartCounter, 1)
+
+	mmRemoveFromGuestCart.t.Helper()
+
+	if mmRemoveFromGuestCart.inspectFuncRemoveFromGuestCart != nil {
+		mmRemoveFromGuestCart.inspectFuncRemoveFromGuestCart(ctx, token, skuID)
+	}
+
+	mm_params := GuestRepositoryMockRemoveFromGuestCartParams{ctx, token, skuID}
+
+	// Record call args
+	mmRemoveFromGuestCart.RemoveFromGuestCartMock.mutex.Lock()
+	mmRemoveFromGuestCart.RemoveFromGuestCartMock.callArgs = append(mmRemoveFromGuestCart.RemoveFromGuestCartMock.callArgs, &mm_params)
+	mmRemoveFromGuestCart.RemoveFromGuestCartMock.mutex.Unlock()
+
+	for _, e := range mmRemoveFromGuestCart.RemoveFromGuestCartMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemoveFromGuestCart.RemoveFromGuestCartMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveFromGuestCart.RemoveFromGuestCartMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveFromGuestCart.RemoveFromGuestCartMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveFromGuestCart.RemoveFromGuestCartMock.defaultExpectation.paramPtrs
+
+		mm_got := GuestRepositoryMockRemoveFromGuestCartParams{ctx, token, skuID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveFromGuestCart.t.Errorf("GuestRepositoryMock.RemoveFromGuestCart got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveFromGuestCart.RemoveFromGuestCartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmRemoveFromGuestCart.t.Errorf("GuestRepositoryMock.RemoveFromGuestCart got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveFromGuestCart.RemoveFromGuestCartMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmRemoveFromGuestCart.t.Errorf("GuestRepositoryMock.RemoveFromGuestCart got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveFromGuestCart.RemoveFromGuestCartMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveFromGuestCart.t.Errorf("GuestRepositoryMock.RemoveFromGuestCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemoveFromGuestCart.RemoveFromGuestCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveFromGuestCart.RemoveFromGuestCartMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveFromGuestCart.t.Fatal("No results are set for the GuestRepositoryMock.RemoveFromGuestCart")
+		}
+		return (*mm_results).err
+	}
+	if mmRemoveFromGuestCart.funcRemoveFromGuestCart != nil {
+		return mmRemoveFromGuestCart.funcRemoveFromGuestCart(ctx, token, skuID)
+	}
+	mmRemoveFromGuestCart.t.Fatalf("Unexpected call to GuestRepositoryMock.RemoveFromGuestCart. %v %v %v", ctx, token, skuID)
+	return
+}
+
+// RemoveFromGuestCartAfterCounter returns a count of finished GuestRepositoryMock.RemoveFromGuestCart invocations
+func (mmRemoveFromGuestCart *GuestRepositoryMock) RemoveFromGuestCartAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveFromGuestCart.afterRemoveFromGuestCartCounter)
+}
+
+// RemoveFromGuestCartBeforeCounter returns a count of GuestRepositoryMock.RemoveFromGuestCart invocations
+func (mmRemoveFromGuestCart *GuestRepositoryMock) RemoveFromGuestCartBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveFromGuestCart.beforeRemoveFromGuestCartCounter)
+}
+
+// Calls returns a list of arguments used in each call to GuestRepositoryMock.RemoveFromGuestCart.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveFromGuestCart *mGuestRepositoryMockRemoveFromGuestCart) Calls() []*GuestRepositoryMockRemoveFromGuestCartParams {
+	mmRemoveFromGuestCart.mutex.RLock()
+
+	argCopy := make([]*GuestRepositoryMockRemoveFromGuestCartParams, len(mmRemoveFromGuestCart.callArgs))
+	copy(argCopy, mmRemoveFromGuestCart.callArgs)
+
+	mmRemoveFromGuestCart.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveFromGuestCartDone returns true if the count of the RemoveFromGuestCart invocations corresponds
+// the number of defined expectations
+func (m *GuestRepositoryMock) MinimockRemoveFromGuestCartDone() bool {
+	if m.RemoveFromGuestCartMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveFromGuestCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveFromGuestCartMock.invocationsDone()
+}
+
+// MinimockRemoveFromGuestCartInspect logs each unmet expectation
+func (m *GuestRepositoryMock) MinimockRemoveFromGuestCartInspect() {
+	for _, e := range m.RemoveFromGuestCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GuestRepositoryMock.RemoveFromGuestCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemoveFromGuestCartCounter := mm_atomic.LoadUint64(&m.afterRemoveFromGuestCartCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveFromGuestCartMock.defaultExpectation != nil && afterRemoveFromGuestCartCounter < 1 {
+		if m.RemoveFromGuestCartMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to GuestRepositoryMock.RemoveFromGuestCart at\n%s", m.RemoveFromGuestCartMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to GuestRepositoryMock.RemoveFromGuestCart at\n%s with params: %#v", m.RemoveFromGuestCartMock.defaultExpectation.expectationOrigins.origin, *m.RemoveFromGuestCartMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveFromGuestCart != nil && afterRemoveFromGuestCartCounter < 1 {
+		m.t.Errorf("Expected call to GuestRepositoryMock.RemoveFromGuestCart at\n%s", m.funcRemoveFromGuestCartOrigin)
+	}
+
+	if !m.RemoveFromGuestCartMock.invocationsDone() && afterRemoveFromGuestCartCounter > 0 {
+		m.t.Errorf("Expected %d calls to GuestRepositoryMock.RemoveFromGuestCart at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveFromGuestCartMock.expectedInvocations), m.RemoveFromGuestCartMock.expectedInvocationsOrigin, afterRemoveFromGuestCartCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *GuestRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddToGuestCartInspect()
+
+			m.MinimockDeleteGuestCartInspect()
+
+			m.MinimockGetGuestCartInspect()
+
+			m.MinimockRemoveFromGuestCartInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *GuestRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *GuestRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddToGuestCartDone() &&
+		m.MinimockDeleteGuestCartDone() &&
+		m.MinimockGetGuestCartDone() &&
+		m.MinimockRemoveFromGuestCartDone()
+}
@@ -11,6 +11,7 @@ import (
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
+	"github.com/vestamart/cart/internal/domain"
 )
 
 // CartRepositoryMock implements mm_cart.Repository
@@ -25,6 +26,20 @@ type CartRepositoryMock struct {
 	beforeAddToCartCounter uint64
 	AddToCartMock          mCartRepositoryMockAddToCart
 
+	funcAddToList          func(ctx context.Context, userID uint64, list string, skuID int64, count uint16) (err error)
+	funcAddToListOrigin    string
+	inspectFuncAddToList   func(ctx context.Context, userID uint64, list string, skuID int64, count uint16)
+	afterAddToListCounter  uint64
+	beforeAddToListCounter uint64
+	AddToListMock          mCartRepositoryMockAddToList
+
+	funcCheckoutItems          func(ctx context.Context, userID uint64, list string, items map[int64]uint16, orderID int64) (err error)
+	funcCheckoutItemsOrigin    string
+	inspectFuncCheckoutItems   func(ctx context.Context, userID uint64, list string, items map[int64]uint16, orderID int64)
+	afterCheckoutItemsCounter  uint64
+	beforeCheckoutItemsCounter uint64
+	CheckoutItemsMock          mCartRepositoryMockCheckoutItems
+
 	funcClearCart          func(ctx context.Context, userID uint64) (err error)
 	funcClearCartOrigin    string
 	inspectFuncClearCart   func(ctx context.Context, userID uint64)
@@ -32,6 +47,13 @@ type CartRepositoryMock struct {
 	beforeClearCartCounter uint64
 	ClearCartMock          mCartRepositoryMockClearCart
 
+	funcDeleteList          func(ctx context.Context, userID uint64, list string) (err error)
+	funcDeleteListOrigin    string
+	inspectFuncDeleteList   func(ctx context.Context, userID uint64, list string)
+	afterDeleteListCounter  uint64
+	beforeDeleteListCounter uint64
+	DeleteListMock          mCartRepositoryMockDeleteList
+
 	funcGetCart          func(ctx context.Context, userID uint64) (m1 map[int64]uint16, err error)
 	funcGetCartOrigin    string
 	inspectFuncGetCart   func(ctx context.Context, userID uint64)
@@ -39,12 +61,75 @@ type CartRepositoryMock struct {
 	beforeGetCartCounter uint64
 	GetCartMock          mCartRepositoryMockGetCart
 
+	funcGetCoupon          func(ctx context.Context, userID uint64) (s1 string, err error)
+	funcGetCouponOrigin    string
+	inspectFuncGetCoupon   func(ctx context.Context, userID uint64)
+	afterGetCouponCounter  uint64
+	beforeGetCouponCounter uint64
+	GetCouponMock          mCartRepositoryMockGetCoupon
+
+	funcGetList          func(ctx context.Context, userID uint64, list string) (m1 map[int64]uint16, err error)
+	funcGetListOrigin    string
+	inspectFuncGetList   func(ctx context.Context, userID uint64, list string)
+	afterGetListCounter  uint64
+	beforeGetListCounter uint64
+	GetListMock          mCartRepositoryMockGetList
+
+	funcGetVersion          func(ctx context.Context, userID uint64) (u1 uint64, err error)
+	funcGetVersionOrigin    string
+	inspectFuncGetVersion   func(ctx context.Context, userID uint64)
+	afterGetVersionCounter  uint64
+	beforeGetVersionCounter uint64
+	GetVersionMock          mCartRepositoryMockGetVersion
+
+	funcLists          func(ctx context.Context, userID uint64) (la1 []domain.ListSummary, err error)
+	funcListsOrigin    string
+	inspectFuncLists   func(ctx context.Context, userID uint64)
+	afterListsCounter  uint64
+	beforeListsCounter uint64
+	ListsMock          mCartRepositoryMockLists
+
+	funcMergeCart          func(ctx context.Context, userID uint64, items map[int64]uint16) (err error)
+	funcMergeCartOrigin    string
+	inspectFuncMergeCart   func(ctx context.Context, userID uint64, items map[int64]uint16)
+	afterMergeCartCounter  uint64
+	beforeMergeCartCounter uint64
+	MergeCartMock          mCartRepositoryMockMergeCart
+
+	funcMoveItem          func(ctx context.Context, userID uint64, from string, to string, skuID int64, count uint16) (u1 uint16, err error)
+	funcMoveItemOrigin    string
+	inspectFuncMoveItem   func(ctx context.Context, userID uint64, from string, to string, skuID int64, count uint16)
+	afterMoveItemCounter  uint64
+	beforeMoveItemCounter uint64
+	MoveItemMock          mCartRepositoryMockMoveItem
+
+	funcRemoveCoupon          func(ctx context.Context, userID uint64) (err error)
+	funcRemoveCouponOrigin    string
+	inspectFuncRemoveCoupon   func(ctx context.Context, userID uint64)
+	afterRemoveCouponCounter  uint64
+	beforeRemoveCouponCounter uint64
+	RemoveCouponMock          mCartRepositoryMockRemoveCoupon
+
 	funcRemoveFromCart          func(ctx context.Context, skuID int64, userID uint64) (err error)
 	funcRemoveFromCartOrigin    string
 	inspectFuncRemoveFromCart   func(ctx context.Context, skuID int64, userID uint64)
 	afterRemoveFromCartCounter  uint64
 	beforeRemoveFromCartCounter uint64
 	RemoveFromCartMock          mCartRepositoryMockRemoveFromCart
+
+	funcRemoveFromList          func(ctx context.Context, userID uint64, list string, skuID int64) (err error)
+	funcRemoveFromListOrigin    string
+	inspectFuncRemoveFromList   func(ctx context.Context, userID uint64, list string, skuID int64)
+	afterRemoveFromListCounter  uint64
+	beforeRemoveFromListCounter uint64
+	RemoveFromListMock          mCartRepositoryMockRemoveFromList
+
+	funcSetCoupon          func(ctx context.Context, userID uint64, code string) (err error)
+	funcSetCouponOrigin    string
+	inspectFuncSetCoupon   func(ctx context.Context, userID uint64, code string)
+	afterSetCouponCounter  uint64
+	beforeSetCouponCounter uint64
+	SetCouponMock          mCartRepositoryMockSetCoupon
 }
 
 // NewCartRepositoryMock returns a mock for mm_cart.Repository
@@ -58,15 +143,51 @@ func NewCartRepositoryMock(t minimock.Tester) *CartRepositoryMock {
 	m.AddToCartMock = mCartRepositoryMockAddToCart{mock: m}
 	m.AddToCartMock.callArgs = []*CartRepositoryMockAddToCartParams{}
 
+	m.AddToListMock = mCartRepositoryMockAddToList{mock: m}
+	m.AddToListMock.callArgs = []*CartRepositoryMockAddToListParams{}
+
+	m.CheckoutItemsMock = mCartRepositoryMockCheckoutItems{mock: m}
+	m.CheckoutItemsMock.callArgs = []*CartRepositoryMockCheckoutItemsParams{}
+
 	m.ClearCartMock = mCartRepositoryMockClearCart{mock: m}
 	m.ClearCartMock.callArgs = []*CartRepositoryMockClearCartParams{}
 
+	m.DeleteListMock = mCartRepositoryMockDeleteList{mock: m}
+	m.DeleteListMock.callArgs = []*CartRepositoryMockDeleteListParams{}
+
 	m.GetCartMock = mCartRepositoryMockGetCart{mock: m}
 	m.GetCartMock.callArgs = []*CartRepositoryMockGetCartParams{}
 
+	m.GetCouponMock = mCartRepositoryMockGetCoupon{mock: m}
+	m.GetCouponMock.callArgs = []*CartRepositoryMockGetCouponParams{}
+
+	m.GetListMock = mCartRepositoryMockGetList{mock: m}
+	m.GetListMock.callArgs = []*CartRepositoryMockGetListParams{}
+
+	m.GetVersionMock = mCartRepositoryMockGetVersion{mock: m}
+	m.GetVersionMock.callArgs = []*CartRepositoryMockGetVersionParams{}
+
+	m.ListsMock = mCartRepositoryMockLists{mock: m}
+	m.ListsMock.callArgs = []*CartRepositoryMockListsParams{}
+
+	m.MergeCartMock = mCartRepositoryMockMergeCart{mock: m}
+	m.MergeCartMock.callArgs = []*CartRepositoryMockMergeCartParams{}
+
+	m.MoveItemMock = mCartRepositoryMockMoveItem{mock: m}
+	m.MoveItemMock.callArgs = []*CartRepositoryMockMoveItemParams{}
+
+	m.RemoveCouponMock = mCartRepositoryMockRemoveCoupon{mock: m}
+	m.RemoveCouponMock.callArgs = []*CartRepositoryMockRemoveCouponParams{}
+
 	m.RemoveFromCartMock = mCartRepositoryMockRemoveFromCart{mock: m}
 	m.RemoveFromCartMock.callArgs = []*CartRepositoryMockRemoveFromCartParams{}
 
+	m.RemoveFromListMock = mCartRepositoryMockRemoveFromList{mock: m}
+	m.RemoveFromListMock.callArgs = []*CartRepositoryMockRemoveFromListParams{}
+
+	m.SetCouponMock = mCartRepositoryMockSetCoupon{mock: m}
+	m.SetCouponMock.callArgs = []*CartRepositoryMockSetCouponParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -476,52 +597,61 @@ func (m *CartRepositoryMock) MinimockAddToCartInspect() {
 	}
 }
 
-type mCartRepositoryMockClearCart struct {
+type mCartRepositoryMockAddToList struct {
 	optional           bool
 	mock               *CartRepositoryMock
-	defaultExpectation *CartRepositoryMockClearCartExpectation
-	expectations       []*CartRepositoryMockClearCartExpectation
+	defaultExpectation *CartRepositoryMockAddToListExpectation
+	expectations       []*CartRepositoryMockAddToListExpectation
 
-	callArgs []*CartRepositoryMockClearCartParams
+	callArgs []*CartRepositoryMockAddToListParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// CartRepositoryMockClearCartExpectation specifies expectation struct of the Repository.ClearCart
-type CartRepositoryMockClearCartExpectation struct {
+// CartRepositoryMockAddToListExpectation specifies expectation struct of the Repository.AddToList
+type CartRepositoryMockAddToListExpectation struct {
 	mock               *CartRepositoryMock
-	params             *CartRepositoryMockClearCartParams
-	paramPtrs          *CartRepositoryMockClearCartParamPtrs
-	expectationOrigins CartRepositoryMockClearCartExpectationOrigins
-	results            *CartRepositoryMockClearCartResults
+	params             *CartRepositoryMockAddToListParams
+	paramPtrs          *CartRepositoryMockAddToListParamPtrs
+	expectationOrigins CartRepositoryMockAddToListExpectationOrigins
+	results            *CartRepositoryMockAddToListResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// CartRepositoryMockClearCartParams contains parameters of the Repository.ClearCart
-type CartRepositoryMockClearCartParams struct {
+// CartRepositoryMockAddToListParams contains parameters of the Repository.AddToList
+type CartRepositoryMockAddToListParams struct {
 	ctx    context.Context
 	userID uint64
+	list   string
+	skuID  int64
+	count  uint16
 }
 
-// CartRepositoryMockClearCartParamPtrs contains pointers to parameters of the Repository.ClearCart
-type CartRepositoryMockClearCartParamPtrs struct {
+// CartRepositoryMockAddToListParamPtrs contains pointers to parameters of the Repository.AddToList
+type CartRepositoryMockAddToListParamPtrs struct {
 	ctx    *context.Context
 	userID *uint64
+	list   *string
+	skuID  *int64
+	count  *uint16
 }
 
-// CartRepositoryMockClearCartResults contains results of the Repository.ClearCart
-type CartRepositoryMockClearCartResults struct {
+// CartRepositoryMockAddToListResults contains results of the Repository.AddToList
+type CartRepositoryMockAddToListResults struct {
 	err error
 }
 
-// CartRepositoryMockClearCartOrigins contains origins of expectations of the Repository.ClearCart
-type CartRepositoryMockClearCartExpectationOrigins struct {
+// CartRepositoryMockAddToListOrigins contains origins of expectations of the Repository.AddToList
+type CartRepositoryMockAddToListExpectationOrigins struct {
 	origin       string
 	originCtx    string
 	originUserID string
+	originList   string
+	originSkuID  string
+	originCount  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -529,342 +659,434 @@ type CartRepositoryMockClearCartExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmClearCart *mCartRepositoryMockClearCart) Optional() *mCartRepositoryMockClearCart {
-	mmClearCart.optional = true
-	return mmClearCart
+func (mmAddToList *mCartRepositoryMockAddToList) Optional() *mCartRepositoryMockAddToList {
+	mmAddToList.optional = true
+	return mmAddToList
 }
 
-// Expect sets up expected params for Repository.ClearCart
-func (mmClearCart *mCartRepositoryMockClearCart) Expect(ctx context.Context, userID uint64) *mCartRepositoryMockClearCart {
-	if mmClearCart.mock.funcClearCart != nil {
-		mmClearCart.mock.t.Fatalf("CartRepositoryMock.ClearCart mock is already set by Set")
+// Expect sets up expected params for Repository.AddToList
+func (mmAddToList *mCartRepositoryMockAddToList) Expect(ctx context.Context, userID uint64, list string, skuID int64, count uint16) *mCartRepositoryMockAddToList {
+	if mmAddToList.mock.funcAddToList != nil {
+		mmAddToList.mock.t.Fatalf("CartRepositoryMock.AddToList mock is already set by Set")
 	}
 
-	if mmClearCart.defaultExpectation == nil {
-		mmClearCart.defaultExpectation = &CartRepositoryMockClearCartExpectation{}
+	if mmAddToList.defaultExpectation == nil {
+		mmAddToList.defaultExpectation = &CartRepositoryMockAddToListExpectation{}
 	}
 
-	if mmClearCart.defaultExpectation.paramPtrs != nil {
-		mmClearCart.mock.t.Fatalf("CartRepositoryMock.ClearCart mock is already set by ExpectParams functions")
+	if mmAddToList.defaultExpectation.paramPtrs != nil {
+		mmAddToList.mock.t.Fatalf("CartRepositoryMock.AddToList mock is already set by ExpectParams functions")
 	}
 
-	mmClearCart.defaultExpectation.params = &CartRepositoryMockClearCartParams{ctx, userID}
-	mmClearCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmClearCart.expectations {
-		if minimock.Equal(e.params, mmClearCart.defaultExpectation.params) {
-			mmClearCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmClearCart.defaultExpectation.params)
+	mmAddToList.defaultExpectation.params = &CartRepositoryMockAddToListParams{ctx, userID, list, skuID, count}
+	mmAddToList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddToList.expectations {
+		if minimock.Equal(e.params, mmAddToList.defaultExpectation.params) {
+			mmAddToList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddToList.defaultExpectation.params)
 		}
 	}
 
-	return mmClearCart
+	return mmAddToList
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Repository.ClearCart
-func (mmClearCart *mCartRepositoryMockClearCart) ExpectCtxParam1(ctx context.Context) *mCartRepositoryMockClearCart {
-	if mmClearCart.mock.funcClearCart != nil {
-		mmClearCart.mock.t.Fatalf("CartRepositoryMock.ClearCart mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.AddToList
+func (mmAddToList *mCartRepositoryMockAddToList) ExpectCtxParam1(ctx context.Context) *mCartRepositoryMockAddToList {
+	if mmAddToList.mock.funcAddToList != nil {
+		mmAddToList.mock.t.Fatalf("CartRepositoryMock.AddToList mock is already set by Set")
 	}
 
-	if mmClearCart.defaultExpectation == nil {
-		mmClearCart.defaultExpectation = &CartRepositoryMockClearCartExpectation{}
+	if mmAddToList.defaultExpectation == nil {
+		mmAddToList.defaultExpectation = &CartRepositoryMockAddToListExpectation{}
 	}
 
-	if mmClearCart.defaultExpectation.params != nil {
-		mmClearCart.mock.t.Fatalf("CartRepositoryMock.ClearCart mock is already set by Expect")
+	if mmAddToList.defaultExpectation.params != nil {
+		mmAddToList.mock.t.Fatalf("CartRepositoryMock.AddToList mock is already set by Expect")
 	}
 
-	if mmClearCart.defaultExpectation.paramPtrs == nil {
-		mmClearCart.defaultExpectation.paramPtrs = &CartRepositoryMockClearCartParamPtrs{}
+	if mmAddToList.defaultExpectation.paramPtrs == nil {
+		mmAddToList.defaultExpectation.paramPtrs = &CartRepositoryMockAddToListParamPtrs{}
 	}
-	mmClearCart.defaultExpectation.paramPtrs.ctx = &ctx
-	mmClearCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmAddToList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddToList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmClearCart
+	return mmAddToList
 }
 
-// ExpectUserIDParam2 sets up expected param userID for Repository.ClearCart
-func (mmClearCart *mCartRepositoryMockClearCart) ExpectUserIDParam2(userID uint64) *mCartRepositoryMockClearCart {
-	if mmClearCart.mock.funcClearCart != nil {
-		mmClearCart.mock.t.Fatalf("CartRepositoryMock.ClearCart mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for Repository.AddToList
+func (mmAddToList *mCartRepositoryMockAddToList) ExpectUserIDParam2(userID uint64) *mCartRepositoryMockAddToList {
+	if mmAddToList.mock.funcAddToList != nil {
+		mmAddToList.mock.t.Fatalf("CartRepositoryMock.AddToList mock is already set by Set")
 	}
 
-	if mmClearCart.defaultExpectation == nil {
-		mmClearCart.defaultExpectation = &CartRepositoryMockClearCartExpectation{}
+	if mmAddToList.defaultExpectation == nil {
+		mmAddToList.defaultExpectation = &CartRepositoryMockAddToListExpectation{}
 	}
 
-	if mmClearCart.defaultExpectation.params != nil {
-		mmClearCart.mock.t.Fatalf("CartRepositoryMock.ClearCart mock is already set by Expect")
+	if mmAddToList.defaultExpectation.params != nil {
+		mmAddToList.mock.t.Fatalf("CartRepositoryMock.AddToList mock is already set by Expect")
 	}
 
-	if mmClearCart.defaultExpectation.paramPtrs == nil {
-		mmClearCart.defaultExpectation.paramPtrs = &CartRepositoryMockClearCartParamPtrs{}
+	if mmAddToList.defaultExpectation.paramPtrs == nil {
+		mmAddToList.defaultExpectation.paramPtrs = &CartRepositoryMockAddToListParamPtrs{}
 	}
-	mmClearCart.defaultExpectation.paramPtrs.userID = &userID
-	mmClearCart.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmAddToList.defaultExpectation.paramPtrs.userID = &userID
+	mmAddToList.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmClearCart
+	return mmAddToList
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.ClearCart
-func (mmClearCart *mCartRepositoryMockClearCart) Inspect(f func(ctx context.Context, userID uint64)) *mCartRepositoryMockClearCart {
-	if mmClearCart.mock.inspectFuncClearCart != nil {
-		mmClearCart.mock.t.Fatalf("Inspect function is already set for CartRepositoryMock.ClearCart")
+// ExpectListParam3 sets up expected param list for Repository.AddToList
+func (mmAddToList *mCartRepositoryMockAddToList) ExpectListParam3(list string) *mCartRepositoryMockAddToList {
+	if mmAddToList.mock.funcAddToList != nil {
+		mmAddToList.mock.t.Fatalf("CartRepositoryMock.AddToList mock is already set by Set")
 	}
 
-	mmClearCart.mock.inspectFuncClearCart = f
+	if mmAddToList.defaultExpectation == nil {
+		mmAddToList.defaultExpectation = &CartRepositoryMockAddToListExpectation{}
+	}
 
-	return mmClearCart
+	if mmAddToList.defaultExpectation.params != nil {
+		mmAddToList.mock.t.Fatalf("CartRepositoryMock.AddToList mock is already set by Expect")
+	}
+
+	if mmAddToList.defaultExpectation.paramPtrs == nil {
+		mmAddToList.defaultExpectation.paramPtrs = &CartRepositoryMockAddToListParamPtrs{}
+	}
+	mmAddToList.defaultExpectation.paramPtrs.list = &list
+	mmAddToList.defaultExpectation.expectationOrigins.originList = minimock.CallerInfo(1)
+
+	return mmAddToList
 }
 
-// Return sets up results that will be returned by Repository.ClearCart
-func (mmClearCart *mCartRepositoryMockClearCart) Return(err error) *CartRepositoryMock {
-	if mmClearCart.mock.funcClearCart != nil {
-		mmClearCart.mock.t.Fatalf("CartRepositoryMock.ClearCart mock is already set by Set")
+// ExpectSkuIDParam4 sets up expected param skuID for Repository.AddToList
+func (mmAddToList *mCartRepositoryMockAddToList) ExpectSkuIDParam4(skuID int64) *mCartRepositoryMockAddToList {
+	if mmAddToList.mock.funcAddToList != nil {
+		mmAddToList.mock.t.Fatalf("CartRepositoryMock.AddToList mock is already set by Set")
 	}
 
-	if mmClearCart.defaultExpectation == nil {
-		mmClearCart.defaultExpectation = &CartRepositoryMockClearCartExpectation{mock: mmClearCart.mock}
+	if mmAddToList.defaultExpectation == nil {
+		mmAddToList.defaultExpectation = &CartRepositoryMockAddToListExpectation{}
 	}
-	mmClearCart.defaultExpectation.results = &CartRepositoryMockClearCartResults{err}
-	mmClearCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmClearCart.mock
+
+	if mmAddToList.defaultExpectation.params != nil {
+		mmAddToList.mock.t.Fatalf("CartRepositoryMock.AddToList mock is already set by Expect")
+	}
+
+	if mmAddToList.defaultExpectation.paramPtrs == nil {
+		mmAddToList.defaultExpectation.paramPtrs = &CartRepositoryMockAddToListParamPtrs{}
+	}
+	mmAddToList.defaultExpectation.paramPtrs.skuID = &skuID
+	mmAddToList.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmAddToList
 }
 
-// Set uses given function f to mock the Repository.ClearCart method
-func (mmClearCart *mCartRepositoryMockClearCart) Set(f func(ctx context.Context, userID uint64) (err error)) *CartRepositoryMock {
-	if mmClearCart.defaultExpectation != nil {
-		mmClearCart.mock.t.Fatalf("Default expectation is already set for the Repository.ClearCart method")
+// ExpectCountParam5 sets up expected param count for Repository.AddToList
+func (mmAddToList *mCartRepositoryMockAddToList) ExpectCountParam5(count uint16) *mCartRepositoryMockAddToList {
+	if mmAddToList.mock.funcAddToList != nil {
+		mmAddToList.mock.t.Fatalf("CartRepositoryMock.AddToList mock is already set by Set")
 	}
 
-	if len(mmClearCart.expectations) > 0 {
-		mmClearCart.mock.t.Fatalf("Some expectations are already set for the Repository.ClearCart method")
+	if mmAddToList.defaultExpectation == nil {
+		mmAddToList.defaultExpectation = &CartRepositoryMockAddToListExpectation{}
 	}
 
-	mmClearCart.mock.funcClearCart = f
-	mmClearCart.mock.funcClearCartOrigin = minimock.CallerInfo(1)
-	return mmClearCart.mock
+	if mmAddToList.defaultExpectation.params != nil {
+		mmAddToList.mock.t.Fatalf("CartRepositoryMock.AddToList mock is already set by Expect")
+	}
+
+	if mmAddToList.defaultExpectation.paramPtrs == nil {
+		mmAddToList.defaultExpectation.paramPtrs = &CartRepositoryMockAddToListParamPtrs{}
+	}
+	mmAddToList.defaultExpectation.paramPtrs.count = &count
+	mmAddToList.defaultExpectation.expectationOrigins.originCount = minimock.CallerInfo(1)
+
+	return mmAddToList
 }
 
-// When sets expectation for the Repository.ClearCart which will trigger the result defined by the following
+// Inspect accepts an inspector function that has same arguments as the Repository.AddToList
+func (mmAddToList *mCartRepositoryMockAddToList) Inspect(f func(ctx context.Context, userID uint64, list string, skuID int64, count uint16)) *mCartRepositoryMockAddToList {
+	if mmAddToList.mock.inspectFuncAddToList != nil {
+		mmAddToList.mock.t.Fatalf("Inspect function is already set for CartRepositoryMock.AddToList")
+	}
+
+	mmAddToList.mock.inspectFuncAddToList = f
+
+	return mmAddToList
+}
+
+// Return sets up results that will be returned by Repository.AddToList
+func (mmAddToList *mCartRepositoryMockAddToList) Return(err error) *CartRepositoryMock {
+	if mmAddToList.mock.funcAddToList != nil {
+		mmAddToList.mock.t.Fatalf("CartRepositoryMock.AddToList mock is already set by Set")
+	}
+
+	if mmAddToList.defaultExpectation == nil {
+		mmAddToList.defaultExpectation = &CartRepositoryMockAddToListExpectation{mock: mmAddToList.mock}
+	}
+	mmAddToList.defaultExpectation.results = &CartRepositoryMockAddToListResults{err}
+	mmAddToList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddToList.mock
+}
+
+// Set uses given function f to mock the Repository.AddToList method
+func (mmAddToList *mCartRepositoryMockAddToList) Set(f func(ctx context.Context, userID uint64, list string, skuID int64, count uint16) (err error)) *CartRepositoryMock {
+	if mmAddToList.defaultExpectation != nil {
+		mmAddToList.mock.t.Fatalf("Default expectation is already set for the Repository.AddToList method")
+	}
+
+	if len(mmAddToList.expectations) > 0 {
+		mmAddToList.mock.t.Fatalf("Some expectations are already set for the Repository.AddToList method")
+	}
+
+	mmAddToList.mock.funcAddToList = f
+	mmAddToList.mock.funcAddToListOrigin = minimock.CallerInfo(1)
+	return mmAddToList.mock
+}
+
+// When sets expectation for the Repository.AddToList which will trigger the result defined by the following
 // Then helper
-func (mmClearCart *mCartRepositoryMockClearCart) When(ctx context.Context, userID uint64) *CartRepositoryMockClearCartExpectation {
-	if mmClearCart.mock.funcClearCart != nil {
-		mmClearCart.mock.t.Fatalf("CartRepositoryMock.ClearCart mock is already set by Set")
+func (mmAddToList *mCartRepositoryMockAddToList) When(ctx context.Context, userID uint64, list string, skuID int64, count uint16) *CartRepositoryMockAddToListExpectation {
+	if mmAddToList.mock.funcAddToList != nil {
+		mmAddToList.mock.t.Fatalf("CartRepositoryMock.AddToList mock is already set by Set")
 	}
 
-	expectation := &CartRepositoryMockClearCartExpectation{
-		mock:               mmClearCart.mock,
-		params:             &CartRepositoryMockClearCartParams{ctx, userID},
-		expectationOrigins: CartRepositoryMockClearCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &CartRepositoryMockAddToListExpectation{
+		mock:               mmAddToList.mock,
+		params:             &CartRepositoryMockAddToListParams{ctx, userID, list, skuID, count},
+		expectationOrigins: CartRepositoryMockAddToListExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmClearCart.expectations = append(mmClearCart.expectations, expectation)
+	mmAddToList.expectations = append(mmAddToList.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.ClearCart return parameters for the expectation previously defined by the When method
-func (e *CartRepositoryMockClearCartExpectation) Then(err error) *CartRepositoryMock {
-	e.results = &CartRepositoryMockClearCartResults{err}
+// Then sets up Repository.AddToList return parameters for the expectation previously defined by the When method
+func (e *CartRepositoryMockAddToListExpectation) Then(err error) *CartRepositoryMock {
+	e.results = &CartRepositoryMockAddToListResults{err}
 	return e.mock
 }
 
-// Times sets number of times Repository.ClearCart should be invoked
-func (mmClearCart *mCartRepositoryMockClearCart) Times(n uint64) *mCartRepositoryMockClearCart {
+// Times sets number of times Repository.AddToList should be invoked
+func (mmAddToList *mCartRepositoryMockAddToList) Times(n uint64) *mCartRepositoryMockAddToList {
 	if n == 0 {
-		mmClearCart.mock.t.Fatalf("Times of CartRepositoryMock.ClearCart mock can not be zero")
+		mmAddToList.mock.t.Fatalf("Times of CartRepositoryMock.AddToList mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmClearCart.expectedInvocations, n)
-	mmClearCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmClearCart
+	mm_atomic.StoreUint64(&mmAddToList.expectedInvocations, n)
+	mmAddToList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddToList
 }
 
-func (mmClearCart *mCartRepositoryMockClearCart) invocationsDone() bool {
-	if len(mmClearCart.expectations) == 0 && mmClearCart.defaultExpectation == nil && mmClearCart.mock.funcClearCart == nil {
+func (mmAddToList *mCartRepositoryMockAddToList) invocationsDone() bool {
+	if len(mmAddToList.expectations) == 0 && mmAddToList.defaultExpectation == nil && mmAddToList.mock.funcAddToList == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmClearCart.mock.afterClearCartCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmClearCart.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmAddToList.mock.afterAddToListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddToList.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// ClearCart implements mm_cart.Repository
-func (mmClearCart *CartRepositoryMock) ClearCart(ctx context.Context, userID uint64) (err error) {
-	mm_atomic.AddUint64(&mmClearCart.beforeClearCartCounter, 1)
-	defer mm_atomic.AddUint64(&mmClearCart.afterClearCartCounter, 1)
+// AddToList implements mm_cart.Repository
+func (mmAddToList *CartRepositoryMock) AddToList(ctx context.Context, userID uint64, list string, skuID int64, count uint16) (err error) {
+	mm_atomic.AddUint64(&mmAddToList.beforeAddToListCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddToList.afterAddToListCounter, 1)
 
-	mmClearCart.t.Helper()
+	mmAddToList.t.Helper()
 
-	if mmClearCart.inspectFuncClearCart != nil {
-		mmClearCart.inspectFuncClearCart(ctx, userID)
+	if mmAddToList.inspectFuncAddToList != nil {
+		mmAddToList.inspectFuncAddToList(ctx, userID, list, skuID, count)
 	}
 
-	mm_params := CartRepositoryMockClearCartParams{ctx, userID}
+	mm_params := CartRepositoryMockAddToListParams{ctx, userID, list, skuID, count}
 
 	// Record call args
-	mmClearCart.ClearCartMock.mutex.Lock()
-	mmClearCart.ClearCartMock.callArgs = append(mmClearCart.ClearCartMock.callArgs, &mm_params)
-	mmClearCart.ClearCartMock.mutex.Unlock()
+	mmAddToList.AddToListMock.mutex.Lock()
+	mmAddToList.AddToListMock.callArgs = append(mmAddToList.AddToListMock.callArgs, &mm_params)
+	mmAddToList.AddToListMock.mutex.Unlock()
 
-	for _, e := range mmClearCart.ClearCartMock.expectations {
+	for _, e := range mmAddToList.AddToListMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmClearCart.ClearCartMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmClearCart.ClearCartMock.defaultExpectation.Counter, 1)
-		mm_want := mmClearCart.ClearCartMock.defaultExpectation.params
-		mm_want_ptrs := mmClearCart.ClearCartMock.defaultExpectation.paramPtrs
+	if mmAddToList.AddToListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddToList.AddToListMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddToList.AddToListMock.defaultExpectation.params
+		mm_want_ptrs := mmAddToList.AddToListMock.defaultExpectation.paramPtrs
 
-		mm_got := CartRepositoryMockClearCartParams{ctx, userID}
+		mm_got := CartRepositoryMockAddToListParams{ctx, userID, list, skuID, count}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmClearCart.t.Errorf("CartRepositoryMock.ClearCart got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmClearCart.ClearCartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmAddToList.t.Errorf("CartRepositoryMock.AddToList got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddToList.AddToListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmClearCart.t.Errorf("CartRepositoryMock.ClearCart got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmClearCart.ClearCartMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+				mmAddToList.t.Errorf("CartRepositoryMock.AddToList got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddToList.AddToListMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.list != nil && !minimock.Equal(*mm_want_ptrs.list, mm_got.list) {
+				mmAddToList.t.Errorf("CartRepositoryMock.AddToList got unexpected parameter list, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddToList.AddToListMock.defaultExpectation.expectationOrigins.originList, *mm_want_ptrs.list, mm_got.list, minimock.Diff(*mm_want_ptrs.list, mm_got.list))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmAddToList.t.Errorf("CartRepositoryMock.AddToList got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddToList.AddToListMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+			if mm_want_ptrs.count != nil && !minimock.Equal(*mm_want_ptrs.count, mm_got.count) {
+				mmAddToList.t.Errorf("CartRepositoryMock.AddToList got unexpected parameter count, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddToList.AddToListMock.defaultExpectation.expectationOrigins.originCount, *mm_want_ptrs.count, mm_got.count, minimock.Diff(*mm_want_ptrs.count, mm_got.count))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmClearCart.t.Errorf("CartRepositoryMock.ClearCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmClearCart.ClearCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmAddToList.t.Errorf("CartRepositoryMock.AddToList got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddToList.AddToListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmClearCart.ClearCartMock.defaultExpectation.results
+		mm_results := mmAddToList.AddToListMock.defaultExpectation.results
 		if mm_results == nil {
-			mmClearCart.t.Fatal("No results are set for the CartRepositoryMock.ClearCart")
+			mmAddToList.t.Fatal("No results are set for the CartRepositoryMock.AddToList")
 		}
 		return (*mm_results).err
 	}
-	if mmClearCart.funcClearCart != nil {
-		return mmClearCart.funcClearCart(ctx, userID)
+	if mmAddToList.funcAddToList != nil {
+		return mmAddToList.funcAddToList(ctx, userID, list, skuID, count)
 	}
-	mmClearCart.t.Fatalf("Unexpected call to CartRepositoryMock.ClearCart. %v %v", ctx, userID)
+	mmAddToList.t.Fatalf("Unexpected call to CartRepositoryMock.AddToList. %v %v %v %v %v", ctx, userID, list, skuID, count)
 	return
 }
 
-// ClearCartAfterCounter returns a count of finished CartRepositoryMock.ClearCart invocations
-func (mmClearCart *CartRepositoryMock) ClearCartAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmClearCart.afterClearCartCounter)
+// AddToListAfterCounter returns a count of finished CartRepositoryMock.AddToList invocations
+func (mmAddToList *CartRepositoryMock) AddToListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddToList.afterAddToListCounter)
 }
 
-// ClearCartBeforeCounter returns a count of CartRepositoryMock.ClearCart invocations
-func (mmClearCart *CartRepositoryMock) ClearCartBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmClearCart.beforeClearCartCounter)
+// AddToListBeforeCounter returns a count of CartRepositoryMock.AddToList invocations
+func (mmAddToList *CartRepositoryMock) AddToListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddToList.beforeAddToListCounter)
 }
 
-// Calls returns a list of arguments used in each call to CartRepositoryMock.ClearCart.
+// Calls returns a list of arguments used in each call to CartRepositoryMock.AddToList.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmClearCart *mCartRepositoryMockClearCart) Calls() []*CartRepositoryMockClearCartParams {
-	mmClearCart.mutex.RLock()
+func (mmAddToList *mCartRepositoryMockAddToList) Calls() []*CartRepositoryMockAddToListParams {
+	mmAddToList.mutex.RLock()
 
-	argCopy := make([]*CartRepositoryMockClearCartParams, len(mmClearCart.callArgs))
-	copy(argCopy, mmClearCart.callArgs)
+	argCopy := make([]*CartRepositoryMockAddToListParams, len(mmAddToList.callArgs))
+	copy(argCopy, mmAddToList.callArgs)
 
-	mmClearCart.mutex.RUnlock()
+	mmAddToList.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockClearCartDone returns true if the count of the ClearCart invocations corresponds
+// MinimockAddToListDone returns true if the count of the AddToList invocations corresponds
 // the number of defined expectations
-func (m *CartRepositoryMock) MinimockClearCartDone() bool {
-	if m.ClearCartMock.optional {
+func (m *CartRepositoryMock) MinimockAddToListDone() bool {
+	if m.AddToListMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.ClearCartMock.expectations {
+	for _, e := range m.AddToListMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.ClearCartMock.invocationsDone()
+	return m.AddToListMock.invocationsDone()
 }
 
-// MinimockClearCartInspect logs each unmet expectation
-func (m *CartRepositoryMock) MinimockClearCartInspect() {
-	for _, e := range m.ClearCartMock.expectations {
+// MinimockAddToListInspect logs each unmet expectation
+func (m *CartRepositoryMock) MinimockAddToListInspect() {
+	for _, e := range m.AddToListMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to CartRepositoryMock.ClearCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to CartRepositoryMock.AddToList at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterClearCartCounter := mm_atomic.LoadUint64(&m.afterClearCartCounter)
+	afterAddToListCounter := mm_atomic.LoadUint64(&m.afterAddToListCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ClearCartMock.defaultExpectation != nil && afterClearCartCounter < 1 {
-		if m.ClearCartMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to CartRepositoryMock.ClearCart at\n%s", m.ClearCartMock.defaultExpectation.returnOrigin)
+	if m.AddToListMock.defaultExpectation != nil && afterAddToListCounter < 1 {
+		if m.AddToListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CartRepositoryMock.AddToList at\n%s", m.AddToListMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to CartRepositoryMock.ClearCart at\n%s with params: %#v", m.ClearCartMock.defaultExpectation.expectationOrigins.origin, *m.ClearCartMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to CartRepositoryMock.AddToList at\n%s with params: %#v", m.AddToListMock.defaultExpectation.expectationOrigins.origin, *m.AddToListMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcClearCart != nil && afterClearCartCounter < 1 {
-		m.t.Errorf("Expected call to CartRepositoryMock.ClearCart at\n%s", m.funcClearCartOrigin)
+	if m.funcAddToList != nil && afterAddToListCounter < 1 {
+		m.t.Errorf("Expected call to CartRepositoryMock.AddToList at\n%s", m.funcAddToListOrigin)
 	}
 
-	if !m.ClearCartMock.invocationsDone() && afterClearCartCounter > 0 {
-		m.t.Errorf("Expected %d calls to CartRepositoryMock.ClearCart at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.ClearCartMock.expectedInvocations), m.ClearCartMock.expectedInvocationsOrigin, afterClearCartCounter)
+	if !m.AddToListMock.invocationsDone() && afterAddToListCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartRepositoryMock.AddToList at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddToListMock.expectedInvocations), m.AddToListMock.expectedInvocationsOrigin, afterAddToListCounter)
 	}
 }
 
-type mCartRepositoryMockGetCart struct {
+type mCartRepositoryMockCheckoutItems struct {
 	optional           bool
 	mock               *CartRepositoryMock
-	defaultExpectation *CartRepositoryMockGetCartExpectation
-	expectations       []*CartRepositoryMockGetCartExpectation
+	defaultExpectation *CartRepositoryMockCheckoutItemsExpectation
+	expectations       []*CartRepositoryMockCheckoutItemsExpectation
 
-	callArgs []*CartRepositoryMockGetCartParams
+	callArgs []*CartRepositoryMockCheckoutItemsParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// CartRepositoryMockGetCartExpectation specifies expectation struct of the Repository.GetCart
-type CartRepositoryMockGetCartExpectation struct {
+// CartRepositoryMockCheckoutItemsExpectation specifies expectation struct of the Repository.CheckoutItems
+type CartRepositoryMockCheckoutItemsExpectation struct {
 	mock               *CartRepositoryMock
-	params             *CartRepositoryMockGetCartParams
-	paramPtrs          *CartRepositoryMockGetCartParamPtrs
-	expectationOrigins CartRepositoryMockGetCartExpectationOrigins
-	results            *CartRepositoryMockGetCartResults
+	params             *CartRepositoryMockCheckoutItemsParams
+	paramPtrs          *CartRepositoryMockCheckoutItemsParamPtrs
+	expectationOrigins CartRepositoryMockCheckoutItemsExpectationOrigins
+	results            *CartRepositoryMockCheckoutItemsResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// CartRepositoryMockGetCartParams contains parameters of the Repository.GetCart
-type CartRepositoryMockGetCartParams struct {
-	ctx    context.Context
-	userID uint64
+// CartRepositoryMockCheckoutItemsParams contains parameters of the Repository.CheckoutItems
+type CartRepositoryMockCheckoutItemsParams struct {
+	ctx     context.Context
+	userID  uint64
+	list    string
+	items   map[int64]uint16
+	orderID int64
 }
 
-// CartRepositoryMockGetCartParamPtrs contains pointers to parameters of the Repository.GetCart
-type CartRepositoryMockGetCartParamPtrs struct {
-	ctx    *context.Context
-	userID *uint64
+// CartRepositoryMockCheckoutItemsParamPtrs contains pointers to parameters of the Repository.CheckoutItems
+type CartRepositoryMockCheckoutItemsParamPtrs struct {
+	ctx     *context.Context
+	userID  *uint64
+	list    *string
+	items   *map[int64]uint16
+	orderID *int64
 }
 
-// CartRepositoryMockGetCartResults contains results of the Repository.GetCart
-type CartRepositoryMockGetCartResults struct {
-	m1  map[int64]uint16
+// CartRepositoryMockCheckoutItemsResults contains results of the Repository.CheckoutItems
+type CartRepositoryMockCheckoutItemsResults struct {
 	err error
 }
 
-// CartRepositoryMockGetCartOrigins contains origins of expectations of the Repository.GetCart
-type CartRepositoryMockGetCartExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originUserID string
+// CartRepositoryMockCheckoutItemsOrigins contains origins of expectations of the Repository.CheckoutItems
+type CartRepositoryMockCheckoutItemsExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originList    string
+	originItems   string
+	originOrderID string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -872,9 +1094,1151 @@ type CartRepositoryMockGetCartExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetCart *mCartRepositoryMockGetCart) Optional() *mCartRepositoryMockGetCart {
-	mmGetCart.optional = true
-	return mmGetCart
+func (mmCheckoutItems *mCartRepositoryMockCheckoutItems) Optional() *mCartRepositoryMockCheckoutItems {
+	mmCheckoutItems.optional = true
+	return mmCheckoutItems
+}
+
+// Expect sets up expected params for Repository.CheckoutItems
+func (mmCheckoutItems *mCartRepositoryMockCheckoutItems) Expect(ctx context.Context, userID uint64, list string, items map[int64]uint16, orderID int64) *mCartRepositoryMockCheckoutItems {
+	if mmCheckoutItems.mock.funcCheckoutItems != nil {
+		mmCheckoutItems.mock.t.Fatalf("CartRepositoryMock.CheckoutItems mock is already set by Set")
+	}
+
+	if mmCheckoutItems.defaultExpectation == nil {
+		mmCheckoutItems.defaultExpectation = &CartRepositoryMockCheckoutItemsExpectation{}
+	}
+
+	if mmCheckoutItems.defaultExpectation.paramPtrs != nil {
+		mmCheckoutItems.mock.t.Fatalf("CartRepositoryMock.CheckoutItems mock is already set by ExpectParams functions")
+	}
+
+	mmCheckoutItems.defaultExpectation.params = &CartRepositoryMockCheckoutItemsParams{ctx, userID, list, items, orderID}
+	mmCheckoutItems.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCheckoutItems.expectations {
+		if minimock.Equal(e.params, mmCheckoutItems.defaultExpectation.params) {
+			mmCheckoutItems.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckoutItems.defaultExpectation.params)
+		}
+	}
+
+	return mmCheckoutItems
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.CheckoutItems
+func (mmCheckoutItems *mCartRepositoryMockCheckoutItems) ExpectCtxParam1(ctx context.Context) *mCartRepositoryMockCheckoutItems {
+	if mmCheckoutItems.mock.funcCheckoutItems != nil {
+		mmCheckoutItems.mock.t.Fatalf("CartRepositoryMock.CheckoutItems mock is already set by Set")
+	}
+
+	if mmCheckoutItems.defaultExpectation == nil {
+		mmCheckoutItems.defaultExpectation = &CartRepositoryMockCheckoutItemsExpectation{}
+	}
+
+	if mmCheckoutItems.defaultExpectation.params != nil {
+		mmCheckoutItems.mock.t.Fatalf("CartRepositoryMock.CheckoutItems mock is already set by Expect")
+	}
+
+	if mmCheckoutItems.defaultExpectation.paramPtrs == nil {
+		mmCheckoutItems.defaultExpectation.paramPtrs = &CartRepositoryMockCheckoutItemsParamPtrs{}
+	}
+	mmCheckoutItems.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCheckoutItems.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCheckoutItems
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.CheckoutItems
+func (mmCheckoutItems *mCartRepositoryMockCheckoutItems) ExpectUserIDParam2(userID uint64) *mCartRepositoryMockCheckoutItems {
+	if mmCheckoutItems.mock.funcCheckoutItems != nil {
+		mmCheckoutItems.mock.t.Fatalf("CartRepositoryMock.CheckoutItems mock is already set by Set")
+	}
+
+	if mmCheckoutItems.defaultExpectation == nil {
+		mmCheckoutItems.defaultExpectation = &CartRepositoryMockCheckoutItemsExpectation{}
+	}
+
+	if mmCheckoutItems.defaultExpectation.params != nil {
+		mmCheckoutItems.mock.t.Fatalf("CartRepositoryMock.CheckoutItems mock is already set by Expect")
+	}
+
+	if mmCheckoutItems.defaultExpectation.paramPtrs == nil {
+		mmCheckoutItems.defaultExpectation.paramPtrs = &CartRepositoryMockCheckoutItemsParamPtrs{}
+	}
+	mmCheckoutItems.defaultExpectation.paramPtrs.userID = &userID
+	mmCheckoutItems.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmCheckoutItems
+}
+
+// ExpectListParam3 sets up expected param list for Repository.CheckoutItems
+func (mmCheckoutItems *mCartRepositoryMockCheckoutItems) ExpectListParam3(list string) *mCartRepositoryMockCheckoutItems {
+	if mmCheckoutItems.mock.funcCheckoutItems != nil {
+		mmCheckoutItems.mock.t.Fatalf("CartRepositoryMock.CheckoutItems mock is already set by Set")
+	}
+
+	if mmCheckoutItems.defaultExpectation == nil {
+		mmCheckoutItems.defaultExpectation = &CartRepositoryMockCheckoutItemsExpectation{}
+	}
+
+	if mmCheckoutItems.defaultExpectation.params != nil {
+		mmCheckoutItems.mock.t.Fatalf("CartRepositoryMock.CheckoutItems mock is already set by Expect")
+	}
+
+	if mmCheckoutItems.defaultExpectation.paramPtrs == nil {
+		mmCheckoutItems.defaultExpectation.paramPtrs = &CartRepositoryMockCheckoutItemsParamPtrs{}
+	}
+	mmCheckoutItems.defaultExpectation.paramPtrs.list = &list
+	mmCheckoutItems.defaultExpectation.expectationOrigins.originList = minimock.CallerInfo(1)
+
+	return mmCheckoutItems
+}
+
+// ExpectItemsParam4 sets up expected param items for Repository.CheckoutItems
+func (mmCheckoutItems *mCartRepositoryMockCheckoutItems) ExpectItemsParam4(items map[int64]uint16) *mCartRepositoryMockCheckoutItems {
+	if mmCheckoutItems.mock.funcCheckoutItems != nil {
+		mmCheckoutItems.mock.t.Fatalf("CartRepositoryMock.CheckoutItems mock is already set by Set")
+	}
+
+	if mmCheckoutItems.defaultExpectation == nil {
+		mmCheckoutItems.defaultExpectation = &CartRepositoryMockCheckoutItemsExpectation{}
+	}
+
+	if mmCheckoutItems.defaultExpectation.params != nil {
+		mmCheckoutItems.mock.t.Fatalf("CartRepositoryMock.CheckoutItems mock is already set by Expect")
+	}
+
+	if mmCheckoutItems.defaultExpectation.paramPtrs == nil {
+		mmCheckoutItems.defaultExpectation.paramPtrs = &CartRepositoryMockCheckoutItemsParamPtrs{}
+	}
+	mmCheckoutItems.defaultExpectation.paramPtrs.items = &items
+	mmCheckoutItems.defaultExpectation.expectationOrigins.originItems = minimock.CallerInfo(1)
+
+	return mmCheckoutItems
+}
+
+// ExpectOrderIDParam5 sets up expected param orderID for Repository.CheckoutItems
+func (mmCheckoutItems *mCartRepositoryMockCheckoutItems) ExpectOrderIDParam5(orderID int64) *mCartRepositoryMockCheckoutItems {
+	if mmCheckoutItems.mock.funcCheckoutItems != nil {
+		mmCheckoutItems.mock.t.Fatalf("CartRepositoryMock.CheckoutItems mock is already set by Set")
+	}
+
+	if mmCheckoutItems.defaultExpectation == nil {
+		mmCheckoutItems.defaultExpectation = &CartRepositoryMockCheckoutItemsExpectation{}
+	}
+
+	if mmCheckoutItems.defaultExpectation.params != nil {
+		mmCheckoutItems.mock.t.Fatalf("CartRepositoryMock.CheckoutItems mock is already set by Expect")
+	}
+
+	if mmCheckoutItems.defaultExpectation.paramPtrs == nil {
+		mmCheckoutItems.defaultExpectation.paramPtrs = &CartRepositoryMockCheckoutItemsParamPtrs{}
+	}
+	mmCheckoutItems.defaultExpectation.paramPtrs.orderID = &orderID
+	mmCheckoutItems.defaultExpectation.expectationOrigins.originOrderID = minimock.CallerInfo(1)
+
+	return mmCheckoutItems
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.CheckoutItems
+func (mmCheckoutItems *mCartRepositoryMockCheckoutItems) Inspect(f func(ctx context.Context, userID uint64, list string, items map[int64]uint16, orderID int64)) *mCartRepositoryMockCheckoutItems {
+	if mmCheckoutItems.mock.inspectFuncCheckoutItems != nil {
+		mmCheckoutItems.mock.t.Fatalf("Inspect function is already set for CartRepositoryMock.CheckoutItems")
+	}
+
+	mmCheckoutItems.mock.inspectFuncCheckoutItems = f
+
+	return mmCheckoutItems
+}
+
+// Return sets up results that will be returned by Repository.CheckoutItems
+func (mmCheckoutItems *mCartRepositoryMockCheckoutItems) Return(err error) *CartRepositoryMock {
+	if mmCheckoutItems.mock.funcCheckoutItems != nil {
+		mmCheckoutItems.mock.t.Fatalf("CartRepositoryMock.CheckoutItems mock is already set by Set")
+	}
+
+	if mmCheckoutItems.defaultExpectation == nil {
+		mmCheckoutItems.defaultExpectation = &CartRepositoryMockCheckoutItemsExpectation{mock: mmCheckoutItems.mock}
+	}
+	mmCheckoutItems.defaultExpectation.results = &CartRepositoryMockCheckoutItemsResults{err}
+	mmCheckoutItems.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCheckoutItems.mock
+}
+
+// Set uses given function f to mock the Repository.CheckoutItems method
+func (mmCheckoutItems *mCartRepositoryMockCheckoutItems) Set(f func(ctx context.Context, userID uint64, list string, items map[int64]uint16, orderID int64) (err error)) *CartRepositoryMock {
+	if mmCheckoutItems.defaultExpectation != nil {
+		mmCheckoutItems.mock.t.Fatalf("Default expectation is already set for the Repository.CheckoutItems method")
+	}
+
+	if len(mmCheckoutItems.expectations) > 0 {
+		mmCheckoutItems.mock.t.Fatalf("Some expectations are already set for the Repository.CheckoutItems method")
+	}
+
+	mmCheckoutItems.mock.funcCheckoutItems = f
+	mmCheckoutItems.mock.funcCheckoutItemsOrigin = minimock.CallerInfo(1)
+	return mmCheckoutItems.mock
+}
+
+// When sets expectation for the Repository.CheckoutItems which will trigger the result defined by the following
+// Then helper
+func (mmCheckoutItems *mCartRepositoryMockCheckoutItems) When(ctx context.Context, userID uint64, list string, items map[int64]uint16, orderID int64) *CartRepositoryMockCheckoutItemsExpectation {
+	if mmCheckoutItems.mock.funcCheckoutItems != nil {
+		mmCheckoutItems.mock.t.Fatalf("CartRepositoryMock.CheckoutItems mock is already set by Set")
+	}
+
+	expectation := &CartRepositoryMockCheckoutItemsExpectation{
+		mock:               mmCheckoutItems.mock,
+		params:             &CartRepositoryMockCheckoutItemsParams{ctx, userID, list, items, orderID},
+		expectationOrigins: CartRepositoryMockCheckoutItemsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCheckoutItems.expectations = append(mmCheckoutItems.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.CheckoutItems return parameters for the expectation previously defined by the When method
+func (e *CartRepositoryMockCheckoutItemsExpectation) Then(err error) *CartRepositoryMock {
+	e.results = &CartRepositoryMockCheckoutItemsResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.CheckoutItems should be invoked
+func (mmCheckoutItems *mCartRepositoryMockCheckoutItems) Times(n uint64) *mCartRepositoryMockCheckoutItems {
+	if n == 0 {
+		mmCheckoutItems.mock.t.Fatalf("Times of CartRepositoryMock.CheckoutItems mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheckoutItems.expectedInvocations, n)
+	mmCheckoutItems.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCheckoutItems
+}
+
+func (mmCheckoutItems *mCartRepositoryMockCheckoutItems) invocationsDone() bool {
+	if len(mmCheckoutItems.expectations) == 0 && mmCheckoutItems.defaultExpectation == nil && mmCheckoutItems.mock.funcCheckoutItems == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheckoutItems.mock.afterCheckoutItemsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheckoutItems.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CheckoutItems implements mm_cart.Repository
+func (mmCheckoutItems *CartRepositoryMock) CheckoutItems(ctx context.Context, userID uint64, list string, items map[int64]uint16, orderID int64) (err error) {
+	mm_atomic.AddUint64(&mmCheckoutItems.beforeCheckoutItemsCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheckoutItems.afterCheckoutItemsCounter, 1)
+
+	mmCheckoutItems.t.Helper()
+
+	if mmCheckoutItems.inspectFuncCheckoutItems != nil {
+		mmCheckoutItems.inspectFuncCheckoutItems(ctx, userID, list, items, orderID)
+	}
+
+	mm_params := CartRepositoryMockCheckoutItemsParams{ctx, userID, list, items, orderID}
+
+	// Record call args
+	mmCheckoutItems.CheckoutItemsMock.mutex.Lock()
+	mmCheckoutItems.CheckoutItemsMock.callArgs = append(mmCheckoutItems.CheckoutItemsMock.callArgs, &mm_params)
+	mmCheckoutItems.CheckoutItemsMock.mutex.Unlock()
+
+	for _, e := range mmCheckoutItems.CheckoutItemsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCheckoutItems.CheckoutItemsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheckoutItems.CheckoutItemsMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheckoutItems.CheckoutItemsMock.defaultExpectation.params
+		mm_want_ptrs := mmCheckoutItems.CheckoutItemsMock.defaultExpectation.paramPtrs
+
+		mm_got := CartRepositoryMockCheckoutItemsParams{ctx, userID, list, items, orderID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheckoutItems.t.Errorf("CartRepositoryMock.CheckoutItems got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckoutItems.CheckoutItemsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmCheckoutItems.t.Errorf("CartRepositoryMock.CheckoutItems got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckoutItems.CheckoutItemsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.list != nil && !minimock.Equal(*mm_want_ptrs.list, mm_got.list) {
+				mmCheckoutItems.t.Errorf("CartRepositoryMock.CheckoutItems got unexpected parameter list, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckoutItems.CheckoutItemsMock.defaultExpectation.expectationOrigins.originList, *mm_want_ptrs.list, mm_got.list, minimock.Diff(*mm_want_ptrs.list, mm_got.list))
+			}
+
+			if mm_want_ptrs.items != nil && !minimock.Equal(*mm_want_ptrs.items, mm_got.items) {
+				mmCheckoutItems.t.Errorf("CartRepositoryMock.CheckoutItems got unexpected parameter items, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckoutItems.CheckoutItemsMock.defaultExpectation.expectationOrigins.originItems, *mm_want_ptrs.items, mm_got.items, minimock.Diff(*mm_want_ptrs.items, mm_got.items))
+			}
+
+			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
+				mmCheckoutItems.t.Errorf("CartRepositoryMock.CheckoutItems got unexpected parameter orderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckoutItems.CheckoutItemsMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheckoutItems.t.Errorf("CartRepositoryMock.CheckoutItems got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCheckoutItems.CheckoutItemsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheckoutItems.CheckoutItemsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheckoutItems.t.Fatal("No results are set for the CartRepositoryMock.CheckoutItems")
+		}
+		return (*mm_results).err
+	}
+	if mmCheckoutItems.funcCheckoutItems != nil {
+		return mmCheckoutItems.funcCheckoutItems(ctx, userID, list, items, orderID)
+	}
+	mmCheckoutItems.t.Fatalf("Unexpected call to CartRepositoryMock.CheckoutItems. %v %v %v %v %v", ctx, userID, list, items, orderID)
+	return
+}
+
+// CheckoutItemsAfterCounter returns a count of finished CartRepositoryMock.CheckoutItems invocations
+func (mmCheckoutItems *CartRepositoryMock) CheckoutItemsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckoutItems.afterCheckoutItemsCounter)
+}
+
+// CheckoutItemsBeforeCounter returns a count of CartRepositoryMock.CheckoutItems invocations
+func (mmCheckoutItems *CartRepositoryMock) CheckoutItemsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckoutItems.beforeCheckoutItemsCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartRepositoryMock.CheckoutItems.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheckoutItems *mCartRepositoryMockCheckoutItems) Calls() []*CartRepositoryMockCheckoutItemsParams {
+	mmCheckoutItems.mutex.RLock()
+
+	argCopy := make([]*CartRepositoryMockCheckoutItemsParams, len(mmCheckoutItems.callArgs))
+	copy(argCopy, mmCheckoutItems.callArgs)
+
+	mmCheckoutItems.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckoutItemsDone returns true if the count of the CheckoutItems invocations corresponds
+// the number of defined expectations
+func (m *CartRepositoryMock) MinimockCheckoutItemsDone() bool {
+	if m.CheckoutItemsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckoutItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckoutItemsMock.invocationsDone()
+}
+
+// MinimockCheckoutItemsInspect logs each unmet expectation
+func (m *CartRepositoryMock) MinimockCheckoutItemsInspect() {
+	for _, e := range m.CheckoutItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartRepositoryMock.CheckoutItems at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCheckoutItemsCounter := mm_atomic.LoadUint64(&m.afterCheckoutItemsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckoutItemsMock.defaultExpectation != nil && afterCheckoutItemsCounter < 1 {
+		if m.CheckoutItemsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CartRepositoryMock.CheckoutItems at\n%s", m.CheckoutItemsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CartRepositoryMock.CheckoutItems at\n%s with params: %#v", m.CheckoutItemsMock.defaultExpectation.expectationOrigins.origin, *m.CheckoutItemsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckoutItems != nil && afterCheckoutItemsCounter < 1 {
+		m.t.Errorf("Expected call to CartRepositoryMock.CheckoutItems at\n%s", m.funcCheckoutItemsOrigin)
+	}
+
+	if !m.CheckoutItemsMock.invocationsDone() && afterCheckoutItemsCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartRepositoryMock.CheckoutItems at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckoutItemsMock.expectedInvocations), m.CheckoutItemsMock.expectedInvocationsOrigin, afterCheckoutItemsCounter)
+	}
+}
+
+type mCartRepositoryMockClearCart struct {
+	optional           bool
+	mock               *CartRepositoryMock
+	defaultExpectation *CartRepositoryMockClearCartExpectation
+	expectations       []*CartRepositoryMockClearCartExpectation
+
+	callArgs []*CartRepositoryMockClearCartParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CartRepositoryMockClearCartExpectation specifies expectation struct of the Repository.ClearCart
+type CartRepositoryMockClearCartExpectation struct {
+	mock               *CartRepositoryMock
+	params             *CartRepositoryMockClearCartParams
+	paramPtrs          *CartRepositoryMockClearCartParamPtrs
+	expectationOrigins CartRepositoryMockClearCartExpectationOrigins
+	results            *CartRepositoryMockClearCartResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CartRepositoryMockClearCartParams contains parameters of the Repository.ClearCart
+type CartRepositoryMockClearCartParams struct {
+	ctx    context.Context
+	userID uint64
+}
+
+// CartRepositoryMockClearCartParamPtrs contains pointers to parameters of the Repository.ClearCart
+type CartRepositoryMockClearCartParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+}
+
+// CartRepositoryMockClearCartResults contains results of the Repository.ClearCart
+type CartRepositoryMockClearCartResults struct {
+	err error
+}
+
+// CartRepositoryMockClearCartOrigins contains origins of expectations of the Repository.ClearCart
+type CartRepositoryMockClearCartExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmClearCart *mCartRepositoryMockClearCart) Optional() *mCartRepositoryMockClearCart {
+	mmClearCart.optional = true
+	return mmClearCart
+}
+
+// Expect sets up expected params for Repository.ClearCart
+func (mmClearCart *mCartRepositoryMockClearCart) Expect(ctx context.Context, userID uint64) *mCartRepositoryMockClearCart {
+	if mmClearCart.mock.funcClearCart != nil {
+		mmClearCart.mock.t.Fatalf("CartRepositoryMock.ClearCart mock is already set by Set")
+	}
+
+	if mmClearCart.defaultExpectation == nil {
+		mmClearCart.defaultExpectation = &CartRepositoryMockClearCartExpectation{}
+	}
+
+	if mmClearCart.defaultExpectation.paramPtrs != nil {
+		mmClearCart.mock.t.Fatalf("CartRepositoryMock.ClearCart mock is already set by ExpectParams functions")
+	}
+
+	mmClearCart.defaultExpectation.params = &CartRepositoryMockClearCartParams{ctx, userID}
+	mmClearCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmClearCart.expectations {
+		if minimock.Equal(e.params, mmClearCart.defaultExpectation.params) {
+			mmClearCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmClearCart.defaultExpectation.params)
+		}
+	}
+
+	return mmClearCart
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.ClearCart
+func (mmClearCart *mCartRepositoryMockClearCart) ExpectCtxParam1(ctx context.Context) *mCartRepositoryMockClearCart {
+	if mmClearCart.mock.funcClearCart != nil {
+		mmClearCart.mock.t.Fatalf("CartRepositoryMock.ClearCart mock is already set by Set")
+	}
+
+	if mmClearCart.defaultExpectation == nil {
+		mmClearCart.defaultExpectation = &CartRepositoryMockClearCartExpectation{}
+	}
+
+	if mmClearCart.defaultExpectation.params != nil {
+		mmClearCart.mock.t.Fatalf("CartRepositoryMock.ClearCart mock is already set by Expect")
+	}
+
+	if mmClearCart.defaultExpectation.paramPtrs == nil {
+		mmClearCart.defaultExpectation.paramPtrs = &CartRepositoryMockClearCartParamPtrs{}
+	}
+	mmClearCart.defaultExpectation.paramPtrs.ctx = &ctx
+	mmClearCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmClearCart
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.ClearCart
+func (mmClearCart *mCartRepositoryMockClearCart) ExpectUserIDParam2(userID uint64) *mCartRepositoryMockClearCart {
+	if mmClearCart.mock.funcClearCart != nil {
+		mmClearCart.mock.t.Fatalf("CartRepositoryMock.ClearCart mock is already set by Set")
+	}
+
+	if mmClearCart.defaultExpectation == nil {
+		mmClearCart.defaultExpectation = &CartRepositoryMockClearCartExpectation{}
+	}
+
+	if mmClearCart.defaultExpectation.params != nil {
+		mmClearCart.mock.t.Fatalf("CartRepositoryMock.ClearCart mock is already set by Expect")
+	}
+
+	if mmClearCart.defaultExpectation.paramPtrs == nil {
+		mmClearCart.defaultExpectation.paramPtrs = &CartRepositoryMockClearCartParamPtrs{}
+	}
+	mmClearCart.defaultExpectation.paramPtrs.userID = &userID
+	mmClearCart.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmClearCart
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.ClearCart
+func (mmClearCart *mCartRepositoryMockClearCart) Inspect(f func(ctx context.Context, userID uint64)) *mCartRepositoryMockClearCart {
+	if mmClearCart.mock.inspectFuncClearCart != nil {
+		mmClearCart.mock.t.Fatalf("Inspect function is already set for CartRepositoryMock.ClearCart")
+	}
+
+	mmClearCart.mock.inspectFuncClearCart = f
+
+	return mmClearCart
+}
+
+// Return sets up results that will be returned by Repository.ClearCart
+func (mmClearCart *mCartRepositoryMockClearCart) Return(err error) *CartRepositoryMock {
+	if mmClearCart.mock.funcClearCart != nil {
+		mmClearCart.mock.t.Fatalf("CartRepositoryMock.ClearCart mock is already set by Set")
+	}
+
+	if mmClearCart.defaultExpectation == nil {
+		mmClearCart.defaultExpectation = &CartRepositoryMockClearCartExpectation{mock: mmClearCart.mock}
+	}
+	mmClearCart.defaultExpectation.results = &CartRepositoryMockClearCartResults{err}
+	mmClearCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmClearCart.mock
+}
+
+// Set uses given function f to mock the Repository.ClearCart method
+func (mmClearCart *mCartRepositoryMockClearCart) Set(f func(ctx context.Context, userID uint64) (err error)) *CartRepositoryMock {
+	if mmClearCart.defaultExpectation != nil {
+		mmClearCart.mock.t.Fatalf("Default expectation is already set for the Repository.ClearCart method")
+	}
+
+	if len(mmClearCart.expectations) > 0 {
+		mmClearCart.mock.t.Fatalf("Some expectations are already set for the Repository.ClearCart method")
+	}
+
+	mmClearCart.mock.funcClearCart = f
+	mmClearCart.mock.funcClearCartOrigin = minimock.CallerInfo(1)
+	return mmClearCart.mock
+}
+
+// When sets expectation for the Repository.ClearCart which will trigger the result defined by the following
+// Then helper
+func (mmClearCart *mCartRepositoryMockClearCart) When(ctx context.Context, userID uint64) *CartRepositoryMockClearCartExpectation {
+	if mmClearCart.mock.funcClearCart != nil {
+		mmClearCart.mock.t.Fatalf("CartRepositoryMock.ClearCart mock is already set by Set")
+	}
+
+	expectation := &CartRepositoryMockClearCartExpectation{
+		mock:               mmClearCart.mock,
+		params:             &CartRepositoryMockClearCartParams{ctx, userID},
+		expectationOrigins: CartRepositoryMockClearCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmClearCart.expectations = append(mmClearCart.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.ClearCart return parameters for the expectation previously defined by the When method
+func (e *CartRepositoryMockClearCartExpectation) Then(err error) *CartRepositoryMock {
+	e.results = &CartRepositoryMockClearCartResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.ClearCart should be invoked
+func (mmClearCart *mCartRepositoryMockClearCart) Times(n uint64) *mCartRepositoryMockClearCart {
+	if n == 0 {
+		mmClearCart.mock.t.Fatalf("Times of CartRepositoryMock.ClearCart mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmClearCart.expectedInvocations, n)
+	mmClearCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmClearCart
+}
+
+func (mmClearCart *mCartRepositoryMockClearCart) invocationsDone() bool {
+	if len(mmClearCart.expectations) == 0 && mmClearCart.defaultExpectation == nil && mmClearCart.mock.funcClearCart == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmClearCart.mock.afterClearCartCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmClearCart.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ClearCart implements mm_cart.Repository
+func (mmClearCart *CartRepositoryMock) ClearCart(ctx context.Context, userID uint64) (err error) {
+	mm_atomic.AddUint64(&mmClearCart.beforeClearCartCounter, 1)
+	defer mm_atomic.AddUint64(&mmClearCart.afterClearCartCounter, 1)
+
+	mmClearCart.t.Helper()
+
+	if mmClearCart.inspectFuncClearCart != nil {
+		mmClearCart.inspectFuncClearCart(ctx, userID)
+	}
+
+	mm_params := CartRepositoryMockClearCartParams{ctx, userID}
+
+	// Record call args
+	mmClearCart.ClearCartMock.mutex.Lock()
+	mmClearCart.ClearCartMock.callArgs = append(mmClearCart.ClearCartMock.callArgs, &mm_params)
+	mmClearCart.ClearCartMock.mutex.Unlock()
+
+	for _, e := range mmClearCart.ClearCartMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmClearCart.ClearCartMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClearCart.ClearCartMock.defaultExpectation.Counter, 1)
+		mm_want := mmClearCart.ClearCartMock.defaultExpectation.params
+		mm_want_ptrs := mmClearCart.ClearCartMock.defaultExpectation.paramPtrs
+
+		mm_got := CartRepositoryMockClearCartParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmClearCart.t.Errorf("CartRepositoryMock.ClearCart got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClearCart.ClearCartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmClearCart.t.Errorf("CartRepositoryMock.ClearCart got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClearCart.ClearCartMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmClearCart.t.Errorf("CartRepositoryMock.ClearCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmClearCart.ClearCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmClearCart.ClearCartMock.defaultExpectation.results
+		if mm_results == nil {
+			mmClearCart.t.Fatal("No results are set for the CartRepositoryMock.ClearCart")
+		}
+		return (*mm_results).err
+	}
+	if mmClearCart.funcClearCart != nil {
+		return mmClearCart.funcClearCart(ctx, userID)
+	}
+	mmClearCart.t.Fatalf("Unexpected call to CartRepositoryMock.ClearCart. %v %v", ctx, userID)
+	return
+}
+
+// ClearCartAfterCounter returns a count of finished CartRepositoryMock.ClearCart invocations
+func (mmClearCart *CartRepositoryMock) ClearCartAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClearCart.afterClearCartCounter)
+}
+
+// ClearCartBeforeCounter returns a count of CartRepositoryMock.ClearCart invocations
+func (mmClearCart *CartRepositoryMock) ClearCartBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClearCart.beforeClearCartCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartRepositoryMock.ClearCart.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmClearCart *mCartRepositoryMockClearCart) Calls() []*CartRepositoryMockClearCartParams {
+	mmClearCart.mutex.RLock()
+
+	argCopy := make([]*CartRepositoryMockClearCartParams, len(mmClearCart.callArgs))
+	copy(argCopy, mmClearCart.callArgs)
+
+	mmClearCart.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockClearCartDone returns true if the count of the ClearCart invocations corresponds
+// the number of defined expectations
+func (m *CartRepositoryMock) MinimockClearCartDone() bool {
+	if m.ClearCartMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ClearCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ClearCartMock.invocationsDone()
+}
+
+// MinimockClearCartInspect logs each unmet expectation
+func (m *CartRepositoryMock) MinimockClearCartInspect() {
+	for _, e := range m.ClearCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartRepositoryMock.ClearCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterClearCartCounter := mm_atomic.LoadUint64(&m.afterClearCartCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ClearCartMock.defaultExpectation != nil && afterClearCartCounter < 1 {
+		if m.ClearCartMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CartRepositoryMock.ClearCart at\n%s", m.ClearCartMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CartRepositoryMock.ClearCart at\n%s with params: %#v", m.ClearCartMock.defaultExpectation.expectationOrigins.origin, *m.ClearCartMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClearCart != nil && afterClearCartCounter < 1 {
+		m.t.Errorf("Expected call to CartRepositoryMock.ClearCart at\n%s", m.funcClearCartOrigin)
+	}
+
+	if !m.ClearCartMock.invocationsDone() && afterClearCartCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartRepositoryMock.ClearCart at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ClearCartMock.expectedInvocations), m.ClearCartMock.expectedInvocationsOrigin, afterClearCartCounter)
+	}
+}
+
+type mCartRepositoryMockDeleteList struct {
+	optional           bool
+	mock               *CartRepositoryMock
+	defaultExpectation *CartRepositoryMockDeleteListExpectation
+	expectations       []*CartRepositoryMockDeleteListExpectation
+
+	callArgs []*CartRepositoryMockDeleteListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CartRepositoryMockDeleteListExpectation specifies expectation struct of the Repository.DeleteList
+type CartRepositoryMockDeleteListExpectation struct {
+	mock               *CartRepositoryMock
+	params             *CartRepositoryMockDeleteListParams
+	paramPtrs          *CartRepositoryMockDeleteListParamPtrs
+	expectationOrigins CartRepositoryMockDeleteListExpectationOrigins
+	results            *CartRepositoryMockDeleteListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CartRepositoryMockDeleteListParams contains parameters of the Repository.DeleteList
+type CartRepositoryMockDeleteListParams struct {
+	ctx    context.Context
+	userID uint64
+	list   string
+}
+
+// CartRepositoryMockDeleteListParamPtrs contains pointers to parameters of the Repository.DeleteList
+type CartRepositoryMockDeleteListParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+	list   *string
+}
+
+// CartRepositoryMockDeleteListResults contains results of the Repository.DeleteList
+type CartRepositoryMockDeleteListResults struct {
+	err error
+}
+
+// CartRepositoryMockDeleteListOrigins contains origins of expectations of the Repository.DeleteList
+type CartRepositoryMockDeleteListExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originList   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteList *mCartRepositoryMockDeleteList) Optional() *mCartRepositoryMockDeleteList {
+	mmDeleteList.optional = true
+	return mmDeleteList
+}
+
+// Expect sets up expected params for Repository.DeleteList
+func (mmDeleteList *mCartRepositoryMockDeleteList) Expect(ctx context.Context, userID uint64, list string) *mCartRepositoryMockDeleteList {
+	if mmDeleteList.mock.funcDeleteList != nil {
+		mmDeleteList.mock.t.Fatalf("CartRepositoryMock.DeleteList mock is already set by Set")
+	}
+
+	if mmDeleteList.defaultExpectation == nil {
+		mmDeleteList.defaultExpectation = &CartRepositoryMockDeleteListExpectation{}
+	}
+
+	if mmDeleteList.defaultExpectation.paramPtrs != nil {
+		mmDeleteList.mock.t.Fatalf("CartRepositoryMock.DeleteList mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteList.defaultExpectation.params = &CartRepositoryMockDeleteListParams{ctx, userID, list}
+	mmDeleteList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteList.expectations {
+		if minimock.Equal(e.params, mmDeleteList.defaultExpectation.params) {
+			mmDeleteList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteList.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.DeleteList
+func (mmDeleteList *mCartRepositoryMockDeleteList) ExpectCtxParam1(ctx context.Context) *mCartRepositoryMockDeleteList {
+	if mmDeleteList.mock.funcDeleteList != nil {
+		mmDeleteList.mock.t.Fatalf("CartRepositoryMock.DeleteList mock is already set by Set")
+	}
+
+	if mmDeleteList.defaultExpectation == nil {
+		mmDeleteList.defaultExpectation = &CartRepositoryMockDeleteListExpectation{}
+	}
+
+	if mmDeleteList.defaultExpectation.params != nil {
+		mmDeleteList.mock.t.Fatalf("CartRepositoryMock.DeleteList mock is already set by Expect")
+	}
+
+	if mmDeleteList.defaultExpectation.paramPtrs == nil {
+		mmDeleteList.defaultExpectation.paramPtrs = &CartRepositoryMockDeleteListParamPtrs{}
+	}
+	mmDeleteList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteList
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.DeleteList
+func (mmDeleteList *mCartRepositoryMockDeleteList) ExpectUserIDParam2(userID uint64) *mCartRepositoryMockDeleteList {
+	if mmDeleteList.mock.funcDeleteList != nil {
+		mmDeleteList.mock.t.Fatalf("CartRepositoryMock.DeleteList mock is already set by Set")
+	}
+
+	if mmDeleteList.defaultExpectation == nil {
+		mmDeleteList.defaultExpectation = &CartRepositoryMockDeleteListExpectation{}
+	}
+
+	if mmDeleteList.defaultExpectation.params != nil {
+		mmDeleteList.mock.t.Fatalf("CartRepositoryMock.DeleteList mock is already set by Expect")
+	}
+
+	if mmDeleteList.defaultExpectation.paramPtrs == nil {
+		mmDeleteList.defaultExpectation.paramPtrs = &CartRepositoryMockDeleteListParamPtrs{}
+	}
+	mmDeleteList.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteList.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteList
+}
+
+// ExpectListParam3 sets up expected param list for Repository.DeleteList
+func (mmDeleteList *mCartRepositoryMockDeleteList) ExpectListParam3(list string) *mCartRepositoryMockDeleteList {
+	if mmDeleteList.mock.funcDeleteList != nil {
+		mmDeleteList.mock.t.Fatalf("CartRepositoryMock.DeleteList mock is already set by Set")
+	}
+
+	if mmDeleteList.defaultExpectation == nil {
+		mmDeleteList.defaultExpectation = &CartRepositoryMockDeleteListExpectation{}
+	}
+
+	if mmDeleteList.defaultExpectation.params != nil {
+		mmDeleteList.mock.t.Fatalf("CartRepositoryMock.DeleteList mock is already set by Expect")
+	}
+
+	if mmDeleteList.defaultExpectation.paramPtrs == nil {
+		mmDeleteList.defaultExpectation.paramPtrs = &CartRepositoryMockDeleteListParamPtrs{}
+	}
+	mmDeleteList.defaultExpectation.paramPtrs.list = &list
+	mmDeleteList.defaultExpectation.expectationOrigins.originList = minimock.CallerInfo(1)
+
+	return mmDeleteList
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.DeleteList
+func (mmDeleteList *mCartRepositoryMockDeleteList) Inspect(f func(ctx context.Context, userID uint64, list string)) *mCartRepositoryMockDeleteList {
+	if mmDeleteList.mock.inspectFuncDeleteList != nil {
+		mmDeleteList.mock.t.Fatalf("Inspect function is already set for CartRepositoryMock.DeleteList")
+	}
+
+	mmDeleteList.mock.inspectFuncDeleteList = f
+
+	return mmDeleteList
+}
+
+// Return sets up results that will be returned by Repository.DeleteList
+func (mmDeleteList *mCartRepositoryMockDeleteList) Return(err error) *CartRepositoryMock {
+	if mmDeleteList.mock.funcDeleteList != nil {
+		mmDeleteList.mock.t.Fatalf("CartRepositoryMock.DeleteList mock is already set by Set")
+	}
+
+	if mmDeleteList.defaultExpectation == nil {
+		mmDeleteList.defaultExpectation = &CartRepositoryMockDeleteListExpectation{mock: mmDeleteList.mock}
+	}
+	mmDeleteList.defaultExpectation.results = &CartRepositoryMockDeleteListResults{err}
+	mmDeleteList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteList.mock
+}
+
+// Set uses given function f to mock the Repository.DeleteList method
+func (mmDeleteList *mCartRepositoryMockDeleteList) Set(f func(ctx context.Context, userID uint64, list string) (err error)) *CartRepositoryMock {
+	if mmDeleteList.defaultExpectation != nil {
+		mmDeleteList.mock.t.Fatalf("Default expectation is already set for the Repository.DeleteList method")
+	}
+
+	if len(mmDeleteList.expectations) > 0 {
+		mmDeleteList.mock.t.Fatalf("Some expectations are already set for the Repository.DeleteList method")
+	}
+
+	mmDeleteList.mock.funcDeleteList = f
+	mmDeleteList.mock.funcDeleteListOrigin = minimock.CallerInfo(1)
+	return mmDeleteList.mock
+}
+
+// When sets expectation for the Repository.DeleteList which will trigger the result defined by the following
+// Then helper
+func (mmDeleteList *mCartRepositoryMockDeleteList) When(ctx context.Context, userID uint64, list string) *CartRepositoryMockDeleteListExpectation {
+	if mmDeleteList.mock.funcDeleteList != nil {
+		mmDeleteList.mock.t.Fatalf("CartRepositoryMock.DeleteList mock is already set by Set")
+	}
+
+	expectation := &CartRepositoryMockDeleteListExpectation{
+		mock:               mmDeleteList.mock,
+		params:             &CartRepositoryMockDeleteListParams{ctx, userID, list},
+		expectationOrigins: CartRepositoryMockDeleteListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteList.expectations = append(mmDeleteList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.DeleteList return parameters for the expectation previously defined by the When method
+func (e *CartRepositoryMockDeleteListExpectation) Then(err error) *CartRepositoryMock {
+	e.results = &CartRepositoryMockDeleteListResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.DeleteList should be invoked
+func (mmDeleteList *mCartRepositoryMockDeleteList) Times(n uint64) *mCartRepositoryMockDeleteList {
+	if n == 0 {
+		mmDeleteList.mock.t.Fatalf("Times of CartRepositoryMock.DeleteList mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteList.expectedInvocations, n)
+	mmDeleteList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteList
+}
+
+func (mmDeleteList *mCartRepositoryMockDeleteList) invocationsDone() bool {
+	if len(mmDeleteList.expectations) == 0 && mmDeleteList.defaultExpectation == nil && mmDeleteList.mock.funcDeleteList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteList.mock.afterDeleteListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteList implements mm_cart.Repository
+func (mmDeleteList *CartRepositoryMock) DeleteList(ctx context.Context, userID uint64, list string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteList.beforeDeleteListCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteList.afterDeleteListCounter, 1)
+
+	mmDeleteList.t.Helper()
+
+	if mmDeleteList.inspectFuncDeleteList != nil {
+		mmDeleteList.inspectFuncDeleteList(ctx, userID, list)
+	}
+
+	mm_params := CartRepositoryMockDeleteListParams{ctx, userID, list}
+
+	// Record call args
+	mmDeleteList.DeleteListMock.mutex.Lock()
+	mmDeleteList.DeleteListMock.callArgs = append(mmDeleteList.DeleteListMock.callArgs, &mm_params)
+	mmDeleteList.DeleteListMock.mutex.Unlock()
+
+	for _, e := range mmDeleteList.DeleteListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteList.DeleteListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteList.DeleteListMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteList.DeleteListMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteList.DeleteListMock.defaultExpectation.paramPtrs
+
+		mm_got := CartRepositoryMockDeleteListParams{ctx, userID, list}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteList.t.Errorf("CartRepositoryMock.DeleteList got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteList.DeleteListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteList.t.Errorf("CartRepositoryMock.DeleteList got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteList.DeleteListMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.list != nil && !minimock.Equal(*mm_want_ptrs.list, mm_got.list) {
+				mmDeleteList.t.Errorf("CartRepositoryMock.DeleteList got unexpected parameter list, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteList.DeleteListMock.defaultExpectation.expectationOrigins.originList, *mm_want_ptrs.list, mm_got.list, minimock.Diff(*mm_want_ptrs.list, mm_got.list))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteList.t.Errorf("CartRepositoryMock.DeleteList got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteList.DeleteListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteList.DeleteListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteList.t.Fatal("No results are set for the CartRepositoryMock.DeleteList")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteList.funcDeleteList != nil {
+		return mmDeleteList.funcDeleteList(ctx, userID, list)
+	}
+	mmDeleteList.t.Fatalf("Unexpected call to CartRepositoryMock.DeleteList. %v %v %v", ctx, userID, list)
+	return
+}
+
+// DeleteListAfterCounter returns a count of finished CartRepositoryMock.DeleteList invocations
+func (mmDeleteList *CartRepositoryMock) DeleteListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteList.afterDeleteListCounter)
+}
+
+// DeleteListBeforeCounter returns a count of CartRepositoryMock.DeleteList invocations
+func (mmDeleteList *CartRepositoryMock) DeleteListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteList.beforeDeleteListCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartRepositoryMock.DeleteList.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteList *mCartRepositoryMockDeleteList) Calls() []*CartRepositoryMockDeleteListParams {
+	mmDeleteList.mutex.RLock()
+
+	argCopy := make([]*CartRepositoryMockDeleteListParams, len(mmDeleteList.callArgs))
+	copy(argCopy, mmDeleteList.callArgs)
+
+	mmDeleteList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteListDone returns true if the count of the DeleteList invocations corresponds
+// the number of defined expectations
+func (m *CartRepositoryMock) MinimockDeleteListDone() bool {
+	if m.DeleteListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteListMock.invocationsDone()
+}
+
+// MinimockDeleteListInspect logs each unmet expectation
+func (m *CartRepositoryMock) MinimockDeleteListInspect() {
+	for _, e := range m.DeleteListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartRepositoryMock.DeleteList at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteListCounter := mm_atomic.LoadUint64(&m.afterDeleteListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteListMock.defaultExpectation != nil && afterDeleteListCounter < 1 {
+		if m.DeleteListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CartRepositoryMock.DeleteList at\n%s", m.DeleteListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CartRepositoryMock.DeleteList at\n%s with params: %#v", m.DeleteListMock.defaultExpectation.expectationOrigins.origin, *m.DeleteListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteList != nil && afterDeleteListCounter < 1 {
+		m.t.Errorf("Expected call to CartRepositoryMock.DeleteList at\n%s", m.funcDeleteListOrigin)
+	}
+
+	if !m.DeleteListMock.invocationsDone() && afterDeleteListCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartRepositoryMock.DeleteList at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteListMock.expectedInvocations), m.DeleteListMock.expectedInvocationsOrigin, afterDeleteListCounter)
+	}
+}
+
+type mCartRepositoryMockGetCart struct {
+	optional           bool
+	mock               *CartRepositoryMock
+	defaultExpectation *CartRepositoryMockGetCartExpectation
+	expectations       []*CartRepositoryMockGetCartExpectation
+
+	callArgs []*CartRepositoryMockGetCartParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CartRepositoryMockGetCartExpectation specifies expectation struct of the Repository.GetCart
+type CartRepositoryMockGetCartExpectation struct {
+	mock               *CartRepositoryMock
+	params             *CartRepositoryMockGetCartParams
+	paramPtrs          *CartRepositoryMockGetCartParamPtrs
+	expectationOrigins CartRepositoryMockGetCartExpectationOrigins
+	results            *CartRepositoryMockGetCartResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CartRepositoryMockGetCartParams contains parameters of the Repository.GetCart
+type CartRepositoryMockGetCartParams struct {
+	ctx    context.Context
+	userID uint64
+}
+
+// CartRepositoryMockGetCartParamPtrs contains pointers to parameters of the Repository.GetCart
+type CartRepositoryMockGetCartParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+}
+
+// CartRepositoryMockGetCartResults contains results of the Repository.GetCart
+type CartRepositoryMockGetCartResults struct {
+	m1  map[int64]uint16
+	err error
+}
+
+// CartRepositoryMockGetCartOrigins contains origins of expectations of the Repository.GetCart
+type CartRepositoryMockGetCartExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCart *mCartRepositoryMockGetCart) Optional() *mCartRepositoryMockGetCart {
+	mmGetCart.optional = true
+	return mmGetCart
 }
 
 // Expect sets up expected params for Repository.GetCart
@@ -883,333 +2247,3695 @@ func (mmGetCart *mCartRepositoryMockGetCart) Expect(ctx context.Context, userID
 		mmGetCart.mock.t.Fatalf("CartRepositoryMock.GetCart mock is already set by Set")
 	}
 
-	if mmGetCart.defaultExpectation == nil {
-		mmGetCart.defaultExpectation = &CartRepositoryMockGetCartExpectation{}
+	if mmGetCart.defaultExpectation == nil {
+		mmGetCart.defaultExpectation = &CartRepositoryMockGetCartExpectation{}
+	}
+
+	if mmGetCart.defaultExpectation.paramPtrs != nil {
+		mmGetCart.mock.t.Fatalf("CartRepositoryMock.GetCart mock is already set by ExpectParams functions")
+	}
+
+	mmGetCart.defaultExpectation.params = &CartRepositoryMockGetCartParams{ctx, userID}
+	mmGetCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCart.expectations {
+		if minimock.Equal(e.params, mmGetCart.defaultExpectation.params) {
+			mmGetCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCart.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCart
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetCart
+func (mmGetCart *mCartRepositoryMockGetCart) ExpectCtxParam1(ctx context.Context) *mCartRepositoryMockGetCart {
+	if mmGetCart.mock.funcGetCart != nil {
+		mmGetCart.mock.t.Fatalf("CartRepositoryMock.GetCart mock is already set by Set")
+	}
+
+	if mmGetCart.defaultExpectation == nil {
+		mmGetCart.defaultExpectation = &CartRepositoryMockGetCartExpectation{}
+	}
+
+	if mmGetCart.defaultExpectation.params != nil {
+		mmGetCart.mock.t.Fatalf("CartRepositoryMock.GetCart mock is already set by Expect")
+	}
+
+	if mmGetCart.defaultExpectation.paramPtrs == nil {
+		mmGetCart.defaultExpectation.paramPtrs = &CartRepositoryMockGetCartParamPtrs{}
+	}
+	mmGetCart.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCart
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.GetCart
+func (mmGetCart *mCartRepositoryMockGetCart) ExpectUserIDParam2(userID uint64) *mCartRepositoryMockGetCart {
+	if mmGetCart.mock.funcGetCart != nil {
+		mmGetCart.mock.t.Fatalf("CartRepositoryMock.GetCart mock is already set by Set")
+	}
+
+	if mmGetCart.defaultExpectation == nil {
+		mmGetCart.defaultExpectation = &CartRepositoryMockGetCartExpectation{}
+	}
+
+	if mmGetCart.defaultExpectation.params != nil {
+		mmGetCart.mock.t.Fatalf("CartRepositoryMock.GetCart mock is already set by Expect")
+	}
+
+	if mmGetCart.defaultExpectation.paramPtrs == nil {
+		mmGetCart.defaultExpectation.paramPtrs = &CartRepositoryMockGetCartParamPtrs{}
+	}
+	mmGetCart.defaultExpectation.paramPtrs.userID = &userID
+	mmGetCart.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetCart
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetCart
+func (mmGetCart *mCartRepositoryMockGetCart) Inspect(f func(ctx context.Context, userID uint64)) *mCartRepositoryMockGetCart {
+	if mmGetCart.mock.inspectFuncGetCart != nil {
+		mmGetCart.mock.t.Fatalf("Inspect function is already set for CartRepositoryMock.GetCart")
+	}
+
+	mmGetCart.mock.inspectFuncGetCart = f
+
+	return mmGetCart
+}
+
+// Return sets up results that will be returned by Repository.GetCart
+func (mmGetCart *mCartRepositoryMockGetCart) Return(m1 map[int64]uint16, err error) *CartRepositoryMock {
+	if mmGetCart.mock.funcGetCart != nil {
+		mmGetCart.mock.t.Fatalf("CartRepositoryMock.GetCart mock is already set by Set")
+	}
+
+	if mmGetCart.defaultExpectation == nil {
+		mmGetCart.defaultExpectation = &CartRepositoryMockGetCartExpectation{mock: mmGetCart.mock}
+	}
+	mmGetCart.defaultExpectation.results = &CartRepositoryMockGetCartResults{m1, err}
+	mmGetCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCart.mock
+}
+
+// Set uses given function f to mock the Repository.GetCart method
+func (mmGetCart *mCartRepositoryMockGetCart) Set(f func(ctx context.Context, userID uint64) (m1 map[int64]uint16, err error)) *CartRepositoryMock {
+	if mmGetCart.defaultExpectation != nil {
+		mmGetCart.mock.t.Fatalf("Default expectation is already set for the Repository.GetCart method")
+	}
+
+	if len(mmGetCart.expectations) > 0 {
+		mmGetCart.mock.t.Fatalf("Some expectations are already set for the Repository.GetCart method")
+	}
+
+	mmGetCart.mock.funcGetCart = f
+	mmGetCart.mock.funcGetCartOrigin = minimock.CallerInfo(1)
+	return mmGetCart.mock
+}
+
+// When sets expectation for the Repository.GetCart which will trigger the result defined by the following
+// Then helper
+func (mmGetCart *mCartRepositoryMockGetCart) When(ctx context.Context, userID uint64) *CartRepositoryMockGetCartExpectation {
+	if mmGetCart.mock.funcGetCart != nil {
+		mmGetCart.mock.t.Fatalf("CartRepositoryMock.GetCart mock is already set by Set")
+	}
+
+	expectation := &CartRepositoryMockGetCartExpectation{
+		mock:               mmGetCart.mock,
+		params:             &CartRepositoryMockGetCartParams{ctx, userID},
+		expectationOrigins: CartRepositoryMockGetCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCart.expectations = append(mmGetCart.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetCart return parameters for the expectation previously defined by the When method
+func (e *CartRepositoryMockGetCartExpectation) Then(m1 map[int64]uint16, err error) *CartRepositoryMock {
+	e.results = &CartRepositoryMockGetCartResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetCart should be invoked
+func (mmGetCart *mCartRepositoryMockGetCart) Times(n uint64) *mCartRepositoryMockGetCart {
+	if n == 0 {
+		mmGetCart.mock.t.Fatalf("Times of CartRepositoryMock.GetCart mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCart.expectedInvocations, n)
+	mmGetCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCart
+}
+
+func (mmGetCart *mCartRepositoryMockGetCart) invocationsDone() bool {
+	if len(mmGetCart.expectations) == 0 && mmGetCart.defaultExpectation == nil && mmGetCart.mock.funcGetCart == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCart.mock.afterGetCartCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCart.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCart implements mm_cart.Repository
+func (mmGetCart *CartRepositoryMock) GetCart(ctx context.Context, userID uint64) (m1 map[int64]uint16, err error) {
+	mm_atomic.AddUint64(&mmGetCart.beforeGetCartCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCart.afterGetCartCounter, 1)
+
+	mmGetCart.t.Helper()
+
+	if mmGetCart.inspectFuncGetCart != nil {
+		mmGetCart.inspectFuncGetCart(ctx, userID)
+	}
+
+	mm_params := CartRepositoryMockGetCartParams{ctx, userID}
+
+	// Record call args
+	mmGetCart.GetCartMock.mutex.Lock()
+	mmGetCart.GetCartMock.callArgs = append(mmGetCart.GetCartMock.callArgs, &mm_params)
+	mmGetCart.GetCartMock.mutex.Unlock()
+
+	for _, e := range mmGetCart.GetCartMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmGetCart.GetCartMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCart.GetCartMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCart.GetCartMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCart.GetCartMock.defaultExpectation.paramPtrs
+
+		mm_got := CartRepositoryMockGetCartParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCart.t.Errorf("CartRepositoryMock.GetCart got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCart.GetCartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetCart.t.Errorf("CartRepositoryMock.GetCart got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCart.GetCartMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCart.t.Errorf("CartRepositoryMock.GetCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCart.GetCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCart.GetCartMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCart.t.Fatal("No results are set for the CartRepositoryMock.GetCart")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmGetCart.funcGetCart != nil {
+		return mmGetCart.funcGetCart(ctx, userID)
+	}
+	mmGetCart.t.Fatalf("Unexpected call to CartRepositoryMock.GetCart. %v %v", ctx, userID)
+	return
+}
+
+// GetCartAfterCounter returns a count of finished CartRepositoryMock.GetCart invocations
+func (mmGetCart *CartRepositoryMock) GetCartAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCart.afterGetCartCounter)
+}
+
+// GetCartBeforeCounter returns a count of CartRepositoryMock.GetCart invocations
+func (mmGetCart *CartRepositoryMock) GetCartBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCart.beforeGetCartCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartRepositoryMock.GetCart.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCart *mCartRepositoryMockGetCart) Calls() []*CartRepositoryMockGetCartParams {
+	mmGetCart.mutex.RLock()
+
+	argCopy := make([]*CartRepositoryMockGetCartParams, len(mmGetCart.callArgs))
+	copy(argCopy, mmGetCart.callArgs)
+
+	mmGetCart.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCartDone returns true if the count of the GetCart invocations corresponds
+// the number of defined expectations
+func (m *CartRepositoryMock) MinimockGetCartDone() bool {
+	if m.GetCartMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCartMock.invocationsDone()
+}
+
+// MinimockGetCartInspect logs each unmet expectation
+func (m *CartRepositoryMock) MinimockGetCartInspect() {
+	for _, e := range m.GetCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartRepositoryMock.GetCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCartCounter := mm_atomic.LoadUint64(&m.afterGetCartCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCartMock.defaultExpectation != nil && afterGetCartCounter < 1 {
+		if m.GetCartMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CartRepositoryMock.GetCart at\n%s", m.GetCartMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CartRepositoryMock.GetCart at\n%s with params: %#v", m.GetCartMock.defaultExpectation.expectationOrigins.origin, *m.GetCartMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCart != nil && afterGetCartCounter < 1 {
+		m.t.Errorf("Expected call to CartRepositoryMock.GetCart at\n%s", m.funcGetCartOrigin)
+	}
+
+	if !m.GetCartMock.invocationsDone() && afterGetCartCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartRepositoryMock.GetCart at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCartMock.expectedInvocations), m.GetCartMock.expectedInvocationsOrigin, afterGetCartCounter)
+	}
+}
+
+type mCartRepositoryMockGetCoupon struct {
+	optional           bool
+	mock               *CartRepositoryMock
+	defaultExpectation *CartRepositoryMockGetCouponExpectation
+	expectations       []*CartRepositoryMockGetCouponExpectation
+
+	callArgs []*CartRepositoryMockGetCouponParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CartRepositoryMockGetCouponExpectation specifies expectation struct of the Repository.GetCoupon
+type CartRepositoryMockGetCouponExpectation struct {
+	mock               *CartRepositoryMock
+	params             *CartRepositoryMockGetCouponParams
+	paramPtrs          *CartRepositoryMockGetCouponParamPtrs
+	expectationOrigins CartRepositoryMockGetCouponExpectationOrigins
+	results            *CartRepositoryMockGetCouponResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CartRepositoryMockGetCouponParams contains parameters of the Repository.GetCoupon
+type CartRepositoryMockGetCouponParams struct {
+	ctx    context.Context
+	userID uint64
+}
+
+// CartRepositoryMockGetCouponParamPtrs contains pointers to parameters of the Repository.GetCoupon
+type CartRepositoryMockGetCouponParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+}
+
+// CartRepositoryMockGetCouponResults contains results of the Repository.GetCoupon
+type CartRepositoryMockGetCouponResults struct {
+	s1  string
+	err error
+}
+
+// CartRepositoryMockGetCouponOrigins contains origins of expectations of the Repository.GetCoupon
+type CartRepositoryMockGetCouponExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCoupon *mCartRepositoryMockGetCoupon) Optional() *mCartRepositoryMockGetCoupon {
+	mmGetCoupon.optional = true
+	return mmGetCoupon
+}
+
+// Expect sets up expected params for Repository.GetCoupon
+func (mmGetCoupon *mCartRepositoryMockGetCoupon) Expect(ctx context.Context, userID uint64) *mCartRepositoryMockGetCoupon {
+	if mmGetCoupon.mock.funcGetCoupon != nil {
+		mmGetCoupon.mock.t.Fatalf("CartRepositoryMock.GetCoupon mock is already set by Set")
+	}
+
+	if mmGetCoupon.defaultExpectation == nil {
+		mmGetCoupon.defaultExpectation = &CartRepositoryMockGetCouponExpectation{}
+	}
+
+	if mmGetCoupon.defaultExpectation.paramPtrs != nil {
+		mmGetCoupon.mock.t.Fatalf("CartRepositoryMock.GetCoupon mock is already set by ExpectParams functions")
+	}
+
+	mmGetCoupon.defaultExpectation.params = &CartRepositoryMockGetCouponParams{ctx, userID}
+	mmGetCoupon.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCoupon.expectations {
+		if minimock.Equal(e.params, mmGetCoupon.defaultExpectation.params) {
+			mmGetCoupon.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCoupon.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCoupon
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetCoupon
+func (mmGetCoupon *mCartRepositoryMockGetCoupon) ExpectCtxParam1(ctx context.Context) *mCartRepositoryMockGetCoupon {
+	if mmGetCoupon.mock.funcGetCoupon != nil {
+		mmGetCoupon.mock.t.Fatalf("CartRepositoryMock.GetCoupon mock is already set by Set")
+	}
+
+	if mmGetCoupon.defaultExpectation == nil {
+		mmGetCoupon.defaultExpectation = &CartRepositoryMockGetCouponExpectation{}
+	}
+
+	if mmGetCoupon.defaultExpectation.params != nil {
+		mmGetCoupon.mock.t.Fatalf("CartRepositoryMock.GetCoupon mock is already set by Expect")
+	}
+
+	if mmGetCoupon.defaultExpectation.paramPtrs == nil {
+		mmGetCoupon.defaultExpectation.paramPtrs = &CartRepositoryMockGetCouponParamPtrs{}
+	}
+	mmGetCoupon.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCoupon.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCoupon
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.GetCoupon
+func (mmGetCoupon *mCartRepositoryMockGetCoupon) ExpectUserIDParam2(userID uint64) *mCartRepositoryMockGetCoupon {
+	if mmGetCoupon.mock.funcGetCoupon != nil {
+		mmGetCoupon.mock.t.Fatalf("CartRepositoryMock.GetCoupon mock is already set by Set")
+	}
+
+	if mmGetCoupon.defaultExpectation == nil {
+		mmGetCoupon.defaultExpectation = &CartRepositoryMockGetCouponExpectation{}
+	}
+
+	if mmGetCoupon.defaultExpectation.params != nil {
+		mmGetCoupon.mock.t.Fatalf("CartRepositoryMock.GetCoupon mock is already set by Expect")
+	}
+
+	if mmGetCoupon.defaultExpectation.paramPtrs == nil {
+		mmGetCoupon.defaultExpectation.paramPtrs = &CartRepositoryMockGetCouponParamPtrs{}
+	}
+	mmGetCoupon.defaultExpectation.paramPtrs.userID = &userID
+	mmGetCoupon.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetCoupon
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetCoupon
+func (mmGetCoupon *mCartRepositoryMockGetCoupon) Inspect(f func(ctx context.Context, userID uint64)) *mCartRepositoryMockGetCoupon {
+	if mmGetCoupon.mock.inspectFuncGetCoupon != nil {
+		mmGetCoupon.mock.t.Fatalf("Inspect function is already set for CartRepositoryMock.GetCoupon")
+	}
+
+	mmGetCoupon.mock.inspectFuncGetCoupon = f
+
+	return mmGetCoupon
+}
+
+// Return sets up results that will be returned by Repository.GetCoupon
+func (mmGetCoupon *mCartRepositoryMockGetCoupon) Return(s1 string, err error) *CartRepositoryMock {
+	if mmGetCoupon.mock.funcGetCoupon != nil {
+		mmGetCoupon.mock.t.Fatalf("CartRepositoryMock.GetCoupon mock is already set by Set")
+	}
+
+	if mmGetCoupon.defaultExpectation == nil {
+		mmGetCoupon.defaultExpectation = &CartRepositoryMockGetCouponExpectation{mock: mmGetCoupon.mock}
+	}
+	mmGetCoupon.defaultExpectation.results = &CartRepositoryMockGetCouponResults{s1, err}
+	mmGetCoupon.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCoupon.mock
+}
+
+// Set uses given function f to mock the Repository.GetCoupon method
+func (mmGetCoupon *mCartRepositoryMockGetCoupon) Set(f func(ctx context.Context, userID uint64) (s1 string, err error)) *CartRepositoryMock {
+	if mmGetCoupon.defaultExpectation != nil {
+		mmGetCoupon.mock.t.Fatalf("Default expectation is already set for the Repository.GetCoupon method")
+	}
+
+	if len(mmGetCoupon.expectations) > 0 {
+		mmGetCoupon.mock.t.Fatalf("Some expectations are already set for the Repository.GetCoupon method")
+	}
+
+	mmGetCoupon.mock.funcGetCoupon = f
+	mmGetCoupon.mock.funcGetCouponOrigin = minimock.CallerInfo(1)
+	return mmGetCoupon.mock
+}
+
+// When sets expectation for the Repository.GetCoupon which will trigger the result defined by the following
+// Then helper
+func (mmGetCoupon *mCartRepositoryMockGetCoupon) When(ctx context.Context, userID uint64) *CartRepositoryMockGetCouponExpectation {
+	if mmGetCoupon.mock.funcGetCoupon != nil {
+		mmGetCoupon.mock.t.Fatalf("CartRepositoryMock.GetCoupon mock is already set by Set")
+	}
+
+	expectation := &CartRepositoryMockGetCouponExpectation{
+		mock:               mmGetCoupon.mock,
+		params:             &CartRepositoryMockGetCouponParams{ctx, userID},
+		expectationOrigins: CartRepositoryMockGetCouponExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCoupon.expectations = append(mmGetCoupon.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetCoupon return parameters for the expectation previously defined by the When method
+func (e *CartRepositoryMockGetCouponExpectation) Then(s1 string, err error) *CartRepositoryMock {
+	e.results = &CartRepositoryMockGetCouponResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetCoupon should be invoked
+func (mmGetCoupon *mCartRepositoryMockGetCoupon) Times(n uint64) *mCartRepositoryMockGetCoupon {
+	if n == 0 {
+		mmGetCoupon.mock.t.Fatalf("Times of CartRepositoryMock.GetCoupon mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCoupon.expectedInvocations, n)
+	mmGetCoupon.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCoupon
+}
+
+func (mmGetCoupon *mCartRepositoryMockGetCoupon) invocationsDone() bool {
+	if len(mmGetCoupon.expectations) == 0 && mmGetCoupon.defaultExpectation == nil && mmGetCoupon.mock.funcGetCoupon == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCoupon.mock.afterGetCouponCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCoupon.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCoupon implements mm_cart.Repository
+func (mmGetCoupon *CartRepositoryMock) GetCoupon(ctx context.Context, userID uint64) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmGetCoupon.beforeGetCouponCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCoupon.afterGetCouponCounter, 1)
+
+	mmGetCoupon.t.Helper()
+
+	if mmGetCoupon.inspectFuncGetCoupon != nil {
+		mmGetCoupon.inspectFuncGetCoupon(ctx, userID)
+	}
+
+	mm_params := CartRepositoryMockGetCouponParams{ctx, userID}
+
+	// Record call args
+	mmGetCoupon.GetCouponMock.mutex.Lock()
+	mmGetCoupon.GetCouponMock.callArgs = append(mmGetCoupon.GetCouponMock.callArgs, &mm_params)
+	mmGetCoupon.GetCouponMock.mutex.Unlock()
+
+	for _, e := range mmGetCoupon.GetCouponMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetCoupon.GetCouponMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCoupon.GetCouponMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCoupon.GetCouponMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCoupon.GetCouponMock.defaultExpectation.paramPtrs
+
+		mm_got := CartRepositoryMockGetCouponParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCoupon.t.Errorf("CartRepositoryMock.GetCoupon got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCoupon.GetCouponMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetCoupon.t.Errorf("CartRepositoryMock.GetCoupon got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCoupon.GetCouponMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCoupon.t.Errorf("CartRepositoryMock.GetCoupon got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCoupon.GetCouponMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCoupon.GetCouponMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCoupon.t.Fatal("No results are set for the CartRepositoryMock.GetCoupon")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetCoupon.funcGetCoupon != nil {
+		return mmGetCoupon.funcGetCoupon(ctx, userID)
+	}
+	mmGetCoupon.t.Fatalf("Unexpected call to CartRepositoryMock.GetCoupon. %v %v", ctx, userID)
+	return
+}
+
+// GetCouponAfterCounter returns a count of finished CartRepositoryMock.GetCoupon invocations
+func (mmGetCoupon *CartRepositoryMock) GetCouponAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCoupon.afterGetCouponCounter)
+}
+
+// GetCouponBeforeCounter returns a count of CartRepositoryMock.GetCoupon invocations
+func (mmGetCoupon *CartRepositoryMock) GetCouponBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCoupon.beforeGetCouponCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartRepositoryMock.GetCoupon.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCoupon *mCartRepositoryMockGetCoupon) Calls() []*CartRepositoryMockGetCouponParams {
+	mmGetCoupon.mutex.RLock()
+
+	argCopy := make([]*CartRepositoryMockGetCouponParams, len(mmGetCoupon.callArgs))
+	copy(argCopy, mmGetCoupon.callArgs)
+
+	mmGetCoupon.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCouponDone returns true if the count of the GetCoupon invocations corresponds
+// the number of defined expectations
+func (m *CartRepositoryMock) MinimockGetCouponDone() bool {
+	if m.GetCouponMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCouponMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCouponMock.invocationsDone()
+}
+
+// MinimockGetCouponInspect logs each unmet expectation
+func (m *CartRepositoryMock) MinimockGetCouponInspect() {
+	for _, e := range m.GetCouponMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartRepositoryMock.GetCoupon at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCouponCounter := mm_atomic.LoadUint64(&m.afterGetCouponCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCouponMock.defaultExpectation != nil && afterGetCouponCounter < 1 {
+		if m.GetCouponMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CartRepositoryMock.GetCoupon at\n%s", m.GetCouponMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CartRepositoryMock.GetCoupon at\n%s with params: %#v", m.GetCouponMock.defaultExpectation.expectationOrigins.origin, *m.GetCouponMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCoupon != nil && afterGetCouponCounter < 1 {
+		m.t.Errorf("Expected call to CartRepositoryMock.GetCoupon at\n%s", m.funcGetCouponOrigin)
+	}
+
+	if !m.GetCouponMock.invocationsDone() && afterGetCouponCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartRepositoryMock.GetCoupon at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCouponMock.expectedInvocations), m.GetCouponMock.expectedInvocationsOrigin, afterGetCouponCounter)
+	}
+}
+
+type mCartRepositoryMockGetList struct {
+	optional           bool
+	mock               *CartRepositoryMock
+	defaultExpectation *CartRepositoryMockGetListExpectation
+	expectations       []*CartRepositoryMockGetListExpectation
+
+	callArgs []*CartRepositoryMockGetListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CartRepositoryMockGetListExpectation specifies expectation struct of the Repository.GetList
+type CartRepositoryMockGetListExpectation struct {
+	mock               *CartRepositoryMock
+	params             *CartRepositoryMockGetListParams
+	paramPtrs          *CartRepositoryMockGetListParamPtrs
+	expectationOrigins CartRepositoryMockGetListExpectationOrigins
+	results            *CartRepositoryMockGetListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CartRepositoryMockGetListParams contains parameters of the Repository.GetList
+type CartRepositoryMockGetListParams struct {
+	ctx    context.Context
+	userID uint64
+	list   string
+}
+
+// CartRepositoryMockGetListParamPtrs contains pointers to parameters of the Repository.GetList
+type CartRepositoryMockGetListParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+	list   *string
+}
+
+// CartRepositoryMockGetListResults contains results of the Repository.GetList
+type CartRepositoryMockGetListResults struct {
+	m1  map[int64]uint16
+	err error
+}
+
+// CartRepositoryMockGetListOrigins contains origins of expectations of the Repository.GetList
+type CartRepositoryMockGetListExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originList   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetList *mCartRepositoryMockGetList) Optional() *mCartRepositoryMockGetList {
+	mmGetList.optional = true
+	return mmGetList
+}
+
+// Expect sets up expected params for Repository.GetList
+func (mmGetList *mCartRepositoryMockGetList) Expect(ctx context.Context, userID uint64, list string) *mCartRepositoryMockGetList {
+	if mmGetList.mock.funcGetList != nil {
+		mmGetList.mock.t.Fatalf("CartRepositoryMock.GetList mock is already set by Set")
+	}
+
+	if mmGetList.defaultExpectation == nil {
+		mmGetList.defaultExpectation = &CartRepositoryMockGetListExpectation{}
+	}
+
+	if mmGetList.defaultExpectation.paramPtrs != nil {
+		mmGetList.mock.t.Fatalf("CartRepositoryMock.GetList mock is already set by ExpectParams functions")
+	}
+
+	mmGetList.defaultExpectation.params = &CartRepositoryMockGetListParams{ctx, userID, list}
+	mmGetList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetList.expectations {
+		if minimock.Equal(e.params, mmGetList.defaultExpectation.params) {
+			mmGetList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetList.defaultExpectation.params)
+		}
+	}
+
+	return mmGetList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetList
+func (mmGetList *mCartRepositoryMockGetList) ExpectCtxParam1(ctx context.Context) *mCartRepositoryMockGetList {
+	if mmGetList.mock.funcGetList != nil {
+		mmGetList.mock.t.Fatalf("CartRepositoryMock.GetList mock is already set by Set")
+	}
+
+	if mmGetList.defaultExpectation == nil {
+		mmGetList.defaultExpectation = &CartRepositoryMockGetListExpectation{}
+	}
+
+	if mmGetList.defaultExpectation.params != nil {
+		mmGetList.mock.t.Fatalf("CartRepositoryMock.GetList mock is already set by Expect")
+	}
+
+	if mmGetList.defaultExpectation.paramPtrs == nil {
+		mmGetList.defaultExpectation.paramPtrs = &CartRepositoryMockGetListParamPtrs{}
+	}
+	mmGetList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetList
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.GetList
+func (mmGetList *mCartRepositoryMockGetList) ExpectUserIDParam2(userID uint64) *mCartRepositoryMockGetList {
+	if mmGetList.mock.funcGetList != nil {
+		mmGetList.mock.t.Fatalf("CartRepositoryMock.GetList mock is already set by Set")
+	}
+
+	if mmGetList.defaultExpectation == nil {
+		mmGetList.defaultExpectation = &CartRepositoryMockGetListExpectation{}
+	}
+
+	if mmGetList.defaultExpectation.params != nil {
+		mmGetList.mock.t.Fatalf("CartRepositoryMock.GetList mock is already set by Expect")
+	}
+
+	if mmGetList.defaultExpectation.paramPtrs == nil {
+		mmGetList.defaultExpectation.paramPtrs = &CartRepositoryMockGetListParamPtrs{}
+	}
+	mmGetList.defaultExpectation.paramPtrs.userID = &userID
+	mmGetList.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetList
+}
+
+// ExpectListParam3 sets up expected param list for Repository.GetList
+func (mmGetList *mCartRepositoryMockGetList) ExpectListParam3(list string) *mCartRepositoryMockGetList {
+	if mmGetList.mock.funcGetList != nil {
+		mmGetList.mock.t.Fatalf("CartRepositoryMock.GetList mock is already set by Set")
+	}
+
+	if mmGetList.defaultExpectation == nil {
+		mmGetList.defaultExpectation = &CartRepositoryMockGetListExpectation{}
+	}
+
+	if mmGetList.defaultExpectation.params != nil {
+		mmGetList.mock.t.Fatalf("CartRepositoryMock.GetList mock is already set by Expect")
+	}
+
+	if mmGetList.defaultExpectation.paramPtrs == nil {
+		mmGetList.defaultExpectation.paramPtrs = &CartRepositoryMockGetListParamPtrs{}
+	}
+	mmGetList.defaultExpectation.paramPtrs.list = &list
+	mmGetList.defaultExpectation.expectationOrigins.originList = minimock.CallerInfo(1)
+
+	return mmGetList
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetList
+func (mmGetList *mCartRepositoryMockGetList) Inspect(f func(ctx context.Context, userID uint64, list string)) *mCartRepositoryMockGetList {
+	if mmGetList.mock.inspectFuncGetList != nil {
+		mmGetList.mock.t.Fatalf("Inspect function is already set for CartRepositoryMock.GetList")
+	}
+
+	mmGetList.mock.inspectFuncGetList = f
+
+	return mmGetList
+}
+
+// Return sets up results that will be returned by Repository.GetList
+func (mmGetList *mCartRepositoryMockGetList) Return(m1 map[int64]uint16, err error) *CartRepositoryMock {
+	if mmGetList.mock.funcGetList != nil {
+		mmGetList.mock.t.Fatalf("CartRepositoryMock.GetList mock is already set by Set")
+	}
+
+	if mmGetList.defaultExpectation == nil {
+		mmGetList.defaultExpectation = &CartRepositoryMockGetListExpectation{mock: mmGetList.mock}
+	}
+	mmGetList.defaultExpectation.results = &CartRepositoryMockGetListResults{m1, err}
+	mmGetList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetList.mock
+}
+
+// Set uses given function f to mock the Repository.GetList method
+func (mmGetList *mCartRepositoryMockGetList) Set(f func(ctx context.Context, userID uint64, list string) (m1 map[int64]uint16, err error)) *CartRepositoryMock {
+	if mmGetList.defaultExpectation != nil {
+		mmGetList.mock.t.Fatalf("Default expectation is already set for the Repository.GetList method")
+	}
+
+	if len(mmGetList.expectations) > 0 {
+		mmGetList.mock.t.Fatalf("Some expectations are already set for the Repository.GetList method")
+	}
+
+	mmGetList.mock.funcGetList = f
+	mmGetList.mock.funcGetListOrigin = minimock.CallerInfo(1)
+	return mmGetList.mock
+}
+
+// When sets expectation for the Repository.GetList which will trigger the result defined by the following
+// Then helper
+func (mmGetList *mCartRepositoryMockGetList) When(ctx context.Context, userID uint64, list string) *CartRepositoryMockGetListExpectation {
+	if mmGetList.mock.funcGetList != nil {
+		mmGetList.mock.t.Fatalf("CartRepositoryMock.GetList mock is already set by Set")
+	}
+
+	expectation := &CartRepositoryMockGetListExpectation{
+		mock:               mmGetList.mock,
+		params:             &CartRepositoryMockGetListParams{ctx, userID, list},
+		expectationOrigins: CartRepositoryMockGetListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetList.expectations = append(mmGetList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetList return parameters for the expectation previously defined by the When method
+func (e *CartRepositoryMockGetListExpectation) Then(m1 map[int64]uint16, err error) *CartRepositoryMock {
+	e.results = &CartRepositoryMockGetListResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetList should be invoked
+func (mmGetList *mCartRepositoryMockGetList) Times(n uint64) *mCartRepositoryMockGetList {
+	if n == 0 {
+		mmGetList.mock.t.Fatalf("Times of CartRepositoryMock.GetList mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetList.expectedInvocations, n)
+	mmGetList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetList
+}
+
+func (mmGetList *mCartRepositoryMockGetList) invocationsDone() bool {
+	if len(mmGetList.expectations) == 0 && mmGetList.defaultExpectation == nil && mmGetList.mock.funcGetList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetList.mock.afterGetListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetList implements mm_cart.Repository
+func (mmGetList *CartRepositoryMock) GetList(ctx context.Context, userID uint64, list string) (m1 map[int64]uint16, err error) {
+	mm_atomic.AddUint64(&mmGetList.beforeGetListCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetList.afterGetListCounter, 1)
+
+	mmGetList.t.Helper()
+
+	if mmGetList.inspectFuncGetList != nil {
+		mmGetList.inspectFuncGetList(ctx, userID, list)
+	}
+
+	mm_params := CartRepositoryMockGetListParams{ctx, userID, list}
+
+	// Record call args
+	mmGetList.GetListMock.mutex.Lock()
+	mmGetList.GetListMock.callArgs = append(mmGetList.GetListMock.callArgs, &mm_params)
+	mmGetList.GetListMock.mutex.Unlock()
+
+	for _, e := range mmGetList.GetListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmGetList.GetListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetList.GetListMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetList.GetListMock.defaultExpectation.params
+		mm_want_ptrs := mmGetList.GetListMock.defaultExpectation.paramPtrs
+
+		mm_got := CartRepositoryMockGetListParams{ctx, userID, list}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetList.t.Errorf("CartRepositoryMock.GetList got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetList.GetListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetList.t.Errorf("CartRepositoryMock.GetList got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetList.GetListMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.list != nil && !minimock.Equal(*mm_want_ptrs.list, mm_got.list) {
+				mmGetList.t.Errorf("CartRepositoryMock.GetList got unexpected parameter list, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetList.GetListMock.defaultExpectation.expectationOrigins.originList, *mm_want_ptrs.list, mm_got.list, minimock.Diff(*mm_want_ptrs.list, mm_got.list))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetList.t.Errorf("CartRepositoryMock.GetList got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetList.GetListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetList.GetListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetList.t.Fatal("No results are set for the CartRepositoryMock.GetList")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmGetList.funcGetList != nil {
+		return mmGetList.funcGetList(ctx, userID, list)
+	}
+	mmGetList.t.Fatalf("Unexpected call to CartRepositoryMock.GetList. %v %v %v", ctx, userID, list)
+	return
+}
+
+// GetListAfterCounter returns a count of finished CartRepositoryMock.GetList invocations
+func (mmGetList *CartRepositoryMock) GetListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetList.afterGetListCounter)
+}
+
+// GetListBeforeCounter returns a count of CartRepositoryMock.GetList invocations
+func (mmGetList *CartRepositoryMock) GetListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetList.beforeGetListCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartRepositoryMock.GetList.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetList *mCartRepositoryMockGetList) Calls() []*CartRepositoryMockGetListParams {
+	mmGetList.mutex.RLock()
+
+	argCopy := make([]*CartRepositoryMockGetListParams, len(mmGetList.callArgs))
+	copy(argCopy, mmGetList.callArgs)
+
+	mmGetList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetListDone returns true if the count of the GetList invocations corresponds
+// the number of defined expectations
+func (m *CartRepositoryMock) MinimockGetListDone() bool {
+	if m.GetListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetListMock.invocationsDone()
+}
+
+// MinimockGetListInspect logs each unmet expectation
+func (m *CartRepositoryMock) MinimockGetListInspect() {
+	for _, e := range m.GetListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartRepositoryMock.GetList at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetListCounter := mm_atomic.LoadUint64(&m.afterGetListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetListMock.defaultExpectation != nil && afterGetListCounter < 1 {
+		if m.GetListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CartRepositoryMock.GetList at\n%s", m.GetListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CartRepositoryMock.GetList at\n%s with params: %#v", m.GetListMock.defaultExpectation.expectationOrigins.origin, *m.GetListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetList != nil && afterGetListCounter < 1 {
+		m.t.Errorf("Expected call to CartRepositoryMock.GetList at\n%s", m.funcGetListOrigin)
+	}
+
+	if !m.GetListMock.invocationsDone() && afterGetListCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartRepositoryMock.GetList at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetListMock.expectedInvocations), m.GetListMock.expectedInvocationsOrigin, afterGetListCounter)
+	}
+}
+
+type mCartRepositoryMockGetVersion struct {
+	optional           bool
+	mock               *CartRepositoryMock
+	defaultExpectation *CartRepositoryMockGetVersionExpectation
+	expectations       []*CartRepositoryMockGetVersionExpectation
+
+	callArgs []*CartRepositoryMockGetVersionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CartRepositoryMockGetVersionExpectation specifies expectation struct of the Repository.GetVersion
+type CartRepositoryMockGetVersionExpectation struct {
+	mock               *CartRepositoryMock
+	params             *CartRepositoryMockGetVersionParams
+	paramPtrs          *CartRepositoryMockGetVersionParamPtrs
+	expectationOrigins CartRepositoryMockGetVersionExpectationOrigins
+	results            *CartRepositoryMockGetVersionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CartRepositoryMockGetVersionParams contains parameters of the Repository.GetVersion
+type CartRepositoryMockGetVersionParams struct {
+	ctx    context.Context
+	userID uint64
+}
+
+// CartRepositoryMockGetVersionParamPtrs contains pointers to parameters of the Repository.GetVersion
+type CartRepositoryMockGetVersionParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+}
+
+// CartRepositoryMockGetVersionResults contains results of the Repository.GetVersion
+type CartRepositoryMockGetVersionResults struct {
+	u1  uint64
+	err error
+}
+
+// CartRepositoryMockGetVersionOrigins contains origins of expectations of the Repository.GetVersion
+type CartRepositoryMockGetVersionExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetVersion *mCartRepositoryMockGetVersion) Optional() *mCartRepositoryMockGetVersion {
+	mmGetVersion.optional = true
+	return mmGetVersion
+}
+
+// Expect sets up expected params for Repository.GetVersion
+func (mmGetVersion *mCartRepositoryMockGetVersion) Expect(ctx context.Context, userID uint64) *mCartRepositoryMockGetVersion {
+	if mmGetVersion.mock.funcGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("CartRepositoryMock.GetVersion mock is already set by Set")
+	}
+
+	if mmGetVersion.defaultExpectation == nil {
+		mmGetVersion.defaultExpectation = &CartRepositoryMockGetVersionExpectation{}
+	}
+
+	if mmGetVersion.defaultExpectation.paramPtrs != nil {
+		mmGetVersion.mock.t.Fatalf("CartRepositoryMock.GetVersion mock is already set by ExpectParams functions")
+	}
+
+	mmGetVersion.defaultExpectation.params = &CartRepositoryMockGetVersionParams{ctx, userID}
+	mmGetVersion.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetVersion.expectations {
+		if minimock.Equal(e.params, mmGetVersion.defaultExpectation.params) {
+			mmGetVersion.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetVersion.defaultExpectation.params)
+		}
+	}
+
+	return mmGetVersion
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetVersion
+func (mmGetVersion *mCartRepositoryMockGetVersion) ExpectCtxParam1(ctx context.Context) *mCartRepositoryMockGetVersion {
+	if mmGetVersion.mock.funcGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("CartRepositoryMock.GetVersion mock is already set by Set")
+	}
+
+	if mmGetVersion.defaultExpectation == nil {
+		mmGetVersion.defaultExpectation = &CartRepositoryMockGetVersionExpectation{}
+	}
+
+	if mmGetVersion.defaultExpectation.params != nil {
+		mmGetVersion.mock.t.Fatalf("CartRepositoryMock.GetVersion mock is already set by Expect")
+	}
+
+	if mmGetVersion.defaultExpectation.paramPtrs == nil {
+		mmGetVersion.defaultExpectation.paramPtrs = &CartRepositoryMockGetVersionParamPtrs{}
+	}
+	mmGetVersion.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetVersion.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetVersion
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.GetVersion
+func (mmGetVersion *mCartRepositoryMockGetVersion) ExpectUserIDParam2(userID uint64) *mCartRepositoryMockGetVersion {
+	if mmGetVersion.mock.funcGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("CartRepositoryMock.GetVersion mock is already set by Set")
+	}
+
+	if mmGetVersion.defaultExpectation == nil {
+		mmGetVersion.defaultExpectation = &CartRepositoryMockGetVersionExpectation{}
+	}
+
+	if mmGetVersion.defaultExpectation.params != nil {
+		mmGetVersion.mock.t.Fatalf("CartRepositoryMock.GetVersion mock is already set by Expect")
+	}
+
+	if mmGetVersion.defaultExpectation.paramPtrs == nil {
+		mmGetVersion.defaultExpectation.paramPtrs = &CartRepositoryMockGetVersionParamPtrs{}
+	}
+	mmGetVersion.defaultExpectation.paramPtrs.userID = &userID
+	mmGetVersion.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetVersion
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetVersion
+func (mmGetVersion *mCartRepositoryMockGetVersion) Inspect(f func(ctx context.Context, userID uint64)) *mCartRepositoryMockGetVersion {
+	if mmGetVersion.mock.inspectFuncGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("Inspect function is already set for CartRepositoryMock.GetVersion")
+	}
+
+	mmGetVersion.mock.inspectFuncGetVersion = f
+
+	return mmGetVersion
+}
+
+// Return sets up results that will be returned by Repository.GetVersion
+func (mmGetVersion *mCartRepositoryMockGetVersion) Return(u1 uint64, err error) *CartRepositoryMock {
+	if mmGetVersion.mock.funcGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("CartRepositoryMock.GetVersion mock is already set by Set")
+	}
+
+	if mmGetVersion.defaultExpectation == nil {
+		mmGetVersion.defaultExpectation = &CartRepositoryMockGetVersionExpectation{mock: mmGetVersion.mock}
+	}
+	mmGetVersion.defaultExpectation.results = &CartRepositoryMockGetVersionResults{u1, err}
+	mmGetVersion.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetVersion.mock
+}
+
+// Set uses given function f to mock the Repository.GetVersion method
+func (mmGetVersion *mCartRepositoryMockGetVersion) Set(f func(ctx context.Context, userID uint64) (u1 uint64, err error)) *CartRepositoryMock {
+	if mmGetVersion.defaultExpectation != nil {
+		mmGetVersion.mock.t.Fatalf("Default expectation is already set for the Repository.GetVersion method")
+	}
+
+	if len(mmGetVersion.expectations) > 0 {
+		mmGetVersion.mock.t.Fatalf("Some expectations are already set for the Repository.GetVersion method")
+	}
+
+	mmGetVersion.mock.funcGetVersion = f
+	mmGetVersion.mock.funcGetVersionOrigin = minimock.CallerInfo(1)
+	return mmGetVersion.mock
+}
+
+// When sets expectation for the Repository.GetVersion which will trigger the result defined by the following
+// Then helper
+func (mmGetVersion *mCartRepositoryMockGetVersion) When(ctx context.Context, userID uint64) *CartRepositoryMockGetVersionExpectation {
+	if mmGetVersion.mock.funcGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("CartRepositoryMock.GetVersion mock is already set by Set")
+	}
+
+	expectation := &CartRepositoryMockGetVersionExpectation{
+		mock:               mmGetVersion.mock,
+		params:             &CartRepositoryMockGetVersionParams{ctx, userID},
+		expectationOrigins: CartRepositoryMockGetVersionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetVersion.expectations = append(mmGetVersion.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetVersion return parameters for the expectation previously defined by the When method
+func (e *CartRepositoryMockGetVersionExpectation) Then(u1 uint64, err error) *CartRepositoryMock {
+	e.results = &CartRepositoryMockGetVersionResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetVersion should be invoked
+func (mmGetVersion *mCartRepositoryMockGetVersion) Times(n uint64) *mCartRepositoryMockGetVersion {
+	if n == 0 {
+		mmGetVersion.mock.t.Fatalf("Times of CartRepositoryMock.GetVersion mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetVersion.expectedInvocations, n)
+	mmGetVersion.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetVersion
+}
+
+func (mmGetVersion *mCartRepositoryMockGetVersion) invocationsDone() bool {
+	if len(mmGetVersion.expectations) == 0 && mmGetVersion.defaultExpectation == nil && mmGetVersion.mock.funcGetVersion == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetVersion.mock.afterGetVersionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetVersion.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetVersion implements mm_cart.Repository
+func (mmGetVersion *CartRepositoryMock) GetVersion(ctx context.Context, userID uint64) (u1 uint64, err error) {
+	mm_atomic.AddUint64(&mmGetVersion.beforeGetVersionCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetVersion.afterGetVersionCounter, 1)
+
+	mmGetVersion.t.Helper()
+
+	if mmGetVersion.inspectFuncGetVersion != nil {
+		mmGetVersion.inspectFuncGetVersion(ctx, userID)
+	}
+
+	mm_params := CartRepositoryMockGetVersionParams{ctx, userID}
+
+	// Record call args
+	mmGetVersion.GetVersionMock.mutex.Lock()
+	mmGetVersion.GetVersionMock.callArgs = append(mmGetVersion.GetVersionMock.callArgs, &mm_params)
+	mmGetVersion.GetVersionMock.mutex.Unlock()
+
+	for _, e := range mmGetVersion.GetVersionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmGetVersion.GetVersionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetVersion.GetVersionMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetVersion.GetVersionMock.defaultExpectation.params
+		mm_want_ptrs := mmGetVersion.GetVersionMock.defaultExpectation.paramPtrs
+
+		mm_got := CartRepositoryMockGetVersionParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetVersion.t.Errorf("CartRepositoryMock.GetVersion got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetVersion.GetVersionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetVersion.t.Errorf("CartRepositoryMock.GetVersion got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetVersion.GetVersionMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetVersion.t.Errorf("CartRepositoryMock.GetVersion got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetVersion.GetVersionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetVersion.GetVersionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetVersion.t.Fatal("No results are set for the CartRepositoryMock.GetVersion")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmGetVersion.funcGetVersion != nil {
+		return mmGetVersion.funcGetVersion(ctx, userID)
+	}
+	mmGetVersion.t.Fatalf("Unexpected call to CartRepositoryMock.GetVersion. %v %v", ctx, userID)
+	return
+}
+
+// GetVersionAfterCounter returns a count of finished CartRepositoryMock.GetVersion invocations
+func (mmGetVersion *CartRepositoryMock) GetVersionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetVersion.afterGetVersionCounter)
+}
+
+// GetVersionBeforeCounter returns a count of CartRepositoryMock.GetVersion invocations
+func (mmGetVersion *CartRepositoryMock) GetVersionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetVersion.beforeGetVersionCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartRepositoryMock.GetVersion.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetVersion *mCartRepositoryMockGetVersion) Calls() []*CartRepositoryMockGetVersionParams {
+	mmGetVersion.mutex.RLock()
+
+	argCopy := make([]*CartRepositoryMockGetVersionParams, len(mmGetVersion.callArgs))
+	copy(argCopy, mmGetVersion.callArgs)
+
+	mmGetVersion.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetVersionDone returns true if the count of the GetVersion invocations corresponds
+// the number of defined expectations
+func (m *CartRepositoryMock) MinimockGetVersionDone() bool {
+	if m.GetVersionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetVersionMock.invocationsDone()
+}
+
+// MinimockGetVersionInspect logs each unmet expectation
+func (m *CartRepositoryMock) MinimockGetVersionInspect() {
+	for _, e := range m.GetVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartRepositoryMock.GetVersion at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetVersionCounter := mm_atomic.LoadUint64(&m.afterGetVersionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetVersionMock.defaultExpectation != nil && afterGetVersionCounter < 1 {
+		if m.GetVersionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CartRepositoryMock.GetVersion at\n%s", m.GetVersionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CartRepositoryMock.GetVersion at\n%s with params: %#v", m.GetVersionMock.defaultExpectation.expectationOrigins.origin, *m.GetVersionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetVersion != nil && afterGetVersionCounter < 1 {
+		m.t.Errorf("Expected call to CartRepositoryMock.GetVersion at\n%s", m.funcGetVersionOrigin)
+	}
+
+	if !m.GetVersionMock.invocationsDone() && afterGetVersionCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartRepositoryMock.GetVersion at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetVersionMock.expectedInvocations), m.GetVersionMock.expectedInvocationsOrigin, afterGetVersionCounter)
+	}
+}
+
+type mCartRepositoryMockLists struct {
+	optional           bool
+	mock               *CartRepositoryMock
+	defaultExpectation *CartRepositoryMockListsExpectation
+	expectations       []*CartRepositoryMockListsExpectation
+
+	callArgs []*CartRepositoryMockListsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CartRepositoryMockListsExpectation specifies expectation struct of the Repository.Lists
+type CartRepositoryMockListsExpectation struct {
+	mock               *CartRepositoryMock
+	params             *CartRepositoryMockListsParams
+	paramPtrs          *CartRepositoryMockListsParamPtrs
+	expectationOrigins CartRepositoryMockListsExpectationOrigins
+	results            *CartRepositoryMockListsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CartRepositoryMockListsParams contains parameters of the Repository.Lists
+type CartRepositoryMockListsParams struct {
+	ctx    context.Context
+	userID uint64
+}
+
+// CartRepositoryMockListsParamPtrs contains pointers to parameters of the Repository.Lists
+type CartRepositoryMockListsParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+}
+
+// CartRepositoryMockListsResults contains results of the Repository.Lists
+type CartRepositoryMockListsResults struct {
+	la1 []domain.ListSummary
+	err error
+}
+
+// CartRepositoryMockListsOrigins contains origins of expectations of the Repository.Lists
+type CartRepositoryMockListsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLists *mCartRepositoryMockLists) Optional() *mCartRepositoryMockLists {
+	mmLists.optional = true
+	return mmLists
+}
+
+// Expect sets up expected params for Repository.Lists
+func (mmLists *mCartRepositoryMockLists) Expect(ctx context.Context, userID uint64) *mCartRepositoryMockLists {
+	if mmLists.mock.funcLists != nil {
+		mmLists.mock.t.Fatalf("CartRepositoryMock.Lists mock is already set by Set")
+	}
+
+	if mmLists.defaultExpectation == nil {
+		mmLists.defaultExpectation = &CartRepositoryMockListsExpectation{}
+	}
+
+	if mmLists.defaultExpectation.paramPtrs != nil {
+		mmLists.mock.t.Fatalf("CartRepositoryMock.Lists mock is already set by ExpectParams functions")
+	}
+
+	mmLists.defaultExpectation.params = &CartRepositoryMockListsParams{ctx, userID}
+	mmLists.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLists.expectations {
+		if minimock.Equal(e.params, mmLists.defaultExpectation.params) {
+			mmLists.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLists.defaultExpectation.params)
+		}
+	}
+
+	return mmLists
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.Lists
+func (mmLists *mCartRepositoryMockLists) ExpectCtxParam1(ctx context.Context) *mCartRepositoryMockLists {
+	if mmLists.mock.funcLists != nil {
+		mmLists.mock.t.Fatalf("CartRepositoryMock.Lists mock is already set by Set")
+	}
+
+	if mmLists.defaultExpectation == nil {
+		mmLists.defaultExpectation = &CartRepositoryMockListsExpectation{}
+	}
+
+	if mmLists.defaultExpectation.params != nil {
+		mmLists.mock.t.Fatalf("CartRepositoryMock.Lists mock is already set by Expect")
+	}
+
+	if mmLists.defaultExpectation.paramPtrs == nil {
+		mmLists.defaultExpectation.paramPtrs = &CartRepositoryMockListsParamPtrs{}
+	}
+	mmLists.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLists.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLists
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.Lists
+func (mmLists *mCartRepositoryMockLists) ExpectUserIDParam2(userID uint64) *mCartRepositoryMockLists {
+	if mmLists.mock.funcLists != nil {
+		mmLists.mock.t.Fatalf("CartRepositoryMock.Lists mock is already set by Set")
+	}
+
+	if mmLists.defaultExpectation == nil {
+		mmLists.defaultExpectation = &CartRepositoryMockListsExpectation{}
+	}
+
+	if mmLists.defaultExpectation.params != nil {
+		mmLists.mock.t.Fatalf("CartRepositoryMock.Lists mock is already set by Expect")
+	}
+
+	if mmLists.defaultExpectation.paramPtrs == nil {
+		mmLists.defaultExpectation.paramPtrs = &CartRepositoryMockListsParamPtrs{}
+	}
+	mmLists.defaultExpectation.paramPtrs.userID = &userID
+	mmLists.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmLists
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.Lists
+func (mmLists *mCartRepositoryMockLists) Inspect(f func(ctx context.Context, userID uint64)) *mCartRepositoryMockLists {
+	if mmLists.mock.inspectFuncLists != nil {
+		mmLists.mock.t.Fatalf("Inspect function is already set for CartRepositoryMock.Lists")
+	}
+
+	mmLists.mock.inspectFuncLists = f
+
+	return mmLists
+}
+
+// Return sets up results that will be returned by Repository.Lists
+func (mmLists *mCartRepositoryMockLists) Return(la1 []domain.ListSummary, err error) *CartRepositoryMock {
+	if mmLists.mock.funcLists != nil {
+		mmLists.mock.t.Fatalf("CartRepositoryMock.Lists mock is already set by Set")
+	}
+
+	if mmLists.defaultExpectation == nil {
+		mmLists.defaultExpectation = &CartRepositoryMockListsExpectation{mock: mmLists.mock}
+	}
+	mmLists.defaultExpectation.results = &CartRepositoryMockListsResults{la1, err}
+	mmLists.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLists.mock
+}
+
+// Set uses given function f to mock the Repository.Lists method
+func (mmLists *mCartRepositoryMockLists) Set(f func(ctx context.Context, userID uint64) (la1 []domain.ListSummary, err error)) *CartRepositoryMock {
+	if mmLists.defaultExpectation != nil {
+		mmLists.mock.t.Fatalf("Default expectation is already set for the Repository.Lists method")
+	}
+
+	if len(mmLists.expectations) > 0 {
+		mmLists.mock.t.Fatalf("Some expectations are already set for the Repository.Lists method")
+	}
+
+	mmLists.mock.funcLists = f
+	mmLists.mock.funcListsOrigin = minimock.CallerInfo(1)
+	return mmLists.mock
+}
+
+// When sets expectation for the Repository.Lists which will trigger the result defined by the following
+// Then helper
+func (mmLists *mCartRepositoryMockLists) When(ctx context.Context, userID uint64) *CartRepositoryMockListsExpectation {
+	if mmLists.mock.funcLists != nil {
+		mmLists.mock.t.Fatalf("CartRepositoryMock.Lists mock is already set by Set")
+	}
+
+	expectation := &CartRepositoryMockListsExpectation{
+		mock:               mmLists.mock,
+		params:             &CartRepositoryMockListsParams{ctx, userID},
+		expectationOrigins: CartRepositoryMockListsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLists.expectations = append(mmLists.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.Lists return parameters for the expectation previously defined by the When method
+func (e *CartRepositoryMockListsExpectation) Then(la1 []domain.ListSummary, err error) *CartRepositoryMock {
+	e.results = &CartRepositoryMockListsResults{la1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.Lists should be invoked
+func (mmLists *mCartRepositoryMockLists) Times(n uint64) *mCartRepositoryMockLists {
+	if n == 0 {
+		mmLists.mock.t.Fatalf("Times of CartRepositoryMock.Lists mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLists.expectedInvocations, n)
+	mmLists.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLists
+}
+
+func (mmLists *mCartRepositoryMockLists) invocationsDone() bool {
+	if len(mmLists.expectations) == 0 && mmLists.defaultExpectation == nil && mmLists.mock.funcLists == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLists.mock.afterListsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLists.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Lists implements mm_cart.Repository
+func (mmLists *CartRepositoryMock) Lists(ctx context.Context, userID uint64) (la1 []domain.ListSummary, err error) {
+	mm_atomic.AddUint64(&mmLists.beforeListsCounter, 1)
+	defer mm_atomic.AddUint64(&mmLists.afterListsCounter, 1)
+
+	mmLists.t.Helper()
+
+	if mmLists.inspectFuncLists != nil {
+		mmLists.inspectFuncLists(ctx, userID)
+	}
+
+	mm_params := CartRepositoryMockListsParams{ctx, userID}
+
+	// Record call args
+	mmLists.ListsMock.mutex.Lock()
+	mmLists.ListsMock.callArgs = append(mmLists.ListsMock.callArgs, &mm_params)
+	mmLists.ListsMock.mutex.Unlock()
+
+	for _, e := range mmLists.ListsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.la1, e.results.err
+		}
+	}
+
+	if mmLists.ListsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLists.ListsMock.defaultExpectation.Counter, 1)
+		mm_want := mmLists.ListsMock.defaultExpectation.params
+		mm_want_ptrs := mmLists.ListsMock.defaultExpectation.paramPtrs
+
+		mm_got := CartRepositoryMockListsParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLists.t.Errorf("CartRepositoryMock.Lists got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLists.ListsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmLists.t.Errorf("CartRepositoryMock.Lists got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLists.ListsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLists.t.Errorf("CartRepositoryMock.Lists got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLists.ListsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLists.ListsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLists.t.Fatal("No results are set for the CartRepositoryMock.Lists")
+		}
+		return (*mm_results).la1, (*mm_results).err
+	}
+	if mmLists.funcLists != nil {
+		return mmLists.funcLists(ctx, userID)
+	}
+	mmLists.t.Fatalf("Unexpected call to CartRepositoryMock.Lists. %v %v", ctx, userID)
+	return
+}
+
+// ListsAfterCounter returns a count of finished CartRepositoryMock.Lists invocations
+func (mmLists *CartRepositoryMock) ListsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLists.afterListsCounter)
+}
+
+// ListsBeforeCounter returns a count of CartRepositoryMock.Lists invocations
+func (mmLists *CartRepositoryMock) ListsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLists.beforeListsCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartRepositoryMock.Lists.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLists *mCartRepositoryMockLists) Calls() []*CartRepositoryMockListsParams {
+	mmLists.mutex.RLock()
+
+	argCopy := make([]*CartRepositoryMockListsParams, len(mmLists.callArgs))
+	copy(argCopy, mmLists.callArgs)
+
+	mmLists.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListsDone returns true if the count of the Lists invocations corresponds
+// the number of defined expectations
+func (m *CartRepositoryMock) MinimockListsDone() bool {
+	if m.ListsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListsMock.invocationsDone()
+}
+
+// MinimockListsInspect logs each unmet expectation
+func (m *CartRepositoryMock) MinimockListsInspect() {
+	for _, e := range m.ListsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartRepositoryMock.Lists at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListsCounter := mm_atomic.LoadUint64(&m.afterListsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListsMock.defaultExpectation != nil && afterListsCounter < 1 {
+		if m.ListsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CartRepositoryMock.Lists at\n%s", m.ListsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CartRepositoryMock.Lists at\n%s with params: %#v", m.ListsMock.defaultExpectation.expectationOrigins.origin, *m.ListsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLists != nil && afterListsCounter < 1 {
+		m.t.Errorf("Expected call to CartRepositoryMock.Lists at\n%s", m.funcListsOrigin)
+	}
+
+	if !m.ListsMock.invocationsDone() && afterListsCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartRepositoryMock.Lists at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListsMock.expectedInvocations), m.ListsMock.expectedInvocationsOrigin, afterListsCounter)
+	}
+}
+
+type mCartRepositoryMockMergeCart struct {
+	optional           bool
+	mock               *CartRepositoryMock
+	defaultExpectation *CartRepositoryMockMergeCartExpectation
+	expectations       []*CartRepositoryMockMergeCartExpectation
+
+	callArgs []*CartRepositoryMockMergeCartParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CartRepositoryMockMergeCartExpectation specifies expectation struct of the Repository.MergeCart
+type CartRepositoryMockMergeCartExpectation struct {
+	mock               *CartRepositoryMock
+	params             *CartRepositoryMockMergeCartParams
+	paramPtrs          *CartRepositoryMockMergeCartParamPtrs
+	expectationOrigins CartRepositoryMockMergeCartExpectationOrigins
+	results            *CartRepositoryMockMergeCartResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CartRepositoryMockMergeCartParams contains parameters of the Repository.MergeCart
+type CartRepositoryMockMergeCartParams struct {
+	ctx    context.Context
+	userID uint64
+	items  map[int64]uint16
+}
+
+// CartRepositoryMockMergeCartParamPtrs contains pointers to parameters of the Repository.MergeCart
+type CartRepositoryMockMergeCartParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+	items  *map[int64]uint16
+}
+
+// CartRepositoryMockMergeCartResults contains results of the Repository.MergeCart
+type CartRepositoryMockMergeCartResults struct {
+	err error
+}
+
+// CartRepositoryMockMergeCartOrigins contains origins of expectations of the Repository.MergeCart
+type CartRepositoryMockMergeCartExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originItems  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMergeCart *mCartRepositoryMockMergeCart) Optional() *mCartRepositoryMockMergeCart {
+	mmMergeCart.optional = true
+	return mmMergeCart
+}
+
+// Expect sets up expected params for Repository.MergeCart
+func (mmMergeCart *mCartRepositoryMockMergeCart) Expect(ctx context.Context, userID uint64, items map[int64]uint16) *mCartRepositoryMockMergeCart {
+	if mmMergeCart.mock.funcMergeCart != nil {
+		mmMergeCart.mock.t.Fatalf("CartRepositoryMock.MergeCart mock is already set by Set")
+	}
+
+	if mmMergeCart.defaultExpectation == nil {
+		mmMergeCart.defaultExpectation = &CartRepositoryMockMergeCartExpectation{}
+	}
+
+	if mmMergeCart.defaultExpectation.paramPtrs != nil {
+		mmMergeCart.mock.t.Fatalf("CartRepositoryMock.MergeCart mock is already set by ExpectParams functions")
+	}
+
+	mmMergeCart.defaultExpectation.params = &CartRepositoryMockMergeCartParams{ctx, userID, items}
+	mmMergeCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMergeCart.expectations {
+		if minimock.Equal(e.params, mmMergeCart.defaultExpectation.params) {
+			mmMergeCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMergeCart.defaultExpectation.params)
+		}
+	}
+
+	return mmMergeCart
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.MergeCart
+func (mmMergeCart *mCartRepositoryMockMergeCart) ExpectCtxParam1(ctx context.Context) *mCartRepositoryMockMergeCart {
+	if mmMergeCart.mock.funcMergeCart != nil {
+		mmMergeCart.mock.t.Fatalf("CartRepositoryMock.MergeCart mock is already set by Set")
+	}
+
+	if mmMergeCart.defaultExpectation == nil {
+		mmMergeCart.defaultExpectation = &CartRepositoryMockMergeCartExpectation{}
+	}
+
+	if mmMergeCart.defaultExpectation.params != nil {
+		mmMergeCart.mock.t.Fatalf("CartRepositoryMock.MergeCart mock is already set by Expect")
+	}
+
+	if mmMergeCart.defaultExpectation.paramPtrs == nil {
+		mmMergeCart.defaultExpectation.paramPtrs = &CartRepositoryMockMergeCartParamPtrs{}
+	}
+	mmMergeCart.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMergeCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMergeCart
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.MergeCart
+func (mmMergeCart *mCartRepositoryMockMergeCart) ExpectUserIDParam2(userID uint64) *mCartRepositoryMockMergeCart {
+	if mmMergeCart.mock.funcMergeCart != nil {
+		mmMergeCart.mock.t.Fatalf("CartRepositoryMock.MergeCart mock is already set by Set")
+	}
+
+	if mmMergeCart.defaultExpectation == nil {
+		mmMergeCart.defaultExpectation = &CartRepositoryMockMergeCartExpectation{}
+	}
+
+	if mmMergeCart.defaultExpectation.params != nil {
+		mmMergeCart.mock.t.Fatalf("CartRepositoryMock.MergeCart mock is already set by Expect")
+	}
+
+	if mmMergeCart.defaultExpectation.paramPtrs == nil {
+		mmMergeCart.defaultExpectation.paramPtrs = &CartRepositoryMockMergeCartParamPtrs{}
+	}
+	mmMergeCart.defaultExpectation.paramPtrs.userID = &userID
+	mmMergeCart.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmMergeCart
+}
+
+// ExpectItemsParam3 sets up expected param items for Repository.MergeCart
+func (mmMergeCart *mCartRepositoryMockMergeCart) ExpectItemsParam3(items map[int64]uint16) *mCartRepositoryMockMergeCart {
+	if mmMergeCart.mock.funcMergeCart != nil {
+		mmMergeCart.mock.t.Fatalf("CartRepositoryMock.MergeCart mock is already set by Set")
+	}
+
+	if mmMergeCart.defaultExpectation == nil {
+		mmMergeCart.defaultExpectation = &CartRepositoryMockMergeCartExpectation{}
+	}
+
+	if mmMergeCart.defaultExpectation.params != nil {
+		mmMergeCart.mock.t.Fatalf("CartRepositoryMock.MergeCart mock is already set by Expect")
+	}
+
+	if mmMergeCart.defaultExpectation.paramPtrs == nil {
+		mmMergeCart.defaultExpectation.paramPtrs = &CartRepositoryMockMergeCartParamPtrs{}
+	}
+	mmMergeCart.defaultExpectation.paramPtrs.items = &items
+	mmMergeCart.defaultExpectation.expectationOrigins.originItems = minimock.CallerInfo(1)
+
+	return mmMergeCart
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.MergeCart
+func (mmMergeCart *mCartRepositoryMockMergeCart) Inspect(f func(ctx context.Context, userID uint64, items map[int64]uint16)) *mCartRepositoryMockMergeCart {
+	if mmMergeCart.mock.inspectFuncMergeCart != nil {
+		mmMergeCart.mock.t.Fatalf("Inspect function is already set for CartRepositoryMock.MergeCart")
+	}
+
+	mmMergeCart.mock.inspectFuncMergeCart = f
+
+	return mmMergeCart
+}
+
+// Return sets up results that will be returned by Repository.MergeCart
+func (mmMergeCart *mCartRepositoryMockMergeCart) Return(err error) *CartRepositoryMock {
+	if mmMergeCart.mock.funcMergeCart != nil {
+		mmMergeCart.mock.t.Fatalf("CartRepositoryMock.MergeCart mock is already set by Set")
+	}
+
+	if mmMergeCart.defaultExpectation == nil {
+		mmMergeCart.defaultExpectation = &CartRepositoryMockMergeCartExpectation{mock: mmMergeCart.mock}
+	}
+	mmMergeCart.defaultExpectation.results = &CartRepositoryMockMergeCartResults{err}
+	mmMergeCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMergeCart.mock
+}
+
+// Set uses given function f to mock the Repository.MergeCart method
+func (mmMergeCart *mCartRepositoryMockMergeCart) Set(f func(ctx context.Context, userID uint64, items map[int64]uint16) (err error)) *CartRepositoryMock {
+	if mmMergeCart.defaultExpectation != nil {
+		mmMergeCart.mock.t.Fatalf("Default expectation is already set for the Repository.MergeCart method")
+	}
+
+	if len(mmMergeCart.expectations) > 0 {
+		mmMergeCart.mock.t.Fatalf("Some expectations are already set for the Repository.MergeCart method")
+	}
+
+	mmMergeCart.mock.funcMergeCart = f
+	mmMergeCart.mock.funcMergeCartOrigin = minimock.CallerInfo(1)
+	return mmMergeCart.mock
+}
+
+// When sets expectation for the Repository.MergeCart which will trigger the result defined by the following
+// Then helper
+func (mmMergeCart *mCartRepositoryMockMergeCart) When(ctx context.Context, userID uint64, items map[int64]uint16) *CartRepositoryMockMergeCartExpectation {
+	if mmMergeCart.mock.funcMergeCart != nil {
+		mmMergeCart.mock.t.Fatalf("CartRepositoryMock.MergeCart mock is already set by Set")
+	}
+
+	expectation := &CartRepositoryMockMergeCartExpectation{
+		mock:               mmMergeCart.mock,
+		params:             &CartRepositoryMockMergeCartParams{ctx, userID, items},
+		expectationOrigins: CartRepositoryMockMergeCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMergeCart.expectations = append(mmMergeCart.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.MergeCart return parameters for the expectation previously defined by the When method
+func (e *CartRepositoryMockMergeCartExpectation) Then(err error) *CartRepositoryMock {
+	e.results = &CartRepositoryMockMergeCartResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.MergeCart should be invoked
+func (mmMergeCart *mCartRepositoryMockMergeCart) Times(n uint64) *mCartRepositoryMockMergeCart {
+	if n == 0 {
+		mmMergeCart.mock.t.Fatalf("Times of CartRepositoryMock.MergeCart mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMergeCart.expectedInvocations, n)
+	mmMergeCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMergeCart
+}
+
+func (mmMergeCart *mCartRepositoryMockMergeCart) invocationsDone() bool {
+	if len(mmMergeCart.expectations) == 0 && mmMergeCart.defaultExpectation == nil && mmMergeCart.mock.funcMergeCart == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMergeCart.mock.afterMergeCartCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMergeCart.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MergeCart implements mm_cart.Repository
+func (mmMergeCart *CartRepositoryMock) MergeCart(ctx context.Context, userID uint64, items map[int64]uint16) (err error) {
+	mm_atomic.AddUint64(&mmMergeCart.beforeMergeCartCounter, 1)
+	defer mm_atomic.AddUint64(&mmMergeCart.afterMergeCartCounter, 1)
+
+	mmMergeCart.t.Helper()
+
+	if mmMergeCart.inspectFuncMergeCart != nil {
+		mmMergeCart.inspectFuncMergeCart(ctx, userID, items)
+	}
+
+	mm_params := CartRepositoryMockMergeCartParams{ctx, userID, items}
+
+	// Record call args
+	mmMergeCart.MergeCartMock.mutex.Lock()
+	mmMergeCart.MergeCartMock.callArgs = append(mmMergeCart.MergeCartMock.callArgs, &mm_params)
+	mmMergeCart.MergeCartMock.mutex.Unlock()
+
+	for _, e := range mmMergeCart.MergeCartMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMergeCart.MergeCartMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMergeCart.MergeCartMock.defaultExpectation.Counter, 1)
+		mm_want := mmMergeCart.MergeCartMock.defaultExpectation.params
+		mm_want_ptrs := mmMergeCart.MergeCartMock.defaultExpectation.paramPtrs
+
+		mm_got := CartRepositoryMockMergeCartParams{ctx, userID, items}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMergeCart.t.Errorf("CartRepositoryMock.MergeCart got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMergeCart.MergeCartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmMergeCart.t.Errorf("CartRepositoryMock.MergeCart got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMergeCart.MergeCartMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.items != nil && !minimock.Equal(*mm_want_ptrs.items, mm_got.items) {
+				mmMergeCart.t.Errorf("CartRepositoryMock.MergeCart got unexpected parameter items, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMergeCart.MergeCartMock.defaultExpectation.expectationOrigins.originItems, *mm_want_ptrs.items, mm_got.items, minimock.Diff(*mm_want_ptrs.items, mm_got.items))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMergeCart.t.Errorf("CartRepositoryMock.MergeCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMergeCart.MergeCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMergeCart.MergeCartMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMergeCart.t.Fatal("No results are set for the CartRepositoryMock.MergeCart")
+		}
+		return (*mm_results).err
+	}
+	if mmMergeCart.funcMergeCart != nil {
+		return mmMergeCart.funcMergeCart(ctx, userID, items)
+	}
+	mmMergeCart.t.Fatalf("Unexpected call to CartRepositoryMock.MergeCart. %v %v %v", ctx, userID, items)
+	return
+}
+
+// MergeCartAfterCounter returns a count of finished CartRepositoryMock.MergeCart invocations
+func (mmMergeCart *CartRepositoryMock) MergeCartAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMergeCart.afterMergeCartCounter)
+}
+
+// MergeCartBeforeCounter returns a count of CartRepositoryMock.MergeCart invocations
+func (mmMergeCart *CartRepositoryMock) MergeCartBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMergeCart.beforeMergeCartCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartRepositoryMock.MergeCart.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMergeCart *mCartRepositoryMockMergeCart) Calls() []*CartRepositoryMockMergeCartParams {
+	mmMergeCart.mutex.RLock()
+
+	argCopy := make([]*CartRepositoryMockMergeCartParams, len(mmMergeCart.callArgs))
+	copy(argCopy, mmMergeCart.callArgs)
+
+	mmMergeCart.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMergeCartDone returns true if the count of the MergeCart invocations corresponds
+// the number of defined expectations
+func (m *CartRepositoryMock) MinimockMergeCartDone() bool {
+	if m.MergeCartMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MergeCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MergeCartMock.invocationsDone()
+}
+
+// MinimockMergeCartInspect logs each unmet expectation
+func (m *CartRepositoryMock) MinimockMergeCartInspect() {
+	for _, e := range m.MergeCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartRepositoryMock.MergeCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMergeCartCounter := mm_atomic.LoadUint64(&m.afterMergeCartCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MergeCartMock.defaultExpectation != nil && afterMergeCartCounter < 1 {
+		if m.MergeCartMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CartRepositoryMock.MergeCart at\n%s", m.MergeCartMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CartRepositoryMock.MergeCart at\n%s with params: %#v", m.MergeCartMock.defaultExpectation.expectationOrigins.origin, *m.MergeCartMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMergeCart != nil && afterMergeCartCounter < 1 {
+		m.t.Errorf("Expected call to CartRepositoryMock.MergeCart at\n%s", m.funcMergeCartOrigin)
+	}
+
+	if !m.MergeCartMock.invocationsDone() && afterMergeCartCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartRepositoryMock.MergeCart at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MergeCartMock.expectedInvocations), m.MergeCartMock.expectedInvocationsOrigin, afterMergeCartCounter)
+	}
+}
+
+type mCartRepositoryMockMoveItem struct {
+	optional           bool
+	mock               *CartRepositoryMock
+	defaultExpectation *CartRepositoryMockMoveItemExpectation
+	expectations       []*CartRepositoryMockMoveItemExpectation
+
+	callArgs []*CartRepositoryMockMoveItemParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CartRepositoryMockMoveItemExpectation specifies expectation struct of the Repository.MoveItem
+type CartRepositoryMockMoveItemExpectation struct {
+	mock               *CartRepositoryMock
+	params             *CartRepositoryMockMoveItemParams
+	paramPtrs          *CartRepositoryMockMoveItemParamPtrs
+	expectationOrigins CartRepositoryMockMoveItemExpectationOrigins
+	results            *CartRepositoryMockMoveItemResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CartRepositoryMockMoveItemParams contains parameters of the Repository.MoveItem
+type CartRepositoryMockMoveItemParams struct {
+	ctx    context.Context
+	userID uint64
+	from   string
+	to     string
+	skuID  int64
+	count  uint16
+}
+
+// CartRepositoryMockMoveItemParamPtrs contains pointers to parameters of the Repository.MoveItem
+type CartRepositoryMockMoveItemParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+	from   *string
+	to     *string
+	skuID  *int64
+	count  *uint16
+}
+
+// CartRepositoryMockMoveItemResults contains results of the Repository.MoveItem
+type CartRepositoryMockMoveItemResults struct {
+	u1  uint16
+	err error
+}
+
+// CartRepositoryMockMoveItemOrigins contains origins of expectations of the Repository.MoveItem
+type CartRepositoryMockMoveItemExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originFrom   string
+	originTo     string
+	originSkuID  string
+	originCount  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMoveItem *mCartRepositoryMockMoveItem) Optional() *mCartRepositoryMockMoveItem {
+	mmMoveItem.optional = true
+	return mmMoveItem
+}
+
+// Expect sets up expected params for Repository.MoveItem
+func (mmMoveItem *mCartRepositoryMockMoveItem) Expect(ctx context.Context, userID uint64, from string, to string, skuID int64, count uint16) *mCartRepositoryMockMoveItem {
+	if mmMoveItem.mock.funcMoveItem != nil {
+		mmMoveItem.mock.t.Fatalf("CartRepositoryMock.MoveItem mock is already set by Set")
+	}
+
+	if mmMoveItem.defaultExpectation == nil {
+		mmMoveItem.defaultExpectation = &CartRepositoryMockMoveItemExpectation{}
+	}
+
+	if mmMoveItem.defaultExpectation.paramPtrs != nil {
+		mmMoveItem.mock.t.Fatalf("CartRepositoryMock.MoveItem mock is already set by ExpectParams functions")
+	}
+
+	mmMoveItem.defaultExpectation.params = &CartRepositoryMockMoveItemParams{ctx, userID, from, to, skuID, count}
+	mmMoveItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMoveItem.expectations {
+		if minimock.Equal(e.params, mmMoveItem.defaultExpectation.params) {
+			mmMoveItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMoveItem.defaultExpectation.params)
+		}
+	}
+
+	return mmMoveItem
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.MoveItem
+func (mmMoveItem *mCartRepositoryMockMoveItem) ExpectCtxParam1(ctx context.Context) *mCartRepositoryMockMoveItem {
+	if mmMoveItem.mock.funcMoveItem != nil {
+		mmMoveItem.mock.t.Fatalf("CartRepositoryMock.MoveItem mock is already set by Set")
+	}
+
+	if mmMoveItem.defaultExpectation == nil {
+		mmMoveItem.defaultExpectation = &CartRepositoryMockMoveItemExpectation{}
+	}
+
+	if mmMoveItem.defaultExpectation.params != nil {
+		mmMoveItem.mock.t.Fatalf("CartRepositoryMock.MoveItem mock is already set by Expect")
+	}
+
+	if mmMoveItem.defaultExpectation.paramPtrs == nil {
+		mmMoveItem.defaultExpectation.paramPtrs = &CartRepositoryMockMoveItemParamPtrs{}
+	}
+	mmMoveItem.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMoveItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMoveItem
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.MoveItem
+func (mmMoveItem *mCartRepositoryMockMoveItem) ExpectUserIDParam2(userID uint64) *mCartRepositoryMockMoveItem {
+	if mmMoveItem.mock.funcMoveItem != nil {
+		mmMoveItem.mock.t.Fatalf("CartRepositoryMock.MoveItem mock is already set by Set")
+	}
+
+	if mmMoveItem.defaultExpectation == nil {
+		mmMoveItem.defaultExpectation = &CartRepositoryMockMoveItemExpectation{}
+	}
+
+	if mmMoveItem.defaultExpectation.params != nil {
+		mmMoveItem.mock.t.Fatalf("CartRepositoryMock.MoveItem mock is already set by Expect")
+	}
+
+	if mmMoveItem.defaultExpectation.paramPtrs == nil {
+		mmMoveItem.defaultExpectation.paramPtrs = &CartRepositoryMockMoveItemParamPtrs{}
+	}
+	mmMoveItem.defaultExpectation.paramPtrs.userID = &userID
+	mmMoveItem.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmMoveItem
+}
+
+// ExpectFromParam3 sets up expected param from for Repository.MoveItem
+func (mmMoveItem *mCartRepositoryMockMoveItem) ExpectFromParam3(from string) *mCartRepositoryMockMoveItem {
+	if mmMoveItem.mock.funcMoveItem != nil {
+		mmMoveItem.mock.t.Fatalf("CartRepositoryMock.MoveItem mock is already set by Set")
+	}
+
+	if mmMoveItem.defaultExpectation == nil {
+		mmMoveItem.defaultExpectation = &CartRepositoryMockMoveItemExpectation{}
+	}
+
+	if mmMoveItem.defaultExpectation.params != nil {
+		mmMoveItem.mock.t.Fatalf("CartRepositoryMock.MoveItem mock is already set by Expect")
+	}
+
+	if mmMoveItem.defaultExpectation.paramPtrs == nil {
+		mmMoveItem.defaultExpectation.paramPtrs = &CartRepositoryMockMoveItemParamPtrs{}
+	}
+	mmMoveItem.defaultExpectation.paramPtrs.from = &from
+	mmMoveItem.defaultExpectation.expectationOrigins.originFrom = minimock.CallerInfo(1)
+
+	return mmMoveItem
+}
+
+// ExpectToParam4 sets up expected param to for Repository.MoveItem
+func (mmMoveItem *mCartRepositoryMockMoveItem) ExpectToParam4(to string) *mCartRepositoryMockMoveItem {
+	if mmMoveItem.mock.funcMoveItem != nil {
+		mmMoveItem.mock.t.Fatalf("CartRepositoryMock.MoveItem mock is already set by Set")
+	}
+
+	if mmMoveItem.defaultExpectation == nil {
+		mmMoveItem.defaultExpectation = &CartRepositoryMockMoveItemExpectation{}
+	}
+
+	if mmMoveItem.defaultExpectation.params != nil {
+		mmMoveItem.mock.t.Fatalf("CartRepositoryMock.MoveItem mock is already set by Expect")
+	}
+
+	if mmMoveItem.defaultExpectation.paramPtrs == nil {
+		mmMoveItem.defaultExpectation.paramPtrs = &CartRepositoryMockMoveItemParamPtrs{}
+	}
+	mmMoveItem.defaultExpectation.paramPtrs.to = &to
+	mmMoveItem.defaultExpectation.expectationOrigins.originTo = minimock.CallerInfo(1)
+
+	return mmMoveItem
+}
+
+// ExpectSkuIDParam5 sets up expected param skuID for Repository.MoveItem
+func (mmMoveItem *mCartRepositoryMockMoveItem) ExpectSkuIDParam5(skuID int64) *mCartRepositoryMockMoveItem {
+	if mmMoveItem.mock.funcMoveItem != nil {
+		mmMoveItem.mock.t.Fatalf("CartRepositoryMock.MoveItem mock is already set by Set")
+	}
+
+	if mmMoveItem.defaultExpectation == nil {
+		mmMoveItem.defaultExpectation = &CartRepositoryMockMoveItemExpectation{}
+	}
+
+	if mmMoveItem.defaultExpectation.params != nil {
+		mmMoveItem.mock.t.Fatalf("CartRepositoryMock.MoveItem mock is already set by Expect")
+	}
+
+	if mmMoveItem.defaultExpectation.paramPtrs == nil {
+		mmMoveItem.defaultExpectation.paramPtrs = &CartRepositoryMockMoveItemParamPtrs{}
+	}
+	mmMoveItem.defaultExpectation.paramPtrs.skuID = &skuID
+	mmMoveItem.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmMoveItem
+}
+
+// ExpectCountParam6 sets up expected param count for Repository.MoveItem
+func (mmMoveItem *mCartRepositoryMockMoveItem) ExpectCountParam6(count uint16) *mCartRepositoryMockMoveItem {
+	if mmMoveItem.mock.funcMoveItem != nil {
+		mmMoveItem.mock.t.Fatalf("CartRepositoryMock.MoveItem mock is already set by Set")
+	}
+
+	if mmMoveItem.defaultExpectation == nil {
+		mmMoveItem.defaultExpectation = &CartRepositoryMockMoveItemExpectation{}
+	}
+
+	if mmMoveItem.defaultExpectation.params != nil {
+		mmMoveItem.mock.t.Fatalf("CartRepositoryMock.MoveItem mock is already set by Expect")
+	}
+
+	if mmMoveItem.defaultExpectation.paramPtrs == nil {
+		mmMoveItem.defaultExpectation.paramPtrs = &CartRepositoryMockMoveItemParamPtrs{}
+	}
+	mmMoveItem.defaultExpectation.paramPtrs.count = &count
+	mmMoveItem.defaultExpectation.expectationOrigins.originCount = minimock.CallerInfo(1)
+
+	return mmMoveItem
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.MoveItem
+func (mmMoveItem *mCartRepositoryMockMoveItem) Inspect(f func(ctx context.Context, userID uint64, from string, to string, skuID int64, count uint16)) *mCartRepositoryMockMoveItem {
+	if mmMoveItem.mock.inspectFuncMoveItem != nil {
+		mmMoveItem.mock.t.Fatalf("Inspect function is already set for CartRepositoryMock.MoveItem")
+	}
+
+	mmMoveItem.mock.inspectFuncMoveItem = f
+
+	return mmMoveItem
+}
+
+// Return sets up results that will be returned by Repository.MoveItem
+func (mmMoveItem *mCartRepositoryMockMoveItem) Return(u1 uint16, err error) *CartRepositoryMock {
+	if mmMoveItem.mock.funcMoveItem != nil {
+		mmMoveItem.mock.t.Fatalf("CartRepositoryMock.MoveItem mock is already set by Set")
+	}
+
+	if mmMoveItem.defaultExpectation == nil {
+		mmMoveItem.defaultExpectation = &CartRepositoryMockMoveItemExpectation{mock: mmMoveItem.mock}
+	}
+	mmMoveItem.defaultExpectation.results = &CartRepositoryMockMoveItemResults{u1, err}
+	mmMoveItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMoveItem.mock
+}
+
+// Set uses given function f to mock the Repository.MoveItem method
+func (mmMoveItem *mCartRepositoryMockMoveItem) Set(f func(ctx context.Context, userID uint64, from string, to string, skuID int64, count uint16) (u1 uint16, err error)) *CartRepositoryMock {
+	if mmMoveItem.defaultExpectation != nil {
+		mmMoveItem.mock.t.Fatalf("Default expectation is already set for the Repository.MoveItem method")
+	}
+
+	if len(mmMoveItem.expectations) > 0 {
+		mmMoveItem.mock.t.Fatalf("Some expectations are already set for the Repository.MoveItem method")
+	}
+
+	mmMoveItem.mock.funcMoveItem = f
+	mmMoveItem.mock.funcMoveItemOrigin = minimock.CallerInfo(1)
+	return mmMoveItem.mock
+}
+
+// When sets expectation for the Repository.MoveItem which will trigger the result defined by the following
+// Then helper
+func (mmMoveItem *mCartRepositoryMockMoveItem) When(ctx context.Context, userID uint64, from string, to string, skuID int64, count uint16) *CartRepositoryMockMoveItemExpectation {
+	if mmMoveItem.mock.funcMoveItem != nil {
+		mmMoveItem.mock.t.Fatalf("CartRepositoryMock.MoveItem mock is already set by Set")
+	}
+
+	expectation := &CartRepositoryMockMoveItemExpectation{
+		mock:               mmMoveItem.mock,
+		params:             &CartRepositoryMockMoveItemParams{ctx, userID, from, to, skuID, count},
+		expectationOrigins: CartRepositoryMockMoveItemExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMoveItem.expectations = append(mmMoveItem.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.MoveItem return parameters for the expectation previously defined by the When method
+func (e *CartRepositoryMockMoveItemExpectation) Then(u1 uint16, err error) *CartRepositoryMock {
+	e.results = &CartRepositoryMockMoveItemResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.MoveItem should be invoked
+func (mmMoveItem *mCartRepositoryMockMoveItem) Times(n uint64) *mCartRepositoryMockMoveItem {
+	if n == 0 {
+		mmMoveItem.mock.t.Fatalf("Times of CartRepositoryMock.MoveItem mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMoveItem.expectedInvocations, n)
+	mmMoveItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMoveItem
+}
+
+func (mmMoveItem *mCartRepositoryMockMoveItem) invocationsDone() bool {
+	if len(mmMoveItem.expectations) == 0 && mmMoveItem.defaultExpectation == nil && mmMoveItem.mock.funcMoveItem == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMoveItem.mock.afterMoveItemCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMoveItem.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MoveItem implements mm_cart.Repository
+func (mmMoveItem *CartRepositoryMock) MoveItem(ctx context.Context, userID uint64, from string, to string, skuID int64, count uint16) (u1 uint16, err error) {
+	mm_atomic.AddUint64(&mmMoveItem.beforeMoveItemCounter, 1)
+	defer mm_atomic.AddUint64(&mmMoveItem.afterMoveItemCounter, 1)
+
+	mmMoveItem.t.Helper()
+
+	if mmMoveItem.inspectFuncMoveItem != nil {
+		mmMoveItem.inspectFuncMoveItem(ctx, userID, from, to, skuID, count)
+	}
+
+	mm_params := CartRepositoryMockMoveItemParams{ctx, userID, from, to, skuID, count}
+
+	// Record call args
+	mmMoveItem.MoveItemMock.mutex.Lock()
+	mmMoveItem.MoveItemMock.callArgs = append(mmMoveItem.MoveItemMock.callArgs, &mm_params)
+	mmMoveItem.MoveItemMock.mutex.Unlock()
+
+	for _, e := range mmMoveItem.MoveItemMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmMoveItem.MoveItemMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMoveItem.MoveItemMock.defaultExpectation.Counter, 1)
+		mm_want := mmMoveItem.MoveItemMock.defaultExpectation.params
+		mm_want_ptrs := mmMoveItem.MoveItemMock.defaultExpectation.paramPtrs
+
+		mm_got := CartRepositoryMockMoveItemParams{ctx, userID, from, to, skuID, count}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMoveItem.t.Errorf("CartRepositoryMock.MoveItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveItem.MoveItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmMoveItem.t.Errorf("CartRepositoryMock.MoveItem got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveItem.MoveItemMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.from != nil && !minimock.Equal(*mm_want_ptrs.from, mm_got.from) {
+				mmMoveItem.t.Errorf("CartRepositoryMock.MoveItem got unexpected parameter from, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveItem.MoveItemMock.defaultExpectation.expectationOrigins.originFrom, *mm_want_ptrs.from, mm_got.from, minimock.Diff(*mm_want_ptrs.from, mm_got.from))
+			}
+
+			if mm_want_ptrs.to != nil && !minimock.Equal(*mm_want_ptrs.to, mm_got.to) {
+				mmMoveItem.t.Errorf("CartRepositoryMock.MoveItem got unexpected parameter to, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveItem.MoveItemMock.defaultExpectation.expectationOrigins.originTo, *mm_want_ptrs.to, mm_got.to, minimock.Diff(*mm_want_ptrs.to, mm_got.to))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmMoveItem.t.Errorf("CartRepositoryMock.MoveItem got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveItem.MoveItemMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+			if mm_want_ptrs.count != nil && !minimock.Equal(*mm_want_ptrs.count, mm_got.count) {
+				mmMoveItem.t.Errorf("CartRepositoryMock.MoveItem got unexpected parameter count, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveItem.MoveItemMock.defaultExpectation.expectationOrigins.originCount, *mm_want_ptrs.count, mm_got.count, minimock.Diff(*mm_want_ptrs.count, mm_got.count))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMoveItem.t.Errorf("CartRepositoryMock.MoveItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMoveItem.MoveItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMoveItem.MoveItemMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMoveItem.t.Fatal("No results are set for the CartRepositoryMock.MoveItem")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmMoveItem.funcMoveItem != nil {
+		return mmMoveItem.funcMoveItem(ctx, userID, from, to, skuID, count)
+	}
+	mmMoveItem.t.Fatalf("Unexpected call to CartRepositoryMock.MoveItem. %v %v %v %v %v %v", ctx, userID, from, to, skuID, count)
+	return
+}
+
+// MoveItemAfterCounter returns a count of finished CartRepositoryMock.MoveItem invocations
+func (mmMoveItem *CartRepositoryMock) MoveItemAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMoveItem.afterMoveItemCounter)
+}
+
+// MoveItemBeforeCounter returns a count of CartRepositoryMock.MoveItem invocations
+func (mmMoveItem *CartRepositoryMock) MoveItemBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMoveItem.beforeMoveItemCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartRepositoryMock.MoveItem.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMoveItem *mCartRepositoryMockMoveItem) Calls() []*CartRepositoryMockMoveItemParams {
+	mmMoveItem.mutex.RLock()
+
+	argCopy := make([]*CartRepositoryMockMoveItemParams, len(mmMoveItem.callArgs))
+	copy(argCopy, mmMoveItem.callArgs)
+
+	mmMoveItem.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMoveItemDone returns true if the count of the MoveItem invocations corresponds
+// the number of defined expectations
+func (m *CartRepositoryMock) MinimockMoveItemDone() bool {
+	if m.MoveItemMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MoveItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MoveItemMock.invocationsDone()
+}
+
+// MinimockMoveItemInspect logs each unmet expectation
+func (m *CartRepositoryMock) MinimockMoveItemInspect() {
+	for _, e := range m.MoveItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartRepositoryMock.MoveItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMoveItemCounter := mm_atomic.LoadUint64(&m.afterMoveItemCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MoveItemMock.defaultExpectation != nil && afterMoveItemCounter < 1 {
+		if m.MoveItemMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CartRepositoryMock.MoveItem at\n%s", m.MoveItemMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CartRepositoryMock.MoveItem at\n%s with params: %#v", m.MoveItemMock.defaultExpectation.expectationOrigins.origin, *m.MoveItemMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMoveItem != nil && afterMoveItemCounter < 1 {
+		m.t.Errorf("Expected call to CartRepositoryMock.MoveItem at\n%s", m.funcMoveItemOrigin)
+	}
+
+	if !m.MoveItemMock.invocationsDone() && afterMoveItemCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartRepositoryMock.MoveItem at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MoveItemMock.expectedInvocations), m.MoveItemMock.expectedInvocationsOrigin, afterMoveItemCounter)
+	}
+}
+
+type mCartRepositoryMockRemoveCoupon struct {
+	optional           bool
+	mock               *CartRepositoryMock
+	defaultExpectation *CartRepositoryMockRemoveCouponExpectation
+	expectations       []*CartRepositoryMockRemoveCouponExpectation
+
+	callArgs []*CartRepositoryMockRemoveCouponParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CartRepositoryMockRemoveCouponExpectation specifies expectation struct of the Repository.RemoveCoupon
+type CartRepositoryMockRemoveCouponExpectation struct {
+	mock               *CartRepositoryMock
+	params             *CartRepositoryMockRemoveCouponParams
+	paramPtrs          *CartRepositoryMockRemoveCouponParamPtrs
+	expectationOrigins CartRepositoryMockRemoveCouponExpectationOrigins
+	results            *CartRepositoryMockRemoveCouponResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CartRepositoryMockRemoveCouponParams contains parameters of the Repository.RemoveCoupon
+type CartRepositoryMockRemoveCouponParams struct {
+	ctx    context.Context
+	userID uint64
+}
+
+// CartRepositoryMockRemoveCouponParamPtrs contains pointers to parameters of the Repository.RemoveCoupon
+type CartRepositoryMockRemoveCouponParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+}
+
+// CartRepositoryMockRemoveCouponResults contains results of the Repository.RemoveCoupon
+type CartRepositoryMockRemoveCouponResults struct {
+	err error
+}
+
+// CartRepositoryMockRemoveCouponOrigins contains origins of expectations of the Repository.RemoveCoupon
+type CartRepositoryMockRemoveCouponExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveCoupon *mCartRepositoryMockRemoveCoupon) Optional() *mCartRepositoryMockRemoveCoupon {
+	mmRemoveCoupon.optional = true
+	return mmRemoveCoupon
+}
+
+// Expect sets up expected params for Repository.RemoveCoupon
+func (mmRemoveCoupon *mCartRepositoryMockRemoveCoupon) Expect(ctx context.Context, userID uint64) *mCartRepositoryMockRemoveCoupon {
+	if mmRemoveCoupon.mock.funcRemoveCoupon != nil {
+		mmRemoveCoupon.mock.t.Fatalf("CartRepositoryMock.RemoveCoupon mock is already set by Set")
+	}
+
+	if mmRemoveCoupon.defaultExpectation == nil {
+		mmRemoveCoupon.defaultExpectation = &CartRepositoryMockRemoveCouponExpectation{}
+	}
+
+	if mmRemoveCoupon.defaultExpectation.paramPtrs != nil {
+		mmRemoveCoupon.mock.t.Fatalf("CartRepositoryMock.RemoveCoupon mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveCoupon.defaultExpectation.params = &CartRepositoryMockRemoveCouponParams{ctx, userID}
+	mmRemoveCoupon.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemoveCoupon.expectations {
+		if minimock.Equal(e.params, mmRemoveCoupon.defaultExpectation.params) {
+			mmRemoveCoupon.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveCoupon.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveCoupon
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.RemoveCoupon
+func (mmRemoveCoupon *mCartRepositoryMockRemoveCoupon) ExpectCtxParam1(ctx context.Context) *mCartRepositoryMockRemoveCoupon {
+	if mmRemoveCoupon.mock.funcRemoveCoupon != nil {
+		mmRemoveCoupon.mock.t.Fatalf("CartRepositoryMock.RemoveCoupon mock is already set by Set")
+	}
+
+	if mmRemoveCoupon.defaultExpectation == nil {
+		mmRemoveCoupon.defaultExpectation = &CartRepositoryMockRemoveCouponExpectation{}
+	}
+
+	if mmRemoveCoupon.defaultExpectation.params != nil {
+		mmRemoveCoupon.mock.t.Fatalf("CartRepositoryMock.RemoveCoupon mock is already set by Expect")
+	}
+
+	if mmRemoveCoupon.defaultExpectation.paramPtrs == nil {
+		mmRemoveCoupon.defaultExpectation.paramPtrs = &CartRepositoryMockRemoveCouponParamPtrs{}
+	}
+	mmRemoveCoupon.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveCoupon.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveCoupon
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.RemoveCoupon
+func (mmRemoveCoupon *mCartRepositoryMockRemoveCoupon) ExpectUserIDParam2(userID uint64) *mCartRepositoryMockRemoveCoupon {
+	if mmRemoveCoupon.mock.funcRemoveCoupon != nil {
+		mmRemoveCoupon.mock.t.Fatalf("CartRepositoryMock.RemoveCoupon mock is already set by Set")
+	}
+
+	if mmRemoveCoupon.defaultExpectation == nil {
+		mmRemoveCoupon.defaultExpectation = &CartRepositoryMockRemoveCouponExpectation{}
+	}
+
+	if mmRemoveCoupon.defaultExpectation.params != nil {
+		mmRemoveCoupon.mock.t.Fatalf("CartRepositoryMock.RemoveCoupon mock is already set by Expect")
+	}
+
+	if mmRemoveCoupon.defaultExpectation.paramPtrs == nil {
+		mmRemoveCoupon.defaultExpectation.paramPtrs = &CartRepositoryMockRemoveCouponParamPtrs{}
+	}
+	mmRemoveCoupon.defaultExpectation.paramPtrs.userID = &userID
+	mmRemoveCoupon.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRemoveCoupon
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.RemoveCoupon
+func (mmRemoveCoupon *mCartRepositoryMockRemoveCoupon) Inspect(f func(ctx context.Context, userID uint64)) *mCartRepositoryMockRemoveCoupon {
+	if mmRemoveCoupon.mock.inspectFuncRemoveCoupon != nil {
+		mmRemoveCoupon.mock.t.Fatalf("Inspect function is already set for CartRepositoryMock.RemoveCoupon")
+	}
+
+	mmRemoveCoupon.mock.inspectFuncRemoveCoupon = f
+
+	return mmRemoveCoupon
+}
+
+// Return sets up results that will be returned by Repository.RemoveCoupon
+func (mmRemoveCoupon *mCartRepositoryMockRemoveCoupon) Return(err error) *CartRepositoryMock {
+	if mmRemoveCoupon.mock.funcRemoveCoupon != nil {
+		mmRemoveCoupon.mock.t.Fatalf("CartRepositoryMock.RemoveCoupon mock is already set by Set")
+	}
+
+	if mmRemoveCoupon.defaultExpectation == nil {
+		mmRemoveCoupon.defaultExpectation = &CartRepositoryMockRemoveCouponExpectation{mock: mmRemoveCoupon.mock}
+	}
+	mmRemoveCoupon.defaultExpectation.results = &CartRepositoryMockRemoveCouponResults{err}
+	mmRemoveCoupon.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemoveCoupon.mock
+}
+
+// Set uses given function f to mock the Repository.RemoveCoupon method
+func (mmRemoveCoupon *mCartRepositoryMockRemoveCoupon) Set(f func(ctx context.Context, userID uint64) (err error)) *CartRepositoryMock {
+	if mmRemoveCoupon.defaultExpectation != nil {
+		mmRemoveCoupon.mock.t.Fatalf("Default expectation is already set for the Repository.RemoveCoupon method")
+	}
+
+	if len(mmRemoveCoupon.expectations) > 0 {
+		mmRemoveCoupon.mock.t.Fatalf("Some expectations are already set for the Repository.RemoveCoupon method")
+	}
+
+	mmRemoveCoupon.mock.funcRemoveCoupon = f
+	mmRemoveCoupon.mock.funcRemoveCouponOrigin = minimock.CallerInfo(1)
+	return mmRemoveCoupon.mock
+}
+
+// When sets expectation for the Repository.RemoveCoupon which will trigger the result defined by the following
+// Then helper
+func (mmRemoveCoupon *mCartRepositoryMockRemoveCoupon) When(ctx context.Context, userID uint64) *CartRepositoryMockRemoveCouponExpectation {
+	if mmRemoveCoupon.mock.funcRemoveCoupon != nil {
+		mmRemoveCoupon.mock.t.Fatalf("CartRepositoryMock.RemoveCoupon mock is already set by Set")
+	}
+
+	expectation := &CartRepositoryMockRemoveCouponExpectation{
+		mock:               mmRemoveCoupon.mock,
+		params:             &CartRepositoryMockRemoveCouponParams{ctx, userID},
+		expectationOrigins: CartRepositoryMockRemoveCouponExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemoveCoupon.expectations = append(mmRemoveCoupon.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.RemoveCoupon return parameters for the expectation previously defined by the When method
+func (e *CartRepositoryMockRemoveCouponExpectation) Then(err error) *CartRepositoryMock {
+	e.results = &CartRepositoryMockRemoveCouponResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.RemoveCoupon should be invoked
+func (mmRemoveCoupon *mCartRepositoryMockRemoveCoupon) Times(n uint64) *mCartRepositoryMockRemoveCoupon {
+	if n == 0 {
+		mmRemoveCoupon.mock.t.Fatalf("Times of CartRepositoryMock.RemoveCoupon mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveCoupon.expectedInvocations, n)
+	mmRemoveCoupon.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemoveCoupon
+}
+
+func (mmRemoveCoupon *mCartRepositoryMockRemoveCoupon) invocationsDone() bool {
+	if len(mmRemoveCoupon.expectations) == 0 && mmRemoveCoupon.defaultExpectation == nil && mmRemoveCoupon.mock.funcRemoveCoupon == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveCoupon.mock.afterRemoveCouponCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveCoupon.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveCoupon implements mm_cart.Repository
+func (mmRemoveCoupon *CartRepositoryMock) RemoveCoupon(ctx context.Context, userID uint64) (err error) {
+	mm_atomic.AddUint64(&mmRemoveCoupon.beforeRemoveCouponCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveCoupon.afterRemoveCouponCounter, 1)
+
+	mmRemoveCoupon.t.Helper()
+
+	if mmRemoveCoupon.inspectFuncRemoveCoupon != nil {
+		mmRemoveCoupon.inspectFuncRemoveCoupon(ctx, userID)
+	}
+
+	mm_params := CartRepositoryMockRemoveCouponParams{ctx, userID}
+
+	// Record call args
+	mmRemoveCoupon.RemoveCouponMock.mutex.Lock()
+	mmRemoveCoupon.RemoveCouponMock.callArgs = append(mmRemoveCoupon.RemoveCouponMock.callArgs, &mm_params)
+	mmRemoveCoupon.RemoveCouponMock.mutex.Unlock()
+
+	for _, e := range mmRemoveCoupon.RemoveCouponMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemoveCoupon.RemoveCouponMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveCoupon.RemoveCouponMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveCoupon.RemoveCouponMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveCoupon.RemoveCouponMock.defaultExpectation.paramPtrs
+
+		mm_got := CartRepositoryMockRemoveCouponParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveCoupon.t.Errorf("CartRepositoryMock.RemoveCoupon got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveCoupon.RemoveCouponMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRemoveCoupon.t.Errorf("CartRepositoryMock.RemoveCoupon got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveCoupon.RemoveCouponMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveCoupon.t.Errorf("CartRepositoryMock.RemoveCoupon got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemoveCoupon.RemoveCouponMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveCoupon.RemoveCouponMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveCoupon.t.Fatal("No results are set for the CartRepositoryMock.RemoveCoupon")
+		}
+		return (*mm_results).err
+	}
+	if mmRemoveCoupon.funcRemoveCoupon != nil {
+		return mmRemoveCoupon.funcRemoveCoupon(ctx, userID)
+	}
+	mmRemoveCoupon.t.Fatalf("Unexpected call to CartRepositoryMock.RemoveCoupon. %v %v", ctx, userID)
+	return
+}
+
+// RemoveCouponAfterCounter returns a count of finished CartRepositoryMock.RemoveCoupon invocations
+func (mmRemoveCoupon *CartRepositoryMock) RemoveCouponAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveCoupon.afterRemoveCouponCounter)
+}
+
+// RemoveCouponBeforeCounter returns a count of CartRepositoryMock.RemoveCoupon invocations
+func (mmRemoveCoupon *CartRepositoryMock) RemoveCouponBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveCoupon.beforeRemoveCouponCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartRepositoryMock.RemoveCoupon.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveCoupon *mCartRepositoryMockRemoveCoupon) Calls() []*CartRepositoryMockRemoveCouponParams {
+	mmRemoveCoupon.mutex.RLock()
+
+	argCopy := make([]*CartRepositoryMockRemoveCouponParams, len(mmRemoveCoupon.callArgs))
+	copy(argCopy, mmRemoveCoupon.callArgs)
+
+	mmRemoveCoupon.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveCouponDone returns true if the count of the RemoveCoupon invocations corresponds
+// the number of defined expectations
+func (m *CartRepositoryMock) MinimockRemoveCouponDone() bool {
+	if m.RemoveCouponMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveCouponMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveCouponMock.invocationsDone()
+}
+
+// MinimockRemoveCouponInspect logs each unmet expectation
+func (m *CartRepositoryMock) MinimockRemoveCouponInspect() {
+	for _, e := range m.RemoveCouponMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartRepositoryMock.RemoveCoupon at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemoveCouponCounter := mm_atomic.LoadUint64(&m.afterRemoveCouponCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveCouponMock.defaultExpectation != nil && afterRemoveCouponCounter < 1 {
+		if m.RemoveCouponMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CartRepositoryMock.RemoveCoupon at\n%s", m.RemoveCouponMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CartRepositoryMock.RemoveCoupon at\n%s with params: %#v", m.RemoveCouponMock.defaultExpectation.expectationOrigins.origin, *m.RemoveCouponMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveCoupon != nil && afterRemoveCouponCounter < 1 {
+		m.t.Errorf("Expected call to CartRepositoryMock.RemoveCoupon at\n%s", m.funcRemoveCouponOrigin)
+	}
+
+	if !m.RemoveCouponMock.invocationsDone() && afterRemoveCouponCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartRepositoryMock.RemoveCoupon at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveCouponMock.expectedInvocations), m.RemoveCouponMock.expectedInvocationsOrigin, afterRemoveCouponCounter)
+	}
+}
+
+type mCartRepositoryMockRemoveFromCart struct {
+	optional           bool
+	mock               *CartRepositoryMock
+	defaultExpectation *CartRepositoryMockRemoveFromCartExpectation
+	expectations       []*CartRepositoryMockRemoveFromCartExpectation
+
+	callArgs []*CartRepositoryMockRemoveFromCartParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CartRepositoryMockRemoveFromCartExpectation specifies expectation struct of the Repository.RemoveFromCart
+type CartRepositoryMockRemoveFromCartExpectation struct {
+	mock               *CartRepositoryMock
+	params             *CartRepositoryMockRemoveFromCartParams
+	paramPtrs          *CartRepositoryMockRemoveFromCartParamPtrs
+	expectationOrigins CartRepositoryMockRemoveFromCartExpectationOrigins
+	results            *CartRepositoryMockRemoveFromCartResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CartRepositoryMockRemoveFromCartParams contains parameters of the Repository.RemoveFromCart
+type CartRepositoryMockRemoveFromCartParams struct {
+	ctx    context.Context
+	skuID  int64
+	userID uint64
+}
+
+// CartRepositoryMockRemoveFromCartParamPtrs contains pointers to parameters of the Repository.RemoveFromCart
+type CartRepositoryMockRemoveFromCartParamPtrs struct {
+	ctx    *context.Context
+	skuID  *int64
+	userID *uint64
+}
+
+// CartRepositoryMockRemoveFromCartResults contains results of the Repository.RemoveFromCart
+type CartRepositoryMockRemoveFromCartResults struct {
+	err error
+}
+
+// CartRepositoryMockRemoveFromCartOrigins contains origins of expectations of the Repository.RemoveFromCart
+type CartRepositoryMockRemoveFromCartExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originSkuID  string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveFromCart *mCartRepositoryMockRemoveFromCart) Optional() *mCartRepositoryMockRemoveFromCart {
+	mmRemoveFromCart.optional = true
+	return mmRemoveFromCart
+}
+
+// Expect sets up expected params for Repository.RemoveFromCart
+func (mmRemoveFromCart *mCartRepositoryMockRemoveFromCart) Expect(ctx context.Context, skuID int64, userID uint64) *mCartRepositoryMockRemoveFromCart {
+	if mmRemoveFromCart.mock.funcRemoveFromCart != nil {
+		mmRemoveFromCart.mock.t.Fatalf("CartRepositoryMock.RemoveFromCart mock is already set by Set")
+	}
+
+	if mmRemoveFromCart.defaultExpectation == nil {
+		mmRemoveFromCart.defaultExpectation = &CartRepositoryMockRemoveFromCartExpectation{}
+	}
+
+	if mmRemoveFromCart.defaultExpectation.paramPtrs != nil {
+		mmRemoveFromCart.mock.t.Fatalf("CartRepositoryMock.RemoveFromCart mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveFromCart.defaultExpectation.params = &CartRepositoryMockRemoveFromCartParams{ctx, skuID, userID}
+	mmRemoveFromCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemoveFromCart.expectations {
+		if minimock.Equal(e.params, mmRemoveFromCart.defaultExpectation.params) {
+			mmRemoveFromCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveFromCart.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveFromCart
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.RemoveFromCart
+func (mmRemoveFromCart *mCartRepositoryMockRemoveFromCart) ExpectCtxParam1(ctx context.Context) *mCartRepositoryMockRemoveFromCart {
+	if mmRemoveFromCart.mock.funcRemoveFromCart != nil {
+		mmRemoveFromCart.mock.t.Fatalf("CartRepositoryMock.RemoveFromCart mock is already set by Set")
+	}
+
+	if mmRemoveFromCart.defaultExpectation == nil {
+		mmRemoveFromCart.defaultExpectation = &CartRepositoryMockRemoveFromCartExpectation{}
+	}
+
+	if mmRemoveFromCart.defaultExpectation.params != nil {
+		mmRemoveFromCart.mock.t.Fatalf("CartRepositoryMock.RemoveFromCart mock is already set by Expect")
+	}
+
+	if mmRemoveFromCart.defaultExpectation.paramPtrs == nil {
+		mmRemoveFromCart.defaultExpectation.paramPtrs = &CartRepositoryMockRemoveFromCartParamPtrs{}
+	}
+	mmRemoveFromCart.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveFromCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveFromCart
+}
+
+// ExpectSkuIDParam2 sets up expected param skuID for Repository.RemoveFromCart
+func (mmRemoveFromCart *mCartRepositoryMockRemoveFromCart) ExpectSkuIDParam2(skuID int64) *mCartRepositoryMockRemoveFromCart {
+	if mmRemoveFromCart.mock.funcRemoveFromCart != nil {
+		mmRemoveFromCart.mock.t.Fatalf("CartRepositoryMock.RemoveFromCart mock is already set by Set")
+	}
+
+	if mmRemoveFromCart.defaultExpectation == nil {
+		mmRemoveFromCart.defaultExpectation = &CartRepositoryMockRemoveFromCartExpectation{}
+	}
+
+	if mmRemoveFromCart.defaultExpectation.params != nil {
+		mmRemoveFromCart.mock.t.Fatalf("CartRepositoryMock.RemoveFromCart mock is already set by Expect")
+	}
+
+	if mmRemoveFromCart.defaultExpectation.paramPtrs == nil {
+		mmRemoveFromCart.defaultExpectation.paramPtrs = &CartRepositoryMockRemoveFromCartParamPtrs{}
+	}
+	mmRemoveFromCart.defaultExpectation.paramPtrs.skuID = &skuID
+	mmRemoveFromCart.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmRemoveFromCart
+}
+
+// ExpectUserIDParam3 sets up expected param userID for Repository.RemoveFromCart
+func (mmRemoveFromCart *mCartRepositoryMockRemoveFromCart) ExpectUserIDParam3(userID uint64) *mCartRepositoryMockRemoveFromCart {
+	if mmRemoveFromCart.mock.funcRemoveFromCart != nil {
+		mmRemoveFromCart.mock.t.Fatalf("CartRepositoryMock.RemoveFromCart mock is already set by Set")
+	}
+
+	if mmRemoveFromCart.defaultExpectation == nil {
+		mmRemoveFromCart.defaultExpectation = &CartRepositoryMockRemoveFromCartExpectation{}
+	}
+
+	if mmRemoveFromCart.defaultExpectation.params != nil {
+		mmRemoveFromCart.mock.t.Fatalf("CartRepositoryMock.RemoveFromCart mock is already set by Expect")
+	}
+
+	if mmRemoveFromCart.defaultExpectation.paramPtrs == nil {
+		mmRemoveFromCart.defaultExpectation.paramPtrs = &CartRepositoryMockRemoveFromCartParamPtrs{}
+	}
+	mmRemoveFromCart.defaultExpectation.paramPtrs.userID = &userID
+	mmRemoveFromCart.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRemoveFromCart
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.RemoveFromCart
+func (mmRemoveFromCart *mCartRepositoryMockRemoveFromCart) Inspect(f func(ctx context.Context, skuID int64, userID uint64)) *mCartRepositoryMockRemoveFromCart {
+	if mmRemoveFromCart.mock.inspectFuncRemoveFromCart != nil {
+		mmRemoveFromCart.mock.t.Fatalf("Inspect function is already set for CartRepositoryMock.RemoveFromCart")
+	}
+
+	mmRemoveFromCart.mock.inspectFuncRemoveFromCart = f
+
+	return mmRemoveFromCart
+}
+
+// Return sets up results that will be returned by Repository.RemoveFromCart
+func (mmRemoveFromCart *mCartRepositoryMockRemoveFromCart) Return(err error) *CartRepositoryMock {
+	if mmRemoveFromCart.mock.funcRemoveFromCart != nil {
+		mmRemoveFromCart.mock.t.Fatalf("CartRepositoryMock.RemoveFromCart mock is already set by Set")
+	}
+
+	if mmRemoveFromCart.defaultExpectation == nil {
+		mmRemoveFromCart.defaultExpectation = &CartRepositoryMockRemoveFromCartExpectation{mock: mmRemoveFromCart.mock}
+	}
+	mmRemoveFromCart.defaultExpectation.results = &CartRepositoryMockRemoveFromCartResults{err}
+	mmRemoveFromCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemoveFromCart.mock
+}
+
+// Set uses given function f to mock the Repository.RemoveFromCart method
+func (mmRemoveFromCart *mCartRepositoryMockRemoveFromCart) Set(f func(ctx context.Context, skuID int64, userID uint64) (err error)) *CartRepositoryMock {
+	if mmRemoveFromCart.defaultExpectation != nil {
+		mmRemoveFromCart.mock.t.Fatalf("Default expectation is already set for the Repository.RemoveFromCart method")
+	}
+
+	if len(mmRemoveFromCart.expectations) > 0 {
+		mmRemoveFromCart.mock.t.Fatalf("Some expectations are already set for the Repository.RemoveFromCart method")
+	}
+
+	mmRemoveFromCart.mock.funcRemoveFromCart = f
+	mmRemoveFromCart.mock.funcRemoveFromCartOrigin = minimock.CallerInfo(1)
+	return mmRemoveFromCart.mock
+}
+
+// When sets expectation for the Repository.RemoveFromCart which will trigger the result defined by the following
+// Then helper
+func (mmRemoveFromCart *mCartRepositoryMockRemoveFromCart) When(ctx context.Context, skuID int64, userID uint64) *CartRepositoryMockRemoveFromCartExpectation {
+	if mmRemoveFromCart.mock.funcRemoveFromCart != nil {
+		mmRemoveFromCart.mock.t.Fatalf("CartRepositoryMock.RemoveFromCart mock is already set by Set")
+	}
+
+	expectation := &CartRepositoryMockRemoveFromCartExpectation{
+		mock:               mmRemoveFromCart.mock,
+		params:             &CartRepositoryMockRemoveFromCartParams{ctx, skuID, userID},
+		expectationOrigins: CartRepositoryMockRemoveFromCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemoveFromCart.expectations = append(mmRemoveFromCart.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.RemoveFromCart return parameters for the expectation previously defined by the When method
+func (e *CartRepositoryMockRemoveFromCartExpectation) Then(err error) *CartRepositoryMock {
+	e.results = &CartRepositoryMockRemoveFromCartResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.RemoveFromCart should be invoked
+func (mmRemoveFromCart *mCartRepositoryMockRemoveFromCart) Times(n uint64) *mCartRepositoryMockRemoveFromCart {
+	if n == 0 {
+		mmRemoveFromCart.mock.t.Fatalf("Times of CartRepositoryMock.RemoveFromCart mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveFromCart.expectedInvocations, n)
+	mmRemoveFromCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemoveFromCart
+}
+
+func (mmRemoveFromCart *mCartRepositoryMockRemoveFromCart) invocationsDone() bool {
+	if len(mmRemoveFromCart.expectations) == 0 && mmRemoveFromCart.defaultExpectation == nil && mmRemoveFromCart.mock.funcRemoveFromCart == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveFromCart.mock.afterRemoveFromCartCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveFromCart.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveFromCart implements mm_cart.Repository
+func (mmRemoveFromCart *CartRepositoryMock) RemoveFromCart(ctx context.Context, skuID int64, userID uint64) (err error) {
+	mm_atomic.AddUint64(&mmRemoveFromCart.beforeRemoveFromCartCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveFromCart.afterRemoveFromCartCounter, 1)
+
+	mmRemoveFromCart.t.Helper()
+
+	if mmRemoveFromCart.inspectFuncRemoveFromCart != nil {
+		mmRemoveFromCart.inspectFuncRemoveFromCart(ctx, skuID, userID)
+	}
+
+	mm_params := CartRepositoryMockRemoveFromCartParams{ctx, skuID, userID}
+
+	// Record call args
+	mmRemoveFromCart.RemoveFromCartMock.mutex.Lock()
+	mmRemoveFromCart.RemoveFromCartMock.callArgs = append(mmRemoveFromCart.RemoveFromCartMock.callArgs, &mm_params)
+	mmRemoveFromCart.RemoveFromCartMock.mutex.Unlock()
+
+	for _, e := range mmRemoveFromCart.RemoveFromCartMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemoveFromCart.RemoveFromCartMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveFromCart.RemoveFromCartMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveFromCart.RemoveFromCartMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveFromCart.RemoveFromCartMock.defaultExpectation.paramPtrs
+
+		mm_got := CartRepositoryMockRemoveFromCartParams{ctx, skuID, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveFromCart.t.Errorf("CartRepositoryMock.RemoveFromCart got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveFromCart.RemoveFromCartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmRemoveFromCart.t.Errorf("CartRepositoryMock.RemoveFromCart got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveFromCart.RemoveFromCartMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRemoveFromCart.t.Errorf("CartRepositoryMock.RemoveFromCart got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveFromCart.RemoveFromCartMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveFromCart.t.Errorf("CartRepositoryMock.RemoveFromCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemoveFromCart.RemoveFromCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveFromCart.RemoveFromCartMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveFromCart.t.Fatal("No results are set for the CartRepositoryMock.RemoveFromCart")
+		}
+		return (*mm_results).err
+	}
+	if mmRemoveFromCart.funcRemoveFromCart != nil {
+		return mmRemoveFromCart.funcRemoveFromCart(ctx, skuID, userID)
+	}
+	mmRemoveFromCart.t.Fatalf("Unexpected call to CartRepositoryMock.RemoveFromCart. %v %v %v", ctx, skuID, userID)
+	return
+}
+
+// RemoveFromCartAfterCounter returns a count of finished CartRepositoryMock.RemoveFromCart invocations
+func (mmRemoveFromCart *CartRepositoryMock) RemoveFromCartAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveFromCart.afterRemoveFromCartCounter)
+}
+
+// RemoveFromCartBeforeCounter returns a count of CartRepositoryMock.RemoveFromCart invocations
+func (mmRemoveFromCart *CartRepositoryMock) RemoveFromCartBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveFromCart.beforeRemoveFromCartCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartRepositoryMock.RemoveFromCart.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveFromCart *mCartRepositoryMockRemoveFromCart) Calls() []*CartRepositoryMockRemoveFromCartParams {
+	mmRemoveFromCart.mutex.RLock()
+
+	argCopy := make([]*CartRepositoryMockRemoveFromCartParams, len(mmRemoveFromCart.callArgs))
+	copy(argCopy, mmRemoveFromCart.callArgs)
+
+	mmRemoveFromCart.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveFromCartDone returns true if the count of the RemoveFromCart invocations corresponds
+// the number of defined expectations
+func (m *CartRepositoryMock) MinimockRemoveFromCartDone() bool {
+	if m.RemoveFromCartMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
 	}
 
-	if mmGetCart.defaultExpectation.paramPtrs != nil {
-		mmGetCart.mock.t.Fatalf("CartRepositoryMock.GetCart mock is already set by ExpectParams functions")
+	for _, e := range m.RemoveFromCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
 	}
 
-	mmGetCart.defaultExpectation.params = &CartRepositoryMockGetCartParams{ctx, userID}
-	mmGetCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetCart.expectations {
-		if minimock.Equal(e.params, mmGetCart.defaultExpectation.params) {
-			mmGetCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCart.defaultExpectation.params)
+	return m.RemoveFromCartMock.invocationsDone()
+}
+
+// MinimockRemoveFromCartInspect logs each unmet expectation
+func (m *CartRepositoryMock) MinimockRemoveFromCartInspect() {
+	for _, e := range m.RemoveFromCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartRepositoryMock.RemoveFromCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	return mmGetCart
+	afterRemoveFromCartCounter := mm_atomic.LoadUint64(&m.afterRemoveFromCartCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveFromCartMock.defaultExpectation != nil && afterRemoveFromCartCounter < 1 {
+		if m.RemoveFromCartMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CartRepositoryMock.RemoveFromCart at\n%s", m.RemoveFromCartMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CartRepositoryMock.RemoveFromCart at\n%s with params: %#v", m.RemoveFromCartMock.defaultExpectation.expectationOrigins.origin, *m.RemoveFromCartMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveFromCart != nil && afterRemoveFromCartCounter < 1 {
+		m.t.Errorf("Expected call to CartRepositoryMock.RemoveFromCart at\n%s", m.funcRemoveFromCartOrigin)
+	}
+
+	if !m.RemoveFromCartMock.invocationsDone() && afterRemoveFromCartCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartRepositoryMock.RemoveFromCart at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveFromCartMock.expectedInvocations), m.RemoveFromCartMock.expectedInvocationsOrigin, afterRemoveFromCartCounter)
+	}
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Repository.GetCart
-func (mmGetCart *mCartRepositoryMockGetCart) ExpectCtxParam1(ctx context.Context) *mCartRepositoryMockGetCart {
-	if mmGetCart.mock.funcGetCart != nil {
-		mmGetCart.mock.t.Fatalf("CartRepositoryMock.GetCart mock is already set by Set")
+type mCartRepositoryMockRemoveFromList struct {
+	optional           bool
+	mock               *CartRepositoryMock
+	defaultExpectation *CartRepositoryMockRemoveFromListExpectation
+	expectations       []*CartRepositoryMockRemoveFromListExpectation
+
+	callArgs []*CartRepositoryMockRemoveFromListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CartRepositoryMockRemoveFromListExpectation specifies expectation struct of the Repository.RemoveFromList
+type CartRepositoryMockRemoveFromListExpectation struct {
+	mock               *CartRepositoryMock
+	params             *CartRepositoryMockRemoveFromListParams
+	paramPtrs          *CartRepositoryMockRemoveFromListParamPtrs
+	expectationOrigins CartRepositoryMockRemoveFromListExpectationOrigins
+	results            *CartRepositoryMockRemoveFromListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CartRepositoryMockRemoveFromListParams contains parameters of the Repository.RemoveFromList
+type CartRepositoryMockRemoveFromListParams struct {
+	ctx    context.Context
+	userID uint64
+	list   string
+	skuID  int64
+}
+
+// CartRepositoryMockRemoveFromListParamPtrs contains pointers to parameters of the Repository.RemoveFromList
+type CartRepositoryMockRemoveFromListParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+	list   *string
+	skuID  *int64
+}
+
+// CartRepositoryMockRemoveFromListResults contains results of the Repository.RemoveFromList
+type CartRepositoryMockRemoveFromListResults struct {
+	err error
+}
+
+// CartRepositoryMockRemoveFromListOrigins contains origins of expectations of the Repository.RemoveFromList
+type CartRepositoryMockRemoveFromListExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originList   string
+	originSkuID  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveFromList *mCartRepositoryMockRemoveFromList) Optional() *mCartRepositoryMockRemoveFromList {
+	mmRemoveFromList.optional = true
+	return mmRemoveFromList
+}
+
+// Expect sets up expected params for Repository.RemoveFromList
+func (mmRemoveFromList *mCartRepositoryMockRemoveFromList) Expect(ctx context.Context, userID uint64, list string, skuID int64) *mCartRepositoryMockRemoveFromList {
+	if mmRemoveFromList.mock.funcRemoveFromList != nil {
+		mmRemoveFromList.mock.t.Fatalf("CartRepositoryMock.RemoveFromList mock is already set by Set")
 	}
 
-	if mmGetCart.defaultExpectation == nil {
-		mmGetCart.defaultExpectation = &CartRepositoryMockGetCartExpectation{}
+	if mmRemoveFromList.defaultExpectation == nil {
+		mmRemoveFromList.defaultExpectation = &CartRepositoryMockRemoveFromListExpectation{}
 	}
 
-	if mmGetCart.defaultExpectation.params != nil {
-		mmGetCart.mock.t.Fatalf("CartRepositoryMock.GetCart mock is already set by Expect")
+	if mmRemoveFromList.defaultExpectation.paramPtrs != nil {
+		mmRemoveFromList.mock.t.Fatalf("CartRepositoryMock.RemoveFromList mock is already set by ExpectParams functions")
 	}
 
-	if mmGetCart.defaultExpectation.paramPtrs == nil {
-		mmGetCart.defaultExpectation.paramPtrs = &CartRepositoryMockGetCartParamPtrs{}
+	mmRemoveFromList.defaultExpectation.params = &CartRepositoryMockRemoveFromListParams{ctx, userID, list, skuID}
+	mmRemoveFromList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemoveFromList.expectations {
+		if minimock.Equal(e.params, mmRemoveFromList.defaultExpectation.params) {
+			mmRemoveFromList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveFromList.defaultExpectation.params)
+		}
 	}
-	mmGetCart.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetCart
+	return mmRemoveFromList
 }
 
-// ExpectUserIDParam2 sets up expected param userID for Repository.GetCart
-func (mmGetCart *mCartRepositoryMockGetCart) ExpectUserIDParam2(userID uint64) *mCartRepositoryMockGetCart {
-	if mmGetCart.mock.funcGetCart != nil {
-		mmGetCart.mock.t.Fatalf("CartRepositoryMock.GetCart mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.RemoveFromList
+func (mmRemoveFromList *mCartRepositoryMockRemoveFromList) ExpectCtxParam1(ctx context.Context) *mCartRepositoryMockRemoveFromList {
+	if mmRemoveFromList.mock.funcRemoveFromList != nil {
+		mmRemoveFromList.mock.t.Fatalf("CartRepositoryMock.RemoveFromList mock is already set by Set")
 	}
 
-	if mmGetCart.defaultExpectation == nil {
-		mmGetCart.defaultExpectation = &CartRepositoryMockGetCartExpectation{}
+	if mmRemoveFromList.defaultExpectation == nil {
+		mmRemoveFromList.defaultExpectation = &CartRepositoryMockRemoveFromListExpectation{}
 	}
 
-	if mmGetCart.defaultExpectation.params != nil {
-		mmGetCart.mock.t.Fatalf("CartRepositoryMock.GetCart mock is already set by Expect")
+	if mmRemoveFromList.defaultExpectation.params != nil {
+		mmRemoveFromList.mock.t.Fatalf("CartRepositoryMock.RemoveFromList mock is already set by Expect")
 	}
 
-	if mmGetCart.defaultExpectation.paramPtrs == nil {
-		mmGetCart.defaultExpectation.paramPtrs = &CartRepositoryMockGetCartParamPtrs{}
+	if mmRemoveFromList.defaultExpectation.paramPtrs == nil {
+		mmRemoveFromList.defaultExpectation.paramPtrs = &CartRepositoryMockRemoveFromListParamPtrs{}
 	}
-	mmGetCart.defaultExpectation.paramPtrs.userID = &userID
-	mmGetCart.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmRemoveFromList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveFromList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetCart
+	return mmRemoveFromList
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.GetCart
-func (mmGetCart *mCartRepositoryMockGetCart) Inspect(f func(ctx context.Context, userID uint64)) *mCartRepositoryMockGetCart {
-	if mmGetCart.mock.inspectFuncGetCart != nil {
-		mmGetCart.mock.t.Fatalf("Inspect function is already set for CartRepositoryMock.GetCart")
+// ExpectUserIDParam2 sets up expected param userID for Repository.RemoveFromList
+func (mmRemoveFromList *mCartRepositoryMockRemoveFromList) ExpectUserIDParam2(userID uint64) *mCartRepositoryMockRemoveFromList {
+	if mmRemoveFromList.mock.funcRemoveFromList != nil {
+		mmRemoveFromList.mock.t.Fatalf("CartRepositoryMock.RemoveFromList mock is already set by Set")
 	}
 
-	mmGetCart.mock.inspectFuncGetCart = f
+	if mmRemoveFromList.defaultExpectation == nil {
+		mmRemoveFromList.defaultExpectation = &CartRepositoryMockRemoveFromListExpectation{}
+	}
 
-	return mmGetCart
+	if mmRemoveFromList.defaultExpectation.params != nil {
+		mmRemoveFromList.mock.t.Fatalf("CartRepositoryMock.RemoveFromList mock is already set by Expect")
+	}
+
+	if mmRemoveFromList.defaultExpectation.paramPtrs == nil {
+		mmRemoveFromList.defaultExpectation.paramPtrs = &CartRepositoryMockRemoveFromListParamPtrs{}
+	}
+	mmRemoveFromList.defaultExpectation.paramPtrs.userID = &userID
+	mmRemoveFromList.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRemoveFromList
 }
 
-// Return sets up results that will be returned by Repository.GetCart
-func (mmGetCart *mCartRepositoryMockGetCart) Return(m1 map[int64]uint16, err error) *CartRepositoryMock {
-	if mmGetCart.mock.funcGetCart != nil {
-		mmGetCart.mock.t.Fatalf("CartRepositoryMock.GetCart mock is already set by Set")
+// ExpectListParam3 sets up expected param list for Repository.RemoveFromList
+func (mmRemoveFromList *mCartRepositoryMockRemoveFromList) ExpectListParam3(list string) *mCartRepositoryMockRemoveFromList {
+	if mmRemoveFromList.mock.funcRemoveFromList != nil {
+		mmRemoveFromList.mock.t.Fatalf("CartRepositoryMock.RemoveFromList mock is already set by Set")
 	}
 
-	if mmGetCart.defaultExpectation == nil {
-		mmGetCart.defaultExpectation = &CartRepositoryMockGetCartExpectation{mock: mmGetCart.mock}
+	if mmRemoveFromList.defaultExpectation == nil {
+		mmRemoveFromList.defaultExpectation = &CartRepositoryMockRemoveFromListExpectation{}
 	}
-	mmGetCart.defaultExpectation.results = &CartRepositoryMockGetCartResults{m1, err}
-	mmGetCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetCart.mock
+
+	if mmRemoveFromList.defaultExpectation.params != nil {
+		mmRemoveFromList.mock.t.Fatalf("CartRepositoryMock.RemoveFromList mock is already set by Expect")
+	}
+
+	if mmRemoveFromList.defaultExpectation.paramPtrs == nil {
+		mmRemoveFromList.defaultExpectation.paramPtrs = &CartRepositoryMockRemoveFromListParamPtrs{}
+	}
+	mmRemoveFromList.defaultExpectation.paramPtrs.list = &list
+	mmRemoveFromList.defaultExpectation.expectationOrigins.originList = minimock.CallerInfo(1)
+
+	return mmRemoveFromList
 }
 
-// Set uses given function f to mock the Repository.GetCart method
-func (mmGetCart *mCartRepositoryMockGetCart) Set(f func(ctx context.Context, userID uint64) (m1 map[int64]uint16, err error)) *CartRepositoryMock {
-	if mmGetCart.defaultExpectation != nil {
-		mmGetCart.mock.t.Fatalf("Default expectation is already set for the Repository.GetCart method")
+// ExpectSkuIDParam4 sets up expected param skuID for Repository.RemoveFromList
+func (mmRemoveFromList *mCartRepositoryMockRemoveFromList) ExpectSkuIDParam4(skuID int64) *mCartRepositoryMockRemoveFromList {
+	if mmRemoveFromList.mock.funcRemoveFromList != nil {
+		mmRemoveFromList.mock.t.Fatalf("CartRepositoryMock.RemoveFromList mock is already set by Set")
 	}
 
-	if len(mmGetCart.expectations) > 0 {
-		mmGetCart.mock.t.Fatalf("Some expectations are already set for the Repository.GetCart method")
+	if mmRemoveFromList.defaultExpectation == nil {
+		mmRemoveFromList.defaultExpectation = &CartRepositoryMockRemoveFromListExpectation{}
 	}
 
-	mmGetCart.mock.funcGetCart = f
-	mmGetCart.mock.funcGetCartOrigin = minimock.CallerInfo(1)
-	return mmGetCart.mock
+	if mmRemoveFromList.defaultExpectation.params != nil {
+		mmRemoveFromList.mock.t.Fatalf("CartRepositoryMock.RemoveFromList mock is already set by Expect")
+	}
+
+	if mmRemoveFromList.defaultExpectation.paramPtrs == nil {
+		mmRemoveFromList.defaultExpectation.paramPtrs = &CartRepositoryMockRemoveFromListParamPtrs{}
+	}
+	mmRemoveFromList.defaultExpectation.paramPtrs.skuID = &skuID
+	mmRemoveFromList.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmRemoveFromList
 }
 
-// When sets expectation for the Repository.GetCart which will trigger the result defined by the following
+// Inspect accepts an inspector function that has same arguments as the Repository.RemoveFromList
+func (mmRemoveFromList *mCartRepositoryMockRemoveFromList) Inspect(f func(ctx context.Context, userID uint64, list string, skuID int64)) *mCartRepositoryMockRemoveFromList {
+	if mmRemoveFromList.mock.inspectFuncRemoveFromList != nil {
+		mmRemoveFromList.mock.t.Fatalf("Inspect function is already set for CartRepositoryMock.RemoveFromList")
+	}
+
+	mmRemoveFromList.mock.inspectFuncRemoveFromList = f
+
+	return mmRemoveFromList
+}
+
+// Return sets up results that will be returned by Repository.RemoveFromList
+func (mmRemoveFromList *mCartRepositoryMockRemoveFromList) Return(err error) *CartRepositoryMock {
+	if mmRemoveFromList.mock.funcRemoveFromList != nil {
+		mmRemoveFromList.mock.t.Fatalf("CartRepositoryMock.RemoveFromList mock is already set by Set")
+	}
+
+	if mmRemoveFromList.defaultExpectation == nil {
+		mmRemoveFromList.defaultExpectation = &CartRepositoryMockRemoveFromListExpectation{mock: mmRemoveFromList.mock}
+	}
+	mmRemoveFromList.defaultExpectation.results = &CartRepositoryMockRemoveFromListResults{err}
+	mmRemoveFromList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemoveFromList.mock
+}
+
+// Set uses given function f to mock the Repository.RemoveFromList method
+func (mmRemoveFromList *mCartRepositoryMockRemoveFromList) Set(f func(ctx context.Context, userID uint64, list string, skuID int64) (err error)) *CartRepositoryMock {
+	if mmRemoveFromList.defaultExpectation != nil {
+		mmRemoveFromList.mock.t.Fatalf("Default expectation is already set for the Repository.RemoveFromList method")
+	}
+
+	if len(mmRemoveFromList.expectations) > 0 {
+		mmRemoveFromList.mock.t.Fatalf("Some expectations are already set for the Repository.RemoveFromList method")
+	}
+
+	mmRemoveFromList.mock.funcRemoveFromList = f
+	mmRemoveFromList.mock.funcRemoveFromListOrigin = minimock.CallerInfo(1)
+	return mmRemoveFromList.mock
+}
+
+// When sets expectation for the Repository.RemoveFromList which will trigger the result defined by the following
 // Then helper
-func (mmGetCart *mCartRepositoryMockGetCart) When(ctx context.Context, userID uint64) *CartRepositoryMockGetCartExpectation {
-	if mmGetCart.mock.funcGetCart != nil {
-		mmGetCart.mock.t.Fatalf("CartRepositoryMock.GetCart mock is already set by Set")
+func (mmRemoveFromList *mCartRepositoryMockRemoveFromList) When(ctx context.Context, userID uint64, list string, skuID int64) *CartRepositoryMockRemoveFromListExpectation {
+	if mmRemoveFromList.mock.funcRemoveFromList != nil {
+		mmRemoveFromList.mock.t.Fatalf("CartRepositoryMock.RemoveFromList mock is already set by Set")
 	}
 
-	expectation := &CartRepositoryMockGetCartExpectation{
-		mock:               mmGetCart.mock,
-		params:             &CartRepositoryMockGetCartParams{ctx, userID},
-		expectationOrigins: CartRepositoryMockGetCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &CartRepositoryMockRemoveFromListExpectation{
+		mock:               mmRemoveFromList.mock,
+		params:             &CartRepositoryMockRemoveFromListParams{ctx, userID, list, skuID},
+		expectationOrigins: CartRepositoryMockRemoveFromListExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetCart.expectations = append(mmGetCart.expectations, expectation)
+	mmRemoveFromList.expectations = append(mmRemoveFromList.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.GetCart return parameters for the expectation previously defined by the When method
-func (e *CartRepositoryMockGetCartExpectation) Then(m1 map[int64]uint16, err error) *CartRepositoryMock {
-	e.results = &CartRepositoryMockGetCartResults{m1, err}
+// Then sets up Repository.RemoveFromList return parameters for the expectation previously defined by the When method
+func (e *CartRepositoryMockRemoveFromListExpectation) Then(err error) *CartRepositoryMock {
+	e.results = &CartRepositoryMockRemoveFromListResults{err}
 	return e.mock
 }
 
-// Times sets number of times Repository.GetCart should be invoked
-func (mmGetCart *mCartRepositoryMockGetCart) Times(n uint64) *mCartRepositoryMockGetCart {
+// Times sets number of times Repository.RemoveFromList should be invoked
+func (mmRemoveFromList *mCartRepositoryMockRemoveFromList) Times(n uint64) *mCartRepositoryMockRemoveFromList {
 	if n == 0 {
-		mmGetCart.mock.t.Fatalf("Times of CartRepositoryMock.GetCart mock can not be zero")
+		mmRemoveFromList.mock.t.Fatalf("Times of CartRepositoryMock.RemoveFromList mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetCart.expectedInvocations, n)
-	mmGetCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetCart
+	mm_atomic.StoreUint64(&mmRemoveFromList.expectedInvocations, n)
+	mmRemoveFromList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemoveFromList
 }
 
-func (mmGetCart *mCartRepositoryMockGetCart) invocationsDone() bool {
-	if len(mmGetCart.expectations) == 0 && mmGetCart.defaultExpectation == nil && mmGetCart.mock.funcGetCart == nil {
+func (mmRemoveFromList *mCartRepositoryMockRemoveFromList) invocationsDone() bool {
+	if len(mmRemoveFromList.expectations) == 0 && mmRemoveFromList.defaultExpectation == nil && mmRemoveFromList.mock.funcRemoveFromList == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetCart.mock.afterGetCartCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetCart.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveFromList.mock.afterRemoveFromListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveFromList.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetCart implements mm_cart.Repository
-func (mmGetCart *CartRepositoryMock) GetCart(ctx context.Context, userID uint64) (m1 map[int64]uint16, err error) {
-	mm_atomic.AddUint64(&mmGetCart.beforeGetCartCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetCart.afterGetCartCounter, 1)
+// RemoveFromList implements mm_cart.Repository
+func (mmRemoveFromList *CartRepositoryMock) RemoveFromList(ctx context.Context, userID uint64, list string, skuID int64) (err error) {
+	mm_atomic.AddUint64(&mmRemoveFromList.beforeRemoveFromListCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveFromList.afterRemoveFromListCounter, 1)
 
-	mmGetCart.t.Helper()
+	mmRemoveFromList.t.Helper()
 
-	if mmGetCart.inspectFuncGetCart != nil {
-		mmGetCart.inspectFuncGetCart(ctx, userID)
+	if mmRemoveFromList.inspectFuncRemoveFromList != nil {
+		mmRemoveFromList.inspectFuncRemoveFromList(ctx, userID, list, skuID)
 	}
 
-	mm_params := CartRepositoryMockGetCartParams{ctx, userID}
+	mm_params := CartRepositoryMockRemoveFromListParams{ctx, userID, list, skuID}
 
 	// Record call args
-	mmGetCart.GetCartMock.mutex.Lock()
-	mmGetCart.GetCartMock.callArgs = append(mmGetCart.GetCartMock.callArgs, &mm_params)
-	mmGetCart.GetCartMock.mutex.Unlock()
+	mmRemoveFromList.RemoveFromListMock.mutex.Lock()
+	mmRemoveFromList.RemoveFromListMock.callArgs = append(mmRemoveFromList.RemoveFromListMock.callArgs, &mm_params)
+	mmRemoveFromList.RemoveFromListMock.mutex.Unlock()
 
-	for _, e := range mmGetCart.GetCartMock.expectations {
+	for _, e := range mmRemoveFromList.RemoveFromListMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.m1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetCart.GetCartMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetCart.GetCartMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetCart.GetCartMock.defaultExpectation.params
-		mm_want_ptrs := mmGetCart.GetCartMock.defaultExpectation.paramPtrs
+	if mmRemoveFromList.RemoveFromListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveFromList.RemoveFromListMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveFromList.RemoveFromListMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveFromList.RemoveFromListMock.defaultExpectation.paramPtrs
 
-		mm_got := CartRepositoryMockGetCartParams{ctx, userID}
+		mm_got := CartRepositoryMockRemoveFromListParams{ctx, userID, list, skuID}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetCart.t.Errorf("CartRepositoryMock.GetCart got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetCart.GetCartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmRemoveFromList.t.Errorf("CartRepositoryMock.RemoveFromList got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveFromList.RemoveFromListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmGetCart.t.Errorf("CartRepositoryMock.GetCart got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetCart.GetCartMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+				mmRemoveFromList.t.Errorf("CartRepositoryMock.RemoveFromList got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveFromList.RemoveFromListMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.list != nil && !minimock.Equal(*mm_want_ptrs.list, mm_got.list) {
+				mmRemoveFromList.t.Errorf("CartRepositoryMock.RemoveFromList got unexpected parameter list, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveFromList.RemoveFromListMock.defaultExpectation.expectationOrigins.originList, *mm_want_ptrs.list, mm_got.list, minimock.Diff(*mm_want_ptrs.list, mm_got.list))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmRemoveFromList.t.Errorf("CartRepositoryMock.RemoveFromList got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveFromList.RemoveFromListMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetCart.t.Errorf("CartRepositoryMock.GetCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetCart.GetCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmRemoveFromList.t.Errorf("CartRepositoryMock.RemoveFromList got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemoveFromList.RemoveFromListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetCart.GetCartMock.defaultExpectation.results
+		mm_results := mmRemoveFromList.RemoveFromListMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetCart.t.Fatal("No results are set for the CartRepositoryMock.GetCart")
+			mmRemoveFromList.t.Fatal("No results are set for the CartRepositoryMock.RemoveFromList")
 		}
-		return (*mm_results).m1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetCart.funcGetCart != nil {
-		return mmGetCart.funcGetCart(ctx, userID)
+	if mmRemoveFromList.funcRemoveFromList != nil {
+		return mmRemoveFromList.funcRemoveFromList(ctx, userID, list, skuID)
 	}
-	mmGetCart.t.Fatalf("Unexpected call to CartRepositoryMock.GetCart. %v %v", ctx, userID)
+	mmRemoveFromList.t.Fatalf("Unexpected call to CartRepositoryMock.RemoveFromList. %v %v %v %v", ctx, userID, list, skuID)
 	return
 }
 
-// GetCartAfterCounter returns a count of finished CartRepositoryMock.GetCart invocations
-func (mmGetCart *CartRepositoryMock) GetCartAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetCart.afterGetCartCounter)
+// RemoveFromListAfterCounter returns a count of finished CartRepositoryMock.RemoveFromList invocations
+func (mmRemoveFromList *CartRepositoryMock) RemoveFromListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveFromList.afterRemoveFromListCounter)
 }
 
-// GetCartBeforeCounter returns a count of CartRepositoryMock.GetCart invocations
-func (mmGetCart *CartRepositoryMock) GetCartBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetCart.beforeGetCartCounter)
+// RemoveFromListBeforeCounter returns a count of CartRepositoryMock.RemoveFromList invocations
+func (mmRemoveFromList *CartRepositoryMock) RemoveFromListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveFromList.beforeRemoveFromListCounter)
 }
 
-// Calls returns a list of arguments used in each call to CartRepositoryMock.GetCart.
+// Calls returns a list of arguments used in each call to CartRepositoryMock.RemoveFromList.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetCart *mCartRepositoryMockGetCart) Calls() []*CartRepositoryMockGetCartParams {
-	mmGetCart.mutex.RLock()
+func (mmRemoveFromList *mCartRepositoryMockRemoveFromList) Calls() []*CartRepositoryMockRemoveFromListParams {
+	mmRemoveFromList.mutex.RLock()
 
-	argCopy := make([]*CartRepositoryMockGetCartParams, len(mmGetCart.callArgs))
-	copy(argCopy, mmGetCart.callArgs)
+	argCopy := make([]*CartRepositoryMockRemoveFromListParams, len(mmRemoveFromList.callArgs))
+	copy(argCopy, mmRemoveFromList.callArgs)
 
-	mmGetCart.mutex.RUnlock()
+	mmRemoveFromList.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetCartDone returns true if the count of the GetCart invocations corresponds
+// MinimockRemoveFromListDone returns true if the count of the RemoveFromList invocations corresponds
 // the number of defined expectations
-func (m *CartRepositoryMock) MinimockGetCartDone() bool {
-	if m.GetCartMock.optional {
+func (m *CartRepositoryMock) MinimockRemoveFromListDone() bool {
+	if m.RemoveFromListMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetCartMock.expectations {
+	for _, e := range m.RemoveFromListMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetCartMock.invocationsDone()
+	return m.RemoveFromListMock.invocationsDone()
 }
 
-// MinimockGetCartInspect logs each unmet expectation
-func (m *CartRepositoryMock) MinimockGetCartInspect() {
-	for _, e := range m.GetCartMock.expectations {
+// MinimockRemoveFromListInspect logs each unmet expectation
+func (m *CartRepositoryMock) MinimockRemoveFromListInspect() {
+	for _, e := range m.RemoveFromListMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to CartRepositoryMock.GetCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to CartRepositoryMock.RemoveFromList at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetCartCounter := mm_atomic.LoadUint64(&m.afterGetCartCounter)
+	afterRemoveFromListCounter := mm_atomic.LoadUint64(&m.afterRemoveFromListCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetCartMock.defaultExpectation != nil && afterGetCartCounter < 1 {
-		if m.GetCartMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to CartRepositoryMock.GetCart at\n%s", m.GetCartMock.defaultExpectation.returnOrigin)
+	if m.RemoveFromListMock.defaultExpectation != nil && afterRemoveFromListCounter < 1 {
+		if m.RemoveFromListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CartRepositoryMock.RemoveFromList at\n%s", m.RemoveFromListMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to CartRepositoryMock.GetCart at\n%s with params: %#v", m.GetCartMock.defaultExpectation.expectationOrigins.origin, *m.GetCartMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to CartRepositoryMock.RemoveFromList at\n%s with params: %#v", m.RemoveFromListMock.defaultExpectation.expectationOrigins.origin, *m.RemoveFromListMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetCart != nil && afterGetCartCounter < 1 {
-		m.t.Errorf("Expected call to CartRepositoryMock.GetCart at\n%s", m.funcGetCartOrigin)
+	if m.funcRemoveFromList != nil && afterRemoveFromListCounter < 1 {
+		m.t.Errorf("Expected call to CartRepositoryMock.RemoveFromList at\n%s", m.funcRemoveFromListOrigin)
 	}
 
-	if !m.GetCartMock.invocationsDone() && afterGetCartCounter > 0 {
-		m.t.Errorf("Expected %d calls to CartRepositoryMock.GetCart at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetCartMock.expectedInvocations), m.GetCartMock.expectedInvocationsOrigin, afterGetCartCounter)
+	if !m.RemoveFromListMock.invocationsDone() && afterRemoveFromListCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartRepositoryMock.RemoveFromList at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveFromListMock.expectedInvocations), m.RemoveFromListMock.expectedInvocationsOrigin, afterRemoveFromListCounter)
 	}
 }
 
-type mCartRepositoryMockRemoveFromCart struct {
+type mCartRepositoryMockSetCoupon struct {
 	optional           bool
 	mock               *CartRepositoryMock
-	defaultExpectation *CartRepositoryMockRemoveFromCartExpectation
-	expectations       []*CartRepositoryMockRemoveFromCartExpectation
+	defaultExpectation *CartRepositoryMockSetCouponExpectation
+	expectations       []*CartRepositoryMockSetCouponExpectation
 
-	callArgs []*CartRepositoryMockRemoveFromCartParams
+	callArgs []*CartRepositoryMockSetCouponParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// CartRepositoryMockRemoveFromCartExpectation specifies expectation struct of the Repository.RemoveFromCart
-type CartRepositoryMockRemoveFromCartExpectation struct {
+// CartRepositoryMockSetCouponExpectation specifies expectation struct of the Repository.SetCoupon
+type CartRepositoryMockSetCouponExpectation struct {
 	mock               *CartRepositoryMock
-	params             *CartRepositoryMockRemoveFromCartParams
-	paramPtrs          *CartRepositoryMockRemoveFromCartParamPtrs
-	expectationOrigins CartRepositoryMockRemoveFromCartExpectationOrigins
-	results            *CartRepositoryMockRemoveFromCartResults
+	params             *CartRepositoryMockSetCouponParams
+	paramPtrs          *CartRepositoryMockSetCouponParamPtrs
+	expectationOrigins CartRepositoryMockSetCouponExpectationOrigins
+	results            *CartRepositoryMockSetCouponResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// CartRepositoryMockRemoveFromCartParams contains parameters of the Repository.RemoveFromCart
-type CartRepositoryMockRemoveFromCartParams struct {
+// CartRepositoryMockSetCouponParams contains parameters of the Repository.SetCoupon
+type CartRepositoryMockSetCouponParams struct {
 	ctx    context.Context
-	skuID  int64
 	userID uint64
+	code   string
 }
 
-// CartRepositoryMockRemoveFromCartParamPtrs contains pointers to parameters of the Repository.RemoveFromCart
-type CartRepositoryMockRemoveFromCartParamPtrs struct {
+// CartRepositoryMockSetCouponParamPtrs contains pointers to parameters of the Repository.SetCoupon
+type CartRepositoryMockSetCouponParamPtrs struct {
 	ctx    *context.Context
-	skuID  *int64
 	userID *uint64
+	code   *string
 }
 
-// CartRepositoryMockRemoveFromCartResults contains results of the Repository.RemoveFromCart
-type CartRepositoryMockRemoveFromCartResults struct {
+// CartRepositoryMockSetCouponResults contains results of the Repository.SetCoupon
+type CartRepositoryMockSetCouponResults struct {
 	err error
 }
 
-// CartRepositoryMockRemoveFromCartOrigins contains origins of expectations of the Repository.RemoveFromCart
-type CartRepositoryMockRemoveFromCartExpectationOrigins struct {
+// CartRepositoryMockSetCouponOrigins contains origins of expectations of the Repository.SetCoupon
+type CartRepositoryMockSetCouponExpectationOrigins struct {
 	origin       string
 	originCtx    string
-	originSkuID  string
 	originUserID string
+	originCode   string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1217,320 +5943,320 @@ type CartRepositoryMockRemoveFromCartExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmRemoveFromCart *mCartRepositoryMockRemoveFromCart) Optional() *mCartRepositoryMockRemoveFromCart {
-	mmRemoveFromCart.optional = true
-	return mmRemoveFromCart
+func (mmSetCoupon *mCartRepositoryMockSetCoupon) Optional() *mCartRepositoryMockSetCoupon {
+	mmSetCoupon.optional = true
+	return mmSetCoupon
 }
 
-// Expect sets up expected params for Repository.RemoveFromCart
-func (mmRemoveFromCart *mCartRepositoryMockRemoveFromCart) Expect(ctx context.Context, skuID int64, userID uint64) *mCartRepositoryMockRemoveFromCart {
-	if mmRemoveFromCart.mock.funcRemoveFromCart != nil {
-		mmRemoveFromCart.mock.t.Fatalf("CartRepositoryMock.RemoveFromCart mock is already set by Set")
+// Expect sets up expected params for Repository.SetCoupon
+func (mmSetCoupon *mCartRepositoryMockSetCoupon) Expect(ctx context.Context, userID uint64, code string) *mCartRepositoryMockSetCoupon {
+	if mmSetCoupon.mock.funcSetCoupon != nil {
+		mmSetCoupon.mock.t.Fatalf("CartRepositoryMock.SetCoupon mock is already set by Set")
 	}
 
-	if mmRemoveFromCart.defaultExpectation == nil {
-		mmRemoveFromCart.defaultExpectation = &CartRepositoryMockRemoveFromCartExpectation{}
+	if mmSetCoupon.defaultExpectation == nil {
+		mmSetCoupon.defaultExpectation = &CartRepositoryMockSetCouponExpectation{}
 	}
 
-	if mmRemoveFromCart.defaultExpectation.paramPtrs != nil {
-		mmRemoveFromCart.mock.t.Fatalf("CartRepositoryMock.RemoveFromCart mock is already set by ExpectParams functions")
+	if mmSetCoupon.defaultExpectation.paramPtrs != nil {
+		mmSetCoupon.mock.t.Fatalf("CartRepositoryMock.SetCoupon mock is already set by ExpectParams functions")
 	}
 
-	mmRemoveFromCart.defaultExpectation.params = &CartRepositoryMockRemoveFromCartParams{ctx, skuID, userID}
-	mmRemoveFromCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmRemoveFromCart.expectations {
-		if minimock.Equal(e.params, mmRemoveFromCart.defaultExpectation.params) {
-			mmRemoveFromCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveFromCart.defaultExpectation.params)
+	mmSetCoupon.defaultExpectation.params = &CartRepositoryMockSetCouponParams{ctx, userID, code}
+	mmSetCoupon.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetCoupon.expectations {
+		if minimock.Equal(e.params, mmSetCoupon.defaultExpectation.params) {
+			mmSetCoupon.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetCoupon.defaultExpectation.params)
 		}
 	}
 
-	return mmRemoveFromCart
+	return mmSetCoupon
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Repository.RemoveFromCart
-func (mmRemoveFromCart *mCartRepositoryMockRemoveFromCart) ExpectCtxParam1(ctx context.Context) *mCartRepositoryMockRemoveFromCart {
-	if mmRemoveFromCart.mock.funcRemoveFromCart != nil {
-		mmRemoveFromCart.mock.t.Fatalf("CartRepositoryMock.RemoveFromCart mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.SetCoupon
+func (mmSetCoupon *mCartRepositoryMockSetCoupon) ExpectCtxParam1(ctx context.Context) *mCartRepositoryMockSetCoupon {
+	if mmSetCoupon.mock.funcSetCoupon != nil {
+		mmSetCoupon.mock.t.Fatalf("CartRepositoryMock.SetCoupon mock is already set by Set")
 	}
 
-	if mmRemoveFromCart.defaultExpectation == nil {
-		mmRemoveFromCart.defaultExpectation = &CartRepositoryMockRemoveFromCartExpectation{}
+	if mmSetCoupon.defaultExpectation == nil {
+		mmSetCoupon.defaultExpectation = &CartRepositoryMockSetCouponExpectation{}
 	}
 
-	if mmRemoveFromCart.defaultExpectation.params != nil {
-		mmRemoveFromCart.mock.t.Fatalf("CartRepositoryMock.RemoveFromCart mock is already set by Expect")
+	if mmSetCoupon.defaultExpectation.params != nil {
+		mmSetCoupon.mock.t.Fatalf("CartRepositoryMock.SetCoupon mock is already set by Expect")
 	}
 
-	if mmRemoveFromCart.defaultExpectation.paramPtrs == nil {
-		mmRemoveFromCart.defaultExpectation.paramPtrs = &CartRepositoryMockRemoveFromCartParamPtrs{}
+	if mmSetCoupon.defaultExpectation.paramPtrs == nil {
+		mmSetCoupon.defaultExpectation.paramPtrs = &CartRepositoryMockSetCouponParamPtrs{}
 	}
-	mmRemoveFromCart.defaultExpectation.paramPtrs.ctx = &ctx
-	mmRemoveFromCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmSetCoupon.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetCoupon.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmRemoveFromCart
+	return mmSetCoupon
 }
 
-// ExpectSkuIDParam2 sets up expected param skuID for Repository.RemoveFromCart
-func (mmRemoveFromCart *mCartRepositoryMockRemoveFromCart) ExpectSkuIDParam2(skuID int64) *mCartRepositoryMockRemoveFromCart {
-	if mmRemoveFromCart.mock.funcRemoveFromCart != nil {
-		mmRemoveFromCart.mock.t.Fatalf("CartRepositoryMock.RemoveFromCart mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for Repository.SetCoupon
+func (mmSetCoupon *mCartRepositoryMockSetCoupon) ExpectUserIDParam2(userID uint64) *mCartRepositoryMockSetCoupon {
+	if mmSetCoupon.mock.funcSetCoupon != nil {
+		mmSetCoupon.mock.t.Fatalf("CartRepositoryMock.SetCoupon mock is already set by Set")
 	}
 
-	if mmRemoveFromCart.defaultExpectation == nil {
-		mmRemoveFromCart.defaultExpectation = &CartRepositoryMockRemoveFromCartExpectation{}
+	if mmSetCoupon.defaultExpectation == nil {
+		mmSetCoupon.defaultExpectation = &CartRepositoryMockSetCouponExpectation{}
 	}
 
-	if mmRemoveFromCart.defaultExpectation.params != nil {
-		mmRemoveFromCart.mock.t.Fatalf("CartRepositoryMock.RemoveFromCart mock is already set by Expect")
+	if mmSetCoupon.defaultExpectation.params != nil {
+		mmSetCoupon.mock.t.Fatalf("CartRepositoryMock.SetCoupon mock is already set by Expect")
 	}
 
-	if mmRemoveFromCart.defaultExpectation.paramPtrs == nil {
-		mmRemoveFromCart.defaultExpectation.paramPtrs = &CartRepositoryMockRemoveFromCartParamPtrs{}
+	if mmSetCoupon.defaultExpectation.paramPtrs == nil {
+		mmSetCoupon.defaultExpectation.paramPtrs = &CartRepositoryMockSetCouponParamPtrs{}
 	}
-	mmRemoveFromCart.defaultExpectation.paramPtrs.skuID = &skuID
-	mmRemoveFromCart.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+	mmSetCoupon.defaultExpectation.paramPtrs.userID = &userID
+	mmSetCoupon.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmRemoveFromCart
+	return mmSetCoupon
 }
 
-// ExpectUserIDParam3 sets up expected param userID for Repository.RemoveFromCart
-func (mmRemoveFromCart *mCartRepositoryMockRemoveFromCart) ExpectUserIDParam3(userID uint64) *mCartRepositoryMockRemoveFromCart {
-	if mmRemoveFromCart.mock.funcRemoveFromCart != nil {
-		mmRemoveFromCart.mock.t.Fatalf("CartRepositoryMock.RemoveFromCart mock is already set by Set")
+// ExpectCodeParam3 sets up expected param code for Repository.SetCoupon
+func (mmSetCoupon *mCartRepositoryMockSetCoupon) ExpectCodeParam3(code string) *mCartRepositoryMockSetCoupon {
+	if mmSetCoupon.mock.funcSetCoupon != nil {
+		mmSetCoupon.mock.t.Fatalf("CartRepositoryMock.SetCoupon mock is already set by Set")
 	}
 
-	if mmRemoveFromCart.defaultExpectation == nil {
-		mmRemoveFromCart.defaultExpectation = &CartRepositoryMockRemoveFromCartExpectation{}
+	if mmSetCoupon.defaultExpectation == nil {
+		mmSetCoupon.defaultExpectation = &CartRepositoryMockSetCouponExpectation{}
 	}
 
-	if mmRemoveFromCart.defaultExpectation.params != nil {
-		mmRemoveFromCart.mock.t.Fatalf("CartRepositoryMock.RemoveFromCart mock is already set by Expect")
+	if mmSetCoupon.defaultExpectation.params != nil {
+		mmSetCoupon.mock.t.Fatalf("CartRepositoryMock.SetCoupon mock is already set by Expect")
 	}
 
-	if mmRemoveFromCart.defaultExpectation.paramPtrs == nil {
-		mmRemoveFromCart.defaultExpectation.paramPtrs = &CartRepositoryMockRemoveFromCartParamPtrs{}
+	if mmSetCoupon.defaultExpectation.paramPtrs == nil {
+		mmSetCoupon.defaultExpectation.paramPtrs = &CartRepositoryMockSetCouponParamPtrs{}
 	}
-	mmRemoveFromCart.defaultExpectation.paramPtrs.userID = &userID
-	mmRemoveFromCart.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmSetCoupon.defaultExpectation.paramPtrs.code = &code
+	mmSetCoupon.defaultExpectation.expectationOrigins.originCode = minimock.CallerInfo(1)
 
-	return mmRemoveFromCart
+	return mmSetCoupon
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.RemoveFromCart
-func (mmRemoveFromCart *mCartRepositoryMockRemoveFromCart) Inspect(f func(ctx context.Context, skuID int64, userID uint64)) *mCartRepositoryMockRemoveFromCart {
-	if mmRemoveFromCart.mock.inspectFuncRemoveFromCart != nil {
-		mmRemoveFromCart.mock.t.Fatalf("Inspect function is already set for CartRepositoryMock.RemoveFromCart")
+// Inspect accepts an inspector function that has same arguments as the Repository.SetCoupon
+func (mmSetCoupon *mCartRepositoryMockSetCoupon) Inspect(f func(ctx context.Context, userID uint64, code string)) *mCartRepositoryMockSetCoupon {
+	if mmSetCoupon.mock.inspectFuncSetCoupon != nil {
+		mmSetCoupon.mock.t.Fatalf("Inspect function is already set for CartRepositoryMock.SetCoupon")
 	}
 
-	mmRemoveFromCart.mock.inspectFuncRemoveFromCart = f
+	mmSetCoupon.mock.inspectFuncSetCoupon = f
 
-	return mmRemoveFromCart
+	return mmSetCoupon
 }
 
-// Return sets up results that will be returned by Repository.RemoveFromCart
-func (mmRemoveFromCart *mCartRepositoryMockRemoveFromCart) Return(err error) *CartRepositoryMock {
-	if mmRemoveFromCart.mock.funcRemoveFromCart != nil {
-		mmRemoveFromCart.mock.t.Fatalf("CartRepositoryMock.RemoveFromCart mock is already set by Set")
+// Return sets up results that will be returned by Repository.SetCoupon
+func (mmSetCoupon *mCartRepositoryMockSetCoupon) Return(err error) *CartRepositoryMock {
+	if mmSetCoupon.mock.funcSetCoupon != nil {
+		mmSetCoupon.mock.t.Fatalf("CartRepositoryMock.SetCoupon mock is already set by Set")
 	}
 
-	if mmRemoveFromCart.defaultExpectation == nil {
-		mmRemoveFromCart.defaultExpectation = &CartRepositoryMockRemoveFromCartExpectation{mock: mmRemoveFromCart.mock}
+	if mmSetCoupon.defaultExpectation == nil {
+		mmSetCoupon.defaultExpectation = &CartRepositoryMockSetCouponExpectation{mock: mmSetCoupon.mock}
 	}
-	mmRemoveFromCart.defaultExpectation.results = &CartRepositoryMockRemoveFromCartResults{err}
-	mmRemoveFromCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmRemoveFromCart.mock
+	mmSetCoupon.defaultExpectation.results = &CartRepositoryMockSetCouponResults{err}
+	mmSetCoupon.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetCoupon.mock
 }
 
-// Set uses given function f to mock the Repository.RemoveFromCart method
-func (mmRemoveFromCart *mCartRepositoryMockRemoveFromCart) Set(f func(ctx context.Context, skuID int64, userID uint64) (err error)) *CartRepositoryMock {
-	if mmRemoveFromCart.defaultExpectation != nil {
-		mmRemoveFromCart.mock.t.Fatalf("Default expectation is already set for the Repository.RemoveFromCart method")
+// Set uses given function f to mock the Repository.SetCoupon method
+func (mmSetCoupon *mCartRepositoryMockSetCoupon) Set(f func(ctx context.Context, userID uint64, code string) (err error)) *CartRepositoryMock {
+	if mmSetCoupon.defaultExpectation != nil {
+		mmSetCoupon.mock.t.Fatalf("Default expectation is already set for the Repository.SetCoupon method")
 	}
 
-	if len(mmRemoveFromCart.expectations) > 0 {
-		mmRemoveFromCart.mock.t.Fatalf("Some expectations are already set for the Repository.RemoveFromCart method")
+	if len(mmSetCoupon.expectations) > 0 {
+		mmSetCoupon.mock.t.Fatalf("Some expectations are already set for the Repository.SetCoupon method")
 	}
 
-	mmRemoveFromCart.mock.funcRemoveFromCart = f
-	mmRemoveFromCart.mock.funcRemoveFromCartOrigin = minimock.CallerInfo(1)
-	return mmRemoveFromCart.mock
+	mmSetCoupon.mock.funcSetCoupon = f
+	mmSetCoupon.mock.funcSetCouponOrigin = minimock.CallerInfo(1)
+	return mmSetCoupon.mock
 }
 
-// When sets expectation for the Repository.RemoveFromCart which will trigger the result defined by the following
+// When sets expectation for the Repository.SetCoupon which will trigger the result defined by the following
 // Then helper
-func (mmRemoveFromCart *mCartRepositoryMockRemoveFromCart) When(ctx context.Context, skuID int64, userID uint64) *CartRepositoryMockRemoveFromCartExpectation {
-	if mmRemoveFromCart.mock.funcRemoveFromCart != nil {
-		mmRemoveFromCart.mock.t.Fatalf("CartRepositoryMock.RemoveFromCart mock is already set by Set")
+func (mmSetCoupon *mCartRepositoryMockSetCoupon) When(ctx context.Context, userID uint64, code string) *CartRepositoryMockSetCouponExpectation {
+	if mmSetCoupon.mock.funcSetCoupon != nil {
+		mmSetCoupon.mock.t.Fatalf("CartRepositoryMock.SetCoupon mock is already set by Set")
 	}
 
-	expectation := &CartRepositoryMockRemoveFromCartExpectation{
-		mock:               mmRemoveFromCart.mock,
-		params:             &CartRepositoryMockRemoveFromCartParams{ctx, skuID, userID},
-		expectationOrigins: CartRepositoryMockRemoveFromCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &CartRepositoryMockSetCouponExpectation{
+		mock:               mmSetCoupon.mock,
+		params:             &CartRepositoryMockSetCouponParams{ctx, userID, code},
+		expectationOrigins: CartRepositoryMockSetCouponExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmRemoveFromCart.expectations = append(mmRemoveFromCart.expectations, expectation)
+	mmSetCoupon.expectations = append(mmSetCoupon.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.RemoveFromCart return parameters for the expectation previously defined by the When method
-func (e *CartRepositoryMockRemoveFromCartExpectation) Then(err error) *CartRepositoryMock {
-	e.results = &CartRepositoryMockRemoveFromCartResults{err}
+// Then sets up Repository.SetCoupon return parameters for the expectation previously defined by the When method
+func (e *CartRepositoryMockSetCouponExpectation) Then(err error) *CartRepositoryMock {
+	e.results = &CartRepositoryMockSetCouponResults{err}
 	return e.mock
 }
 
-// Times sets number of times Repository.RemoveFromCart should be invoked
-func (mmRemoveFromCart *mCartRepositoryMockRemoveFromCart) Times(n uint64) *mCartRepositoryMockRemoveFromCart {
+// Times sets number of times Repository.SetCoupon should be invoked
+func (mmSetCoupon *mCartRepositoryMockSetCoupon) Times(n uint64) *mCartRepositoryMockSetCoupon {
 	if n == 0 {
-		mmRemoveFromCart.mock.t.Fatalf("Times of CartRepositoryMock.RemoveFromCart mock can not be zero")
+		mmSetCoupon.mock.t.Fatalf("Times of CartRepositoryMock.SetCoupon mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmRemoveFromCart.expectedInvocations, n)
-	mmRemoveFromCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmRemoveFromCart
+	mm_atomic.StoreUint64(&mmSetCoupon.expectedInvocations, n)
+	mmSetCoupon.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetCoupon
 }
 
-func (mmRemoveFromCart *mCartRepositoryMockRemoveFromCart) invocationsDone() bool {
-	if len(mmRemoveFromCart.expectations) == 0 && mmRemoveFromCart.defaultExpectation == nil && mmRemoveFromCart.mock.funcRemoveFromCart == nil {
+func (mmSetCoupon *mCartRepositoryMockSetCoupon) invocationsDone() bool {
+	if len(mmSetCoupon.expectations) == 0 && mmSetCoupon.defaultExpectation == nil && mmSetCoupon.mock.funcSetCoupon == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmRemoveFromCart.mock.afterRemoveFromCartCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveFromCart.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSetCoupon.mock.afterSetCouponCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetCoupon.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// RemoveFromCart implements mm_cart.Repository
-func (mmRemoveFromCart *CartRepositoryMock) RemoveFromCart(ctx context.Context, skuID int64, userID uint64) (err error) {
-	mm_atomic.AddUint64(&mmRemoveFromCart.beforeRemoveFromCartCounter, 1)
-	defer mm_atomic.AddUint64(&mmRemoveFromCart.afterRemoveFromCartCounter, 1)
+// SetCoupon implements mm_cart.Repository
+func (mmSetCoupon *CartRepositoryMock) SetCoupon(ctx context.Context, userID uint64, code string) (err error) {
+	mm_atomic.AddUint64(&mmSetCoupon.beforeSetCouponCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetCoupon.afterSetCouponCounter, 1)
 
-	mmRemoveFromCart.t.Helper()
+	mmSetCoupon.t.Helper()
 
-	if mmRemoveFromCart.inspectFuncRemoveFromCart != nil {
-		mmRemoveFromCart.inspectFuncRemoveFromCart(ctx, skuID, userID)
+	if mmSetCoupon.inspectFuncSetCoupon != nil {
+		mmSetCoupon.inspectFuncSetCoupon(ctx, userID, code)
 	}
 
-	mm_params := CartRepositoryMockRemoveFromCartParams{ctx, skuID, userID}
+	mm_params := CartRepositoryMockSetCouponParams{ctx, userID, code}
 
 	// Record call args
-	mmRemoveFromCart.RemoveFromCartMock.mutex.Lock()
-	mmRemoveFromCart.RemoveFromCartMock.callArgs = append(mmRemoveFromCart.RemoveFromCartMock.callArgs, &mm_params)
-	mmRemoveFromCart.RemoveFromCartMock.mutex.Unlock()
+	mmSetCoupon.SetCouponMock.mutex.Lock()
+	mmSetCoupon.SetCouponMock.callArgs = append(mmSetCoupon.SetCouponMock.callArgs, &mm_params)
+	mmSetCoupon.SetCouponMock.mutex.Unlock()
 
-	for _, e := range mmRemoveFromCart.RemoveFromCartMock.expectations {
+	for _, e := range mmSetCoupon.SetCouponMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmRemoveFromCart.RemoveFromCartMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmRemoveFromCart.RemoveFromCartMock.defaultExpectation.Counter, 1)
-		mm_want := mmRemoveFromCart.RemoveFromCartMock.defaultExpectation.params
-		mm_want_ptrs := mmRemoveFromCart.RemoveFromCartMock.defaultExpectation.paramPtrs
+	if mmSetCoupon.SetCouponMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetCoupon.SetCouponMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetCoupon.SetCouponMock.defaultExpectation.params
+		mm_want_ptrs := mmSetCoupon.SetCouponMock.defaultExpectation.paramPtrs
 
-		mm_got := CartRepositoryMockRemoveFromCartParams{ctx, skuID, userID}
+		mm_got := CartRepositoryMockSetCouponParams{ctx, userID, code}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmRemoveFromCart.t.Errorf("CartRepositoryMock.RemoveFromCart got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmRemoveFromCart.RemoveFromCartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmSetCoupon.t.Errorf("CartRepositoryMock.SetCoupon got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetCoupon.SetCouponMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
-				mmRemoveFromCart.t.Errorf("CartRepositoryMock.RemoveFromCart got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmRemoveFromCart.RemoveFromCartMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmSetCoupon.t.Errorf("CartRepositoryMock.SetCoupon got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetCoupon.SetCouponMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
 			}
 
-			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmRemoveFromCart.t.Errorf("CartRepositoryMock.RemoveFromCart got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmRemoveFromCart.RemoveFromCartMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			if mm_want_ptrs.code != nil && !minimock.Equal(*mm_want_ptrs.code, mm_got.code) {
+				mmSetCoupon.t.Errorf("CartRepositoryMock.SetCoupon got unexpected parameter code, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetCoupon.SetCouponMock.defaultExpectation.expectationOrigins.originCode, *mm_want_ptrs.code, mm_got.code, minimock.Diff(*mm_want_ptrs.code, mm_got.code))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmRemoveFromCart.t.Errorf("CartRepositoryMock.RemoveFromCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmRemoveFromCart.RemoveFromCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSetCoupon.t.Errorf("CartRepositoryMock.SetCoupon got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetCoupon.SetCouponMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmRemoveFromCart.RemoveFromCartMock.defaultExpectation.results
+		mm_results := mmSetCoupon.SetCouponMock.defaultExpectation.results
 		if mm_results == nil {
-			mmRemoveFromCart.t.Fatal("No results are set for the CartRepositoryMock.RemoveFromCart")
+			mmSetCoupon.t.Fatal("No results are set for the CartRepositoryMock.SetCoupon")
 		}
 		return (*mm_results).err
 	}
-	if mmRemoveFromCart.funcRemoveFromCart != nil {
-		return mmRemoveFromCart.funcRemoveFromCart(ctx, skuID, userID)
+	if mmSetCoupon.funcSetCoupon != nil {
+		return mmSetCoupon.funcSetCoupon(ctx, userID, code)
 	}
-	mmRemoveFromCart.t.Fatalf("Unexpected call to CartRepositoryMock.RemoveFromCart. %v %v %v", ctx, skuID, userID)
+	mmSetCoupon.t.Fatalf("Unexpected call to CartRepositoryMock.SetCoupon. %v %v %v", ctx, userID, code)
 	return
 }
 
-// RemoveFromCartAfterCounter returns a count of finished CartRepositoryMock.RemoveFromCart invocations
-func (mmRemoveFromCart *CartRepositoryMock) RemoveFromCartAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmRemoveFromCart.afterRemoveFromCartCounter)
+// SetCouponAfterCounter returns a count of finished CartRepositoryMock.SetCoupon invocations
+func (mmSetCoupon *CartRepositoryMock) SetCouponAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetCoupon.afterSetCouponCounter)
 }
 
-// RemoveFromCartBeforeCounter returns a count of CartRepositoryMock.RemoveFromCart invocations
-func (mmRemoveFromCart *CartRepositoryMock) RemoveFromCartBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmRemoveFromCart.beforeRemoveFromCartCounter)
+// SetCouponBeforeCounter returns a count of CartRepositoryMock.SetCoupon invocations
+func (mmSetCoupon *CartRepositoryMock) SetCouponBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetCoupon.beforeSetCouponCounter)
 }
 
-// Calls returns a list of arguments used in each call to CartRepositoryMock.RemoveFromCart.
+// Calls returns a list of arguments used in each call to CartRepositoryMock.SetCoupon.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmRemoveFromCart *mCartRepositoryMockRemoveFromCart) Calls() []*CartRepositoryMockRemoveFromCartParams {
-	mmRemoveFromCart.mutex.RLock()
+func (mmSetCoupon *mCartRepositoryMockSetCoupon) Calls() []*CartRepositoryMockSetCouponParams {
+	mmSetCoupon.mutex.RLock()
 
-	argCopy := make([]*CartRepositoryMockRemoveFromCartParams, len(mmRemoveFromCart.callArgs))
-	copy(argCopy, mmRemoveFromCart.callArgs)
+	argCopy := make([]*CartRepositoryMockSetCouponParams, len(mmSetCoupon.callArgs))
+	copy(argCopy, mmSetCoupon.callArgs)
 
-	mmRemoveFromCart.mutex.RUnlock()
+	mmSetCoupon.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockRemoveFromCartDone returns true if the count of the RemoveFromCart invocations corresponds
+// MinimockSetCouponDone returns true if the count of the SetCoupon invocations corresponds
 // the number of defined expectations
-func (m *CartRepositoryMock) MinimockRemoveFromCartDone() bool {
-	if m.RemoveFromCartMock.optional {
+func (m *CartRepositoryMock) MinimockSetCouponDone() bool {
+	if m.SetCouponMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.RemoveFromCartMock.expectations {
+	for _, e := range m.SetCouponMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.RemoveFromCartMock.invocationsDone()
+	return m.SetCouponMock.invocationsDone()
 }
 
-// MinimockRemoveFromCartInspect logs each unmet expectation
-func (m *CartRepositoryMock) MinimockRemoveFromCartInspect() {
-	for _, e := range m.RemoveFromCartMock.expectations {
+// MinimockSetCouponInspect logs each unmet expectation
+func (m *CartRepositoryMock) MinimockSetCouponInspect() {
+	for _, e := range m.SetCouponMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to CartRepositoryMock.RemoveFromCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to CartRepositoryMock.SetCoupon at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterRemoveFromCartCounter := mm_atomic.LoadUint64(&m.afterRemoveFromCartCounter)
+	afterSetCouponCounter := mm_atomic.LoadUint64(&m.afterSetCouponCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.RemoveFromCartMock.defaultExpectation != nil && afterRemoveFromCartCounter < 1 {
-		if m.RemoveFromCartMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to CartRepositoryMock.RemoveFromCart at\n%s", m.RemoveFromCartMock.defaultExpectation.returnOrigin)
+	if m.SetCouponMock.defaultExpectation != nil && afterSetCouponCounter < 1 {
+		if m.SetCouponMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CartRepositoryMock.SetCoupon at\n%s", m.SetCouponMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to CartRepositoryMock.RemoveFromCart at\n%s with params: %#v", m.RemoveFromCartMock.defaultExpectation.expectationOrigins.origin, *m.RemoveFromCartMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to CartRepositoryMock.SetCoupon at\n%s with params: %#v", m.SetCouponMock.defaultExpectation.expectationOrigins.origin, *m.SetCouponMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcRemoveFromCart != nil && afterRemoveFromCartCounter < 1 {
-		m.t.Errorf("Expected call to CartRepositoryMock.RemoveFromCart at\n%s", m.funcRemoveFromCartOrigin)
+	if m.funcSetCoupon != nil && afterSetCouponCounter < 1 {
+		m.t.Errorf("Expected call to CartRepositoryMock.SetCoupon at\n%s", m.funcSetCouponOrigin)
 	}
 
-	if !m.RemoveFromCartMock.invocationsDone() && afterRemoveFromCartCounter > 0 {
-		m.t.Errorf("Expected %d calls to CartRepositoryMock.RemoveFromCart at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.RemoveFromCartMock.expectedInvocations), m.RemoveFromCartMock.expectedInvocationsOrigin, afterRemoveFromCartCounter)
+	if !m.SetCouponMock.invocationsDone() && afterSetCouponCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartRepositoryMock.SetCoupon at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetCouponMock.expectedInvocations), m.SetCouponMock.expectedInvocationsOrigin, afterSetCouponCounter)
 	}
 }
 
@@ -1540,11 +6266,35 @@ func (m *CartRepositoryMock) MinimockFinish() {
 		if !m.minimockDone() {
 			m.MinimockAddToCartInspect()
 
+			m.MinimockAddToListInspect()
+
+			m.MinimockCheckoutItemsInspect()
+
 			m.MinimockClearCartInspect()
 
+			m.MinimockDeleteListInspect()
+
 			m.MinimockGetCartInspect()
 
+			m.MinimockGetCouponInspect()
+
+			m.MinimockGetListInspect()
+
+			m.MinimockGetVersionInspect()
+
+			m.MinimockListsInspect()
+
+			m.MinimockMergeCartInspect()
+
+			m.MinimockMoveItemInspect()
+
+			m.MinimockRemoveCouponInspect()
+
 			m.MinimockRemoveFromCartInspect()
+
+			m.MinimockRemoveFromListInspect()
+
+			m.MinimockSetCouponInspect()
 		}
 	})
 }
@@ -1569,7 +6319,19 @@ func (m *CartRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockAddToCartDone() &&
+		m.MinimockAddToListDone() &&
+		m.MinimockCheckoutItemsDone() &&
 		m.MinimockClearCartDone() &&
+		m.MinimockDeleteListDone() &&
 		m.MinimockGetCartDone() &&
-		m.MinimockRemoveFromCartDone()
+		m.MinimockGetCouponDone() &&
+		m.MinimockGetListDone() &&
+		m.MinimockGetVersionDone() &&
+		m.MinimockListsDone() &&
+		m.MinimockMergeCartDone() &&
+		m.MinimockMoveItemDone() &&
+		m.MinimockRemoveCouponDone() &&
+		m.MinimockRemoveFromCartDone() &&
+		m.MinimockRemoveFromListDone() &&
+		m.MinimockSetCouponDone()
 }
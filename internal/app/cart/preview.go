@@ -0,0 +1,162 @@
+package cart
+
+import (
+	"cmp"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/vestamart/cart/internal/domain"
+	"github.com/vestamart/cart/internal/localErr"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const defaultPreviewTTL = 10 * time.Minute
+
+// previews signs the tokens of checkout previews.
+type previews struct {
+	secret   []byte
+	ttl      time.Duration
+	required bool
+	now      func() time.Time
+}
+
+func newPreviews() previews {
+	secret := make([]byte, 32)
+	_, _ = rand.Read(secret)
+	return previews{secret: secret, ttl: defaultPreviewTTL, now: time.Now}
+}
+
+// WithCheckoutPreview sets the secret and lifetime of preview tokens. With
+// required on, CheckoutCart and CheckoutItems only run with the token of a
+// preview that still matches the cart.
+func (s *Service) WithCheckoutPreview(secret []byte, ttl time.Duration, required bool) *Service {
+	if len(secret) > 0 {
+		s.previews.secret = secret
+	}
+	s.previews.ttl = ttl
+	s.previews.required = required
+	return s
+}
+
+// PreviewCheckout runs the checkout of the cart, or of the items selected by
+// lines, up to the order creation. Nothing is ordered or removed.
+func (s *Service) PreviewCheckout(ctx context.Context, userID uint64, lines []domain.CheckoutLine) (*domain.CheckoutPreview, error) {
+	cart, err := s.GetCart(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err = domain.CheckVersion(ctx, cart.Version); err != nil {
+		return nil, err
+	}
+	// A selection is priced on its own, as it is what the order will hold.
+	priced := cart
+	if len(lines) > 0 {
+		items, err := domain.SelectItems(cart, lines)
+		if err != nil {
+			return nil, err
+		}
+		priced = &domain.UserCart{Items: items}
+		if err = s.reprice(priced, cart.Coupon); err != nil {
+			return nil, err
+		}
+	}
+	items := priced.Items
+	if len(items) == 0 {
+		return nil, localErr.ErrCartNotFound
+	}
+
+	preview := &domain.CheckoutPreview{
+		UserID:     userID,
+		Version:    cart.Version,
+		Items:      make([]domain.PreviewItem, 0, len(items)),
+		Subtotal:   priced.Subtotal,
+		Discounts:  priced.Discounts,
+		TotalPrice: priced.TotalPrice,
+		Problems:   []domain.PreviewProblem{},
+	}
+	for _, item := range items {
+		stock, checked, err := s.stock(ctx, item.Sku)
+		if err != nil {
+			return nil, err
+		}
+		if !checked {
+			preview.Items = append(preview.Items, domain.PreviewItem{CartItem: item})
+			continue
+		}
+		preview.Items = append(preview.Items, domain.PreviewItem{CartItem: item, Available: &stock})
+		if !enoughStock(stock, item.Count) {
+			preview.Problems = append(preview.Problems, domain.PreviewProblem{
+				SkuID:  item.Sku,
+				Code:   localErr.ItemNotEnoughErr.Code,
+				Detail: fmt.Sprintf("%d requested, %d available", item.Count, stock),
+			})
+		}
+	}
+	if len(lines) == 0 {
+		slices.SortFunc(preview.Items, func(a, b domain.PreviewItem) int { return cmp.Compare(a.Sku, b.Sku) })
+	}
+
+	if len(preview.Problems) == 0 {
+		expiresAt := s.previews.now().Add(s.previews.ttl).Truncate(time.Second)
+		preview.Token = s.previews.sign(userID, cart.Version, items, expiresAt)
+		preview.ExpiresAt = &expiresAt
+	}
+	return preview, nil
+}
+
+// checkPreview verifies the preview token of ctx against the items about to
+// be ordered. Without a token it only fails if previews are required.
+func (s *Service) checkPreview(ctx context.Context, userID, version uint64, items []domain.CartItem) error {
+	token := domain.PreviewToken(ctx)
+	if token == "" {
+		if s.previews.required {
+			return domain.ErrPreviewRequired
+		}
+		return nil
+	}
+	if !s.previews.verify(token, userID, version, items) {
+		return domain.ErrPreviewStale
+	}
+	return nil
+}
+
+// sign returns "<expiry unix seconds>.<hex HMAC-SHA256>" over the user, the
+// cart version, the expiry and every sku with its count and price.
+func (p previews) sign(userID, version uint64, items []domain.CartItem, expiresAt time.Time) string {
+	exp := strconv.FormatInt(expiresAt.Unix(), 10)
+	return exp + "." + hex.EncodeToString(p.mac(userID, version, items, exp))
+}
+
+func (p previews) verify(token string, userID, version uint64, items []domain.CartItem) bool {
+	exp, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return false
+	}
+	unix, err := strconv.ParseInt(exp, 10, 64)
+	if err != nil || !p.now().Before(time.Unix(unix, 0)) {
+		return false
+	}
+	got, err := hex.DecodeString(sig)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(got, p.mac(userID, version, items, exp))
+}
+
+func (p previews) mac(userID, version uint64, items []domain.CartItem, exp string) []byte {
+	sorted := slices.Clone(items)
+	slices.SortFunc(sorted, func(a, b domain.CartItem) int { return cmp.Compare(a.Sku, b.Sku) })
+
+	m := hmac.New(sha256.New, p.secret)
+	_, _ = fmt.Fprintf(m, "%d.%d.%s", userID, version, exp)
+	for _, item := range sorted {
+		_, _ = fmt.Fprintf(m, ".%d:%d:%d%s", item.Sku, item.Count, item.Price.Amount, item.Price.Currency)
+	}
+	return m.Sum(nil)
+}
@@ -0,0 +1,215 @@
+package cart
+
+import (
+	"context"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/cart/internal/app/cart/mock"
+	"github.com/vestamart/cart/internal/domain"
+	"github.com/vestamart/cart/internal/promotion"
+	"github.com/vestamart/loms/pkg/api/loms/v1"
+	"testing"
+	"time"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestCartService_PreviewCheckout(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		stock            *uint64
+		stockCheckOff    bool
+		expectedProblems []domain.PreviewProblem
+		expectedToken    bool
+	}{
+		{
+			name:             "Enough stock - token issued",
+			stock:            ptr(uint64(5)),
+			expectedProblems: []domain.PreviewProblem{},
+			expectedToken:    true,
+		},
+		{
+			name:  "Not enough stock - problem, no token",
+			stock: ptr(uint64(1)),
+			expectedProblems: []domain.PreviewProblem{
+				{SkuID: 123, Code: "insufficient_stock", Detail: "2 requested, 1 available"},
+			},
+		},
+		{
+			name:  "Stock equal to the count - problem, as in AddToCart",
+			stock: ptr(uint64(2)),
+			expectedProblems: []domain.PreviewProblem{
+				{SkuID: 123, Code: "insufficient_stock", Detail: "2 requested, 2 available"},
+			},
+		},
+		{
+			name:             "Stock check off - LOMS not asked, token issued",
+			stockCheckOff:    true,
+			expectedProblems: []domain.PreviewProblem{},
+			expectedToken:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			repoMock := mock.NewCartRepositoryMock(mc)
+			productMock := mock.NewProductServiceMock(mc)
+			lomsMock := mock.NewLomsClientMock(mc)
+			service := NewCartService(repoMock, productMock, lomsMock).
+				WithCheckoutPreview([]byte("secret"), time.Minute, false)
+			service.previews.now = func() time.Time { return now }
+			service.SetStockCheck(!tt.stockCheckOff)
+
+			repoMock.GetVersionMock.Return(3, nil)
+			repoMock.GetCartMock.Return(map[int64]uint16{123: 2}, nil)
+			productMock.GetProductMock.Return(&domain.ProductServiceResponse{Name: "Test Product", Price: 100}, nil)
+			if tt.stock != nil {
+				lomsMock.StocksInfoMock.Return(&loms.StocksInfoResponse{Count: *tt.stock}, nil)
+			}
+
+			preview, err := service.PreviewCheckout(context.Background(), 456, nil)
+			require.NoError(t, err)
+			assert.Equal(t, []domain.PreviewItem{{
+				CartItem:  domain.CartItem{Sku: 123, Name: "Test Product", Count: 2, Price: rub(100)},
+				Available: tt.stock,
+			}}, preview.Items)
+			assert.Equal(t, rub(200), preview.TotalPrice)
+			assert.Equal(t, tt.expectedProblems, preview.Problems)
+			assert.Equal(t, tt.expectedToken, preview.Token != "")
+		})
+	}
+}
+
+func TestCartService_PreviewCheckout_Promotions(t *testing.T) {
+	promotions, err := promotion.New([]promotion.Rule{
+		{ID: "spring10", Type: promotion.Percent, Coupon: "SPRING10", Percent: 10},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		lines         []domain.CheckoutLine
+		expectedItems int
+		expectedTotal domain.Money
+		expected      []domain.Discount
+	}{
+		{
+			name:          "Whole cart - total of GetCart",
+			expectedItems: 2,
+			expectedTotal: rub(270),
+			expected:      []domain.Discount{{Promotion: "spring10", Amount: rub(30)}},
+		},
+		{
+			name:          "Selection - coupon on the selected items",
+			lines:         []domain.CheckoutLine{{SkuID: 123}},
+			expectedItems: 1,
+			expectedTotal: rub(180),
+			expected:      []domain.Discount{{Promotion: "spring10", Amount: rub(20)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			repoMock := mock.NewCartRepositoryMock(mc)
+			productMock := mock.NewProductServiceMock(mc)
+			lomsMock := mock.NewLomsClientMock(mc)
+			service := NewCartService(repoMock, productMock, lomsMock).WithPromotions(promotions)
+
+			repoMock.GetVersionMock.Return(3, nil)
+			repoMock.GetCartMock.Return(map[int64]uint16{123: 2, 124: 1}, nil)
+			repoMock.GetCouponMock.Return("SPRING10", nil)
+			productMock.GetProductMock.Return(&domain.ProductServiceResponse{Name: "Test Product", Price: 100}, nil)
+			lomsMock.StocksInfoMock.Return(&loms.StocksInfoResponse{Count: 10}, nil)
+
+			preview, err := service.PreviewCheckout(context.Background(), 456, tt.lines)
+			require.NoError(t, err)
+			assert.Len(t, preview.Items, tt.expectedItems)
+			assert.Equal(t, tt.expected, preview.Discounts)
+			assert.Equal(t, tt.expectedTotal, preview.TotalPrice)
+		})
+	}
+}
+
+func TestCartService_CheckoutWithPreview(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	items := []domain.CartItem{{Sku: 123, Count: 2, Price: rub(100)}}
+
+	service := NewCartService(nil, nil, nil).WithCheckoutPreview([]byte("secret"), time.Minute, true)
+	service.previews.now = func() time.Time { return now }
+	token := service.previews.sign(456, 3, items, now.Add(time.Minute))
+
+	tests := []struct {
+		name        string
+		token       string
+		version     uint64
+		items       []domain.CartItem
+		after       time.Duration
+		expectedErr error
+	}{
+		{
+			name:    "Matching token - success",
+			token:   token,
+			version: 3,
+			items:   items,
+		},
+		{
+			name:        "No token - required",
+			version:     3,
+			items:       items,
+			expectedErr: domain.ErrPreviewRequired,
+		},
+		{
+			name:        "Cart changed - stale",
+			token:       token,
+			version:     4,
+			items:       []domain.CartItem{{Sku: 123, Count: 3, Price: rub(100)}},
+			expectedErr: domain.ErrPreviewStale,
+		},
+		{
+			name:        "Price changed - stale",
+			token:       token,
+			version:     3,
+			items:       []domain.CartItem{{Sku: 123, Count: 2, Price: rub(90)}},
+			expectedErr: domain.ErrPreviewStale,
+		},
+		{
+			name:        "Expired - stale",
+			token:       token,
+			version:     3,
+			items:       items,
+			after:       time.Minute,
+			expectedErr: domain.ErrPreviewStale,
+		},
+		{
+			name:        "Other user - stale",
+			token:       service.previews.sign(457, 3, items, now.Add(time.Minute)),
+			version:     3,
+			items:       items,
+			expectedErr: domain.ErrPreviewStale,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service.previews.now = func() time.Time { return now.Add(tt.after) }
+			ctx := context.Background()
+			if tt.token != "" {
+				ctx = domain.WithPreviewToken(ctx, tt.token)
+			}
+
+			err := service.checkPreview(ctx, 456, tt.version, tt.items)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
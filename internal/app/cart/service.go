@@ -2,10 +2,10 @@ package cart
 
 import (
 	"context"
-	"errors"
 	"github.com/vestamart/cart/internal/domain"
 	"github.com/vestamart/cart/internal/localErr"
 	"github.com/vestamart/loms/pkg/api/loms/v1"
+	"sync/atomic"
 )
 
 //go:generate minimock -i github.com/vestamart/cart/internal/app/cart.Repository -o ./mock/repository_mock.go -n CartRepositoryMock -p mock
@@ -14,6 +14,28 @@ type Repository interface {
 	RemoveFromCart(_ context.Context, skuID int64, userID uint64) error
 	ClearCart(_ context.Context, userID uint64) error
 	GetCart(_ context.Context, userID uint64) (map[int64]uint16, error)
+	GetVersion(_ context.Context, userID uint64) (uint64, error)
+	MergeCart(_ context.Context, userID uint64, items map[int64]uint16) error
+
+	AddToList(_ context.Context, userID uint64, list string, skuID int64, count uint16) error
+	RemoveFromList(_ context.Context, userID uint64, list string, skuID int64) error
+	MoveItem(_ context.Context, userID uint64, from, to string, skuID int64, count uint16) (uint16, error)
+	DeleteList(_ context.Context, userID uint64, list string) error
+	CheckoutItems(_ context.Context, userID uint64, list string, items map[int64]uint16, orderID int64) error
+	GetList(_ context.Context, userID uint64, list string) (map[int64]uint16, error)
+	Lists(_ context.Context, userID uint64) ([]domain.ListSummary, error)
+
+	SetCoupon(_ context.Context, userID uint64, code string) error
+	RemoveCoupon(_ context.Context, userID uint64) error
+	GetCoupon(_ context.Context, userID uint64) (string, error)
+}
+
+//go:generate minimock -i github.com/vestamart/cart/internal/app/cart.GuestRepository -o ./mock/guest_repository_mock.go -n GuestRepositoryMock -p mock
+type GuestRepository interface {
+	AddToGuestCart(_ context.Context, token string, skuID int64, count uint16) error
+	RemoveFromGuestCart(_ context.Context, token string, skuID int64) error
+	GetGuestCart(_ context.Context, token string) (map[int64]uint16, error)
+	DeleteGuestCart(_ context.Context, token string) error
 }
 
 //go:generate minimock -i github.com/vestamart/cart/internal/app/cart.ProductService -o ./mock/product_service_mock.go -n ProductServiceMock -p mock
@@ -22,67 +44,227 @@ type ProductService interface {
 	GetProduct(ctx context.Context, sku int64) (*domain.ProductServiceResponse, error)
 }
 
+// Promotions prices carts with discounts.
+type Promotions interface {
+	// Coupon returns the canonical code of a coupon, false if there is none.
+	Coupon(code string) (string, bool)
+	// Apply sets the discounts and the total of cart for coupon.
+	Apply(cart *domain.UserCart, coupon string) error
+}
+
+// Publisher receives every cart change made through the service.
+type Publisher interface {
+	Publish(event domain.CartEvent)
+}
+
 //go:generate minimock -i github.com/vestamart/loms/pkg/api/loms/v1.LomsClient -o ./mock/loms_client_mock.go -n LomsClientMock -p mock
 type Service struct {
 	repository     Repository
 	productService ProductService
 	lomsService    loms.LomsClient
+	stockCheck     *atomic.Bool
+	publishers     []Publisher
+	guests         GuestRepository
+	mergePolicy    domain.MergePolicy
+	previews       previews
+	promotions     Promotions
+	exchange       Exchange
 }
 
 func NewCartService(repository Repository, client ProductService, loms loms.LomsClient) *Service {
-	return &Service{repository: repository, productService: client, lomsService: loms}
+	s := &Service{
+		repository:     repository,
+		productService: client,
+		lomsService:    loms,
+		stockCheck:     &atomic.Bool{},
+		mergePolicy:    domain.MergeSum,
+		previews:       newPreviews(),
+	}
+	s.stockCheck.Store(true)
+	return s
+}
+
+// SetStockCheck toggles the LOMS stock check in AddToCart.
+func (s *Service) SetStockCheck(enabled bool) {
+	s.stockCheck.Store(enabled)
+}
+
+// WithGuestCarts enables guest carts. policy is used by MergeGuestCart when
+// the caller does not pick one.
+func (s *Service) WithGuestCarts(guests GuestRepository, policy domain.MergePolicy) *Service {
+	s.guests = guests
+	s.mergePolicy = policy
+	return s
+}
+
+// WithPromotions applies the discounts of p to the cart in GetCart.
+func (s *Service) WithPromotions(p Promotions) *Service {
+	s.promotions = p
+	return s
+}
+
+// AddPublisher subscribes p to cart changes. It must be called before the
+// service starts serving.
+func (s *Service) AddPublisher(p Publisher) {
+	s.publishers = append(s.publishers, p)
+}
+
+func (s *Service) publish(event domain.CartEvent) {
+	for _, p := range s.publishers {
+		p.Publish(event)
+	}
 }
 
 func (s *Service) AddToCart(ctx context.Context, skuID int64, userID uint64, count uint16) error {
 	if skuID < 1 || userID < 1 {
-		return errors.New("skuID or userID must be greater than 0")
+		return localErr.ErrInvalidArgument.WithMsg("skuID or userID must be greater than 0")
 	}
+	if err := s.checkItem(ctx, skuID, count); err != nil {
+		return err
+	}
+
+	if err := s.repository.AddToCart(ctx, skuID, userID, count); err != nil {
+		return err
+	}
+	s.publish(domain.CartEvent{Type: domain.EventItemAdded, UserID: userID, List: domain.DefaultList, SkuID: skuID, Count: count})
+
+	return nil
+}
+
+// checkItem verifies that skuID exists and, unless the stock check is off,
+// that LOMS has more than count of it.
+func (s *Service) checkItem(ctx context.Context, skuID int64, count uint16) error {
 	if err := s.productService.ExistItem(ctx, skuID); err != nil {
 		return err
 	}
 
-	v, err := s.lomsService.StocksInfo(ctx, &loms.StocksInfoRequest{Sku: uint32(skuID)})
+	stock, checked, err := s.stock(ctx, skuID)
 	if err != nil {
 		return err
 	}
-	if uint16(v.Count) <= count {
+	if checked && !enoughStock(stock, count) {
 		return localErr.ItemNotEnoughErr
 	}
 
-	return s.repository.AddToCart(ctx, skuID, userID, count)
+	return nil
+}
+
+// stock returns the LOMS stock of skuID, or false if the stock check is off.
+func (s *Service) stock(ctx context.Context, skuID int64) (uint64, bool, error) {
+	if !s.stockCheck.Load() {
+		return 0, false, nil
+	}
+	v, err := s.lomsService.StocksInfo(ctx, &loms.StocksInfoRequest{Sku: uint32(skuID)})
+	if err != nil {
+		return 0, false, err
+	}
+	return v.GetCount(), true, nil
+}
+
+// enoughStock is the boundary of every stock check: count needs more than
+// count in stock.
+func enoughStock(stock uint64, count uint16) bool {
+	return stock > uint64(count)
 }
 
 func (s *Service) RemoveFromCart(ctx context.Context, skuID int64, userID uint64) error {
-	return s.repository.RemoveFromCart(ctx, skuID, userID)
+	if err := s.repository.RemoveFromCart(ctx, skuID, userID); err != nil {
+		return err
+	}
+	s.publish(domain.CartEvent{Type: domain.EventItemRemoved, UserID: userID, List: domain.DefaultList, SkuID: skuID})
+
+	return nil
 }
 
 func (s *Service) ClearCart(ctx context.Context, userID uint64) error {
-	return s.repository.ClearCart(ctx, userID)
+	if err := s.repository.ClearCart(ctx, userID); err != nil {
+		return err
+	}
+	s.publish(domain.CartEvent{Type: domain.EventCartCleared, UserID: userID, List: domain.DefaultList})
+
+	return nil
+}
+
+// CartVersion returns the current cart version without loading the products.
+func (s *Service) CartVersion(ctx context.Context, userID uint64) (uint64, error) {
+	return s.repository.GetVersion(ctx, userID)
 }
 
 func (s *Service) GetCart(ctx context.Context, userID uint64) (*domain.UserCart, error) {
+	// The version is read first: if the cart changes in between, the stale
+	// version makes a later conditional request fail instead of succeed.
+	version, err := s.repository.GetVersion(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
 	userCart, err := s.repository.GetCart(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	var totalPrice uint32
-	var cart domain.UserCart
+	cart, err := s.price(ctx, userCart)
+	if err != nil {
+		return nil, err
+	}
+	if s.promotions != nil {
+		coupon, err := s.repository.GetCoupon(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if err = s.promotions.Apply(cart, coupon); err != nil {
+			return nil, err
+		}
+	}
+	cart.Version = version
+	return cart, nil
+}
 
-	for sku, count := range userCart {
+// reprice sets the subtotal, the discounts and the total of the priced items
+// of cart, as GetCart does for the whole cart.
+func (s *Service) reprice(cart *domain.UserCart, coupon string) error {
+	cart.Subtotal = domain.NewMoney(0, domain.DefaultCurrency)
+	if s.promotions != nil {
+		return s.promotions.Apply(cart, coupon)
+	}
+	for _, item := range cart.Items {
+		line, err := item.Price.Mul(int64(item.Count))
+		if err != nil {
+			return err
+		}
+		if cart.Subtotal, err = cart.Subtotal.Add(line); err != nil {
+			return err
+		}
+	}
+	cart.TotalPrice = cart.Subtotal
+	return nil
+}
+
+// price loads the products of items and computes the cart total, without
+// discounts. A total that does not fit in Money is ErrMoneyOverflow.
+func (s *Service) price(ctx context.Context, items map[int64]uint16) (*domain.UserCart, error) {
+	cart := domain.UserCart{TotalPrice: domain.NewMoney(0, domain.DefaultCurrency)}
+
+	for sku, count := range items {
 		resp, err := s.productService.GetProduct(ctx, sku)
 		if err != nil {
 			return nil, err
 		}
-		totalPrice += uint32(count) * resp.Price
+		price := domain.NewMoney(int64(resp.Price), domain.DefaultCurrency)
+		line, err := price.Mul(int64(count))
+		if err != nil {
+			return nil, err
+		}
+		if cart.TotalPrice, err = cart.TotalPrice.Add(line); err != nil {
+			return nil, err
+		}
 		cart.Items = append(cart.Items, domain.CartItem{
 			Sku:   sku,
 			Name:  resp.Name,
 			Count: count,
-			Price: resp.Price,
+			Price: price,
 		})
 	}
-	cart.TotalPrice = totalPrice
+	cart.Subtotal = cart.TotalPrice
 	return &cart, nil
 }
 
@@ -91,9 +273,84 @@ func (s *Service) CheckoutCart(ctx context.Context, userID uint64) (int64, error
 	if err != nil {
 		return 0, err
 	}
+	if err = s.checkPreview(ctx, userID, cart.Version, cart.Items); err != nil {
+		return 0, err
+	}
+	return s.checkout(ctx, userID, domain.DefaultList, cart)
+}
+
+// checkout places an order for cart, the content of list, and removes the
+// ordered counts from list.
+func (s *Service) checkout(ctx context.Context, userID uint64, list string, cart *domain.UserCart) (int64, error) {
+	if err := domain.CheckVersion(ctx, cart.Version); err != nil {
+		return 0, err
+	}
+
+	orderID, err := s.createOrder(ctx, userID, cart.Items)
+	if err != nil {
+		return 0, err
+	}
+	if err = s.removeOrdered(ctx, userID, list, cart.Items, orderID); err != nil {
+		return 0, err
+	}
+
+	return orderID, nil
+}
+
+// removeOrdered removes the ordered counts from list. The order is placed
+// already, so they are removed unconditionally, but only them: whatever was
+// added to the list meanwhile was not ordered and stays.
+func (s *Service) removeOrdered(ctx context.Context, userID uint64, list string, ordered []domain.CartItem, orderID int64) error {
+	purchased := make(map[int64]uint16, len(ordered))
+	for _, item := range ordered {
+		purchased[item.Sku] = item.Count
+	}
+	err := s.repository.CheckoutItems(domain.WithoutIfMatch(ctx), userID, list, purchased, orderID)
+	if err != nil {
+		return err
+	}
+	s.publish(domain.CartEvent{Type: domain.EventCheckout, UserID: userID, List: list, OrderID: orderID, Items: purchased})
+	return nil
+}
+
+// CheckoutItems places an order for some of the items in the cart and
+// removes only the purchased counts. A line with a zero count buys all of
+// its sku.
+func (s *Service) CheckoutItems(ctx context.Context, userID uint64, lines []domain.CheckoutLine) (int64, error) {
+	if len(lines) == 0 {
+		return 0, localErr.ErrInvalidArgument.WithMsg("no items to check out")
+	}
+	cart, err := s.GetCart(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	if err = domain.CheckVersion(ctx, cart.Version); err != nil {
+		return 0, err
+	}
+
+	selected, err := domain.SelectItems(cart, lines)
+	if err != nil {
+		return 0, err
+	}
+	if err = s.checkPreview(ctx, userID, cart.Version, selected); err != nil {
+		return 0, err
+	}
+
+	orderID, err := s.createOrder(ctx, userID, selected)
+	if err != nil {
+		return 0, err
+	}
 
+	if err = s.removeOrdered(ctx, userID, domain.DefaultList, selected, orderID); err != nil {
+		return 0, err
+	}
+
+	return orderID, nil
+}
+
+func (s *Service) createOrder(ctx context.Context, userID uint64, cartItems []domain.CartItem) (int64, error) {
 	var items []*loms.Item
-	for _, item := range cart.Items {
+	for _, item := range cartItems {
 		items = append(items, &loms.Item{
 			Sku:   uint32(item.Sku),
 			Count: uint32(item.Count),
@@ -109,11 +366,5 @@ func (s *Service) CheckoutCart(ctx context.Context, userID uint64) (int64, error
 	if err != nil {
 		return 0, err
 	}
-
-	err = s.ClearCart(ctx, userID)
-	if err != nil {
-		return 0, err
-	}
-
 	return orderID.GetOrderId(), nil
 }
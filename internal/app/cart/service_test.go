@@ -5,6 +5,7 @@ import (
 	"errors"
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/vestamart/cart/internal/app/cart/mock"
 	"github.com/vestamart/cart/internal/domain"
 	"github.com/vestamart/cart/internal/localErr"
@@ -12,6 +13,10 @@ import (
 	"testing"
 )
 
+func rub(amount int64) domain.Money {
+	return domain.NewMoney(amount, domain.DefaultCurrency)
+}
+
 func TestCartService_AddToCart(t *testing.T) {
 	mc := minimock.NewController(t)
 	repoMock := mock.NewCartRepositoryMock(mc)
@@ -189,6 +194,7 @@ func TestCartService_GetCart(t *testing.T) {
 			name:   "Valid cart with items - success",
 			userID: 456,
 			prepareMocks: func() {
+				repoMock.GetVersionMock.Return(3, nil)
 				repoMock.GetCartMock.Return(map[int64]uint16{123: 2}, nil)
 				productMock.GetProductMock.When(context.Background(), int64(123)).Then(&domain.ProductServiceResponse{
 					Name:  "Test Product",
@@ -201,10 +207,12 @@ func TestCartService_GetCart(t *testing.T) {
 						Sku:   123,
 						Name:  "Test Product",
 						Count: 2,
-						Price: 100,
+						Price: rub(100),
 					},
 				},
-				TotalPrice: 200,
+				Subtotal:   rub(200),
+				TotalPrice: rub(200),
+				Version:    3,
 			},
 			expectedErr: nil,
 		},
@@ -212,11 +220,14 @@ func TestCartService_GetCart(t *testing.T) {
 			name:   "Empty cart - success",
 			userID: 456,
 			prepareMocks: func() {
+				repoMock.GetVersionMock.Return(3, nil)
 				repoMock.GetCartMock.Return(map[int64]uint16{}, nil)
 			},
 			expectedCart: &domain.UserCart{
 				Items:      nil,
-				TotalPrice: 0,
+				Subtotal:   rub(0),
+				TotalPrice: rub(0),
+				Version:    3,
 			},
 			expectedErr: nil,
 		},
@@ -224,6 +235,7 @@ func TestCartService_GetCart(t *testing.T) {
 			name:   "Repository error - failure",
 			userID: 456,
 			prepareMocks: func() {
+				repoMock.GetVersionMock.Return(3, nil)
 				repoMock.GetCartMock.Return(nil, errors.New("database error"))
 			},
 			expectedCart: nil,
@@ -233,6 +245,7 @@ func TestCartService_GetCart(t *testing.T) {
 			name:   "Product service error - failure",
 			userID: 4567,
 			prepareMocks: func() {
+				repoMock.GetVersionMock.Return(3, nil)
 				repoMock.GetCartMock.Return(map[int64]uint16{133: 2}, nil)
 				productMock.GetProductMock.When(minimock.AnyContext, int64(133)).Then(nil, errors.New("product not found"))
 			},
@@ -258,6 +271,23 @@ func TestCartService_GetCart(t *testing.T) {
 	}
 }
 
+func TestCartService_GetCart_PriceUnit(t *testing.T) {
+	mc := minimock.NewController(t)
+	repoMock := mock.NewCartRepositoryMock(mc)
+	productMock := mock.NewProductServiceMock(mc)
+	service := NewCartService(repoMock, productMock, nil)
+
+	repoMock.GetVersionMock.Return(1, nil)
+	repoMock.GetCartMock.Return(map[int64]uint16{1076963: 2}, nil)
+	// The product service prices in whole rubles.
+	productMock.GetProductMock.Return(&domain.ProductServiceResponse{Name: "Book", Price: 3379}, nil)
+
+	cart, err := service.GetCart(context.Background(), 456)
+	require.NoError(t, err)
+	assert.Equal(t, domain.Money{Amount: 3379, Currency: "RUB"}, cart.Items[0].Price, "rubles are not scaled to kopecks")
+	assert.Equal(t, domain.Money{Amount: 6758, Currency: "RUB"}, cart.TotalPrice)
+}
+
 func TestCartService_CheckoutCart(t *testing.T) {
 	mc := minimock.NewController(t)
 	repoMock := mock.NewCartRepositoryMock(mc)
@@ -269,6 +299,7 @@ func TestCartService_CheckoutCart(t *testing.T) {
 	tests := []struct {
 		name         string
 		userID       uint64
+		ifMatch      []uint64
 		prepareMocks func()
 		expectedID   int64
 		expectedErr  error
@@ -277,10 +308,11 @@ func TestCartService_CheckoutCart(t *testing.T) {
 			name:   "Successful checkout",
 			userID: 456,
 			prepareMocks: func() {
+				repoMock.GetVersionMock.Return(3, nil)
 				repoMock.GetCartMock.Return(map[int64]uint16{123: 2}, nil)
 				productMock.GetProductMock.Return(&domain.ProductServiceResponse{Name: "Test Product", Price: 100}, nil)
 				lomsMock.OrderCreateMock.Return(&loms.OrderCreateResponse{OrderId: 1}, nil)
-				repoMock.ClearCartMock.Return(nil)
+				repoMock.CheckoutItemsMock.Return(nil)
 			},
 			expectedID:  1,
 			expectedErr: nil,
@@ -289,6 +321,7 @@ func TestCartService_CheckoutCart(t *testing.T) {
 			name:   "Order creation fails",
 			userID: 456,
 			prepareMocks: func() {
+				repoMock.GetVersionMock.Return(3, nil)
 				repoMock.GetCartMock.Return(map[int64]uint16{123: 2}, nil)
 				productMock.GetProductMock.Return(&domain.ProductServiceResponse{Name: "Test Product", Price: 100}, nil)
 				lomsMock.OrderCreateMock.Return(nil, errors.New("order creation failed"))
@@ -296,13 +329,96 @@ func TestCartService_CheckoutCart(t *testing.T) {
 			expectedID:  0,
 			expectedErr: errors.New("order creation failed"),
 		},
+		{
+			name:    "Stale version - no order created",
+			userID:  456,
+			ifMatch: []uint64{2},
+			prepareMocks: func() {
+				repoMock.GetVersionMock.Return(3, nil)
+				repoMock.GetCartMock.Return(map[int64]uint16{123: 2}, nil)
+				productMock.GetProductMock.Return(&domain.ProductServiceResponse{Name: "Test Product", Price: 100}, nil)
+			},
+			expectedID:  0,
+			expectedErr: domain.ErrVersionMismatch,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.prepareMocks()
-			orderID, err := service.CheckoutCart(context.Background(), tt.userID)
-			assert.Equal(t, tt.expectedErr, err)
+			ctx := context.Background()
+			if tt.ifMatch != nil {
+				ctx = domain.WithIfMatch(ctx, tt.ifMatch...)
+			}
+			orderID, err := service.CheckoutCart(ctx, tt.userID)
+			if errors.Is(tt.expectedErr, domain.ErrVersionMismatch) {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.Equal(t, tt.expectedErr, err)
+			}
+			assert.Equal(t, tt.expectedID, orderID)
+		})
+	}
+}
+
+func TestCartService_CheckoutItems(t *testing.T) {
+	tests := []struct {
+		name         string
+		lines        []domain.CheckoutLine
+		prepareMocks func(repoMock *mock.CartRepositoryMock, lomsMock *mock.LomsClientMock)
+		expectedID   int64
+		expectedErr  error
+	}{
+		{
+			name:  "Some of the cart - success",
+			lines: []domain.CheckoutLine{{SkuID: 123, Count: 1}, {SkuID: 789}},
+			prepareMocks: func(repoMock *mock.CartRepositoryMock, lomsMock *mock.LomsClientMock) {
+				lomsMock.OrderCreateMock.Return(&loms.OrderCreateResponse{OrderId: 1}, nil)
+				repoMock.CheckoutItemsMock.
+					Expect(minimock.AnyContext, 456, domain.DefaultList, map[int64]uint16{123: 1, 789: 4}, 1).
+					Return(nil)
+			},
+			expectedID: 1,
+		},
+		{
+			name:        "More than in the cart - error",
+			lines:       []domain.CheckoutLine{{SkuID: 123, Count: 3}},
+			expectedErr: localErr.ErrInvalidArgument,
+		},
+		{
+			name:        "Sku not in the cart - error",
+			lines:       []domain.CheckoutLine{{SkuID: 1011}},
+			expectedErr: domain.ErrItemNotInList,
+		},
+		{
+			name:        "No items - error",
+			expectedErr: localErr.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			repoMock := mock.NewCartRepositoryMock(mc)
+			productMock := mock.NewProductServiceMock(mc)
+			lomsMock := mock.NewLomsClientMock(mc)
+			service := NewCartService(repoMock, productMock, lomsMock)
+
+			if len(tt.lines) > 0 {
+				repoMock.GetVersionMock.Return(3, nil)
+				repoMock.GetCartMock.Return(map[int64]uint16{123: 2, 789: 4}, nil)
+				productMock.GetProductMock.Return(&domain.ProductServiceResponse{Name: "Test Product", Price: 100}, nil)
+			}
+			if tt.prepareMocks != nil {
+				tt.prepareMocks(repoMock, lomsMock)
+			}
+
+			orderID, err := service.CheckoutItems(context.Background(), 456, tt.lines)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
 			assert.Equal(t, tt.expectedID, orderID)
 		})
 	}
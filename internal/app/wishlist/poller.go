@@ -0,0 +1,103 @@
+package wishlist
+
+import (
+	"context"
+	"github.com/vestamart/cart/internal/domain"
+	"github.com/vestamart/loms/pkg/api/loms/v1"
+	"google.golang.org/grpc"
+	"log/slog"
+	"time"
+)
+
+type Store interface {
+	WishlistedSkus(ctx context.Context) ([]int64, error)
+	MarkAvailability(ctx context.Context, skuID int64, inStock bool) ([]uint64, error)
+}
+
+type StockService interface {
+	StocksInfo(ctx context.Context, in *loms.StocksInfoRequest, opts ...grpc.CallOption) (*loms.StocksInfoResponse, error)
+}
+
+// Notifier tells users that a wishlisted sku is back in stock.
+type Notifier interface {
+	NotifyBackInStock(ctx context.Context, event domain.BackInStock)
+}
+
+// Poller checks the stock of every wishlisted sku. A sku is back in stock
+// when its stock rises to threshold or above; each waiting user is notified
+// once per such crossing.
+type Poller struct {
+	store     Store
+	stocks    StockService
+	threshold uint64
+	interval  time.Duration
+	notifiers []Notifier
+	now       func() time.Time
+}
+
+func NewPoller(store Store, stocks StockService, threshold uint64, interval time.Duration) *Poller {
+	return &Poller{
+		store:     store,
+		stocks:    stocks,
+		threshold: threshold,
+		interval:  interval,
+		now:       time.Now,
+	}
+}
+
+// AddNotifier registers n. It must be called before Run.
+func (p *Poller) AddNotifier(n Notifier) {
+	p.notifiers = append(p.notifiers, n)
+}
+
+func (p *Poller) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := p.Poll(ctx); err != nil {
+				slog.Error("wishlist: poll", "err", err)
+			}
+		}
+	}
+}
+
+func (p *Poller) Poll(ctx context.Context) error {
+	skus, err := p.store.WishlistedSkus(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, skuID := range skus {
+		v, err := p.stocks.StocksInfo(ctx, &loms.StocksInfoRequest{Sku: uint32(skuID)})
+		if err != nil {
+			// The sku keeps its state and is checked again next time.
+			slog.Warn("wishlist: stocks of sku", "sku", skuID, "err", err)
+			continue
+		}
+
+		users, err := p.store.MarkAvailability(ctx, skuID, v.Count >= p.threshold)
+		if err != nil {
+			return err
+		}
+		for _, userID := range users {
+			event := domain.BackInStock{UserID: userID, SkuID: skuID, Available: v.Count, Time: p.now()}
+			for _, n := range p.notifiers {
+				n.NotifyBackInStock(ctx, event)
+			}
+		}
+	}
+
+	return nil
+}
+
+// LogNotifier logs back in stock events.
+type LogNotifier struct{}
+
+func (LogNotifier) NotifyBackInStock(_ context.Context, event domain.BackInStock) {
+	slog.Info("wishlist: sku is back in stock", "sku", event.SkuID, "user", event.UserID, "available", event.Available)
+}
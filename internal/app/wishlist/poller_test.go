@@ -0,0 +1,57 @@
+package wishlist
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/cart/internal/domain"
+	"github.com/vestamart/cart/internal/repository"
+	"github.com/vestamart/loms/pkg/api/loms/v1"
+	"google.golang.org/grpc"
+)
+
+type fakeStocks map[int64]uint64
+
+func (f fakeStocks) StocksInfo(_ context.Context, in *loms.StocksInfoRequest, _ ...grpc.CallOption) (*loms.StocksInfoResponse, error) {
+	return &loms.StocksInfoResponse{Count: f[int64(in.Sku)]}, nil
+}
+
+type recorder []domain.BackInStock
+
+func (r *recorder) NotifyBackInStock(_ context.Context, event domain.BackInStock) {
+	*r = append(*r, event)
+}
+
+func TestPoller_NotifiesOncePerCrossing(t *testing.T) {
+	ctx := context.Background()
+	store := repository.NewWishlistRepository(10)
+	_, err := store.AddToWishlist(ctx, 1, 100)
+	require.NoError(t, err)
+	_, err = store.AddToWishlist(ctx, 2, 100)
+	require.NoError(t, err)
+
+	stocks := fakeStocks{100: 1}
+	poller := NewPoller(store, stocks, 3, time.Minute)
+	var events recorder
+	poller.AddNotifier(&events)
+
+	require.NoError(t, poller.Poll(ctx))
+	assert.Empty(t, events, "below the threshold")
+
+	stocks[100] = 5
+	require.NoError(t, poller.Poll(ctx))
+	require.NoError(t, poller.Poll(ctx))
+	require.Len(t, events, 2, "each user is notified once")
+	assert.Equal(t, uint64(1), events[0].UserID)
+	assert.Equal(t, uint64(5), events[0].Available)
+
+	// Sold out and restocked: a new crossing.
+	stocks[100] = 0
+	require.NoError(t, poller.Poll(ctx))
+	stocks[100] = 3
+	require.NoError(t, poller.Poll(ctx))
+	assert.Len(t, events, 4)
+}
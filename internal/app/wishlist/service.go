@@ -0,0 +1,46 @@
+package wishlist
+
+import (
+	"context"
+	"github.com/vestamart/cart/internal/domain"
+	"github.com/vestamart/cart/internal/localErr"
+)
+
+type Repository interface {
+	AddToWishlist(ctx context.Context, userID uint64, skuID int64) (domain.WishlistItem, error)
+	RemoveFromWishlist(ctx context.Context, userID uint64, skuID int64) error
+	GetWishlist(ctx context.Context, userID uint64) ([]domain.WishlistItem, error)
+}
+
+type ProductService interface {
+	ExistItem(ctx context.Context, sku int64) error
+}
+
+type Service struct {
+	repository     Repository
+	productService ProductService
+}
+
+func NewService(repository Repository, productService ProductService) *Service {
+	return &Service{repository: repository, productService: productService}
+}
+
+// Add puts skuID on the wishlist of the user. Unlike the cart, it does not
+// check the stock: waiting for out of stock skus is the point.
+func (s *Service) Add(ctx context.Context, userID uint64, skuID int64) (domain.WishlistItem, error) {
+	if skuID < 1 || userID < 1 {
+		return domain.WishlistItem{}, localErr.ErrInvalidArgument.WithMsg("skuID or userID must be greater than 0")
+	}
+	if err := s.productService.ExistItem(ctx, skuID); err != nil {
+		return domain.WishlistItem{}, err
+	}
+	return s.repository.AddToWishlist(ctx, userID, skuID)
+}
+
+func (s *Service) Remove(ctx context.Context, userID uint64, skuID int64) error {
+	return s.repository.RemoveFromWishlist(ctx, userID, skuID)
+}
+
+func (s *Service) List(ctx context.Context, userID uint64) ([]domain.WishlistItem, error) {
+	return s.repository.GetWishlist(ctx, userID)
+}
@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"context"
+	"strconv"
+)
+
+type principalKey struct{}
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	Subject string
+	Admin   bool
+}
+
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+func FromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
+
+// AuthorizeUser allows the request to act on userID's data if the token
+// subject is that user or the caller is an admin. Requests without a
+// principal pass, which is the case when authentication is disabled.
+func AuthorizeUser(ctx context.Context, userID uint64) error {
+	p, ok := FromContext(ctx)
+	if !ok || p.Admin {
+		return nil
+	}
+	if p.Subject != strconv.FormatUint(userID, 10) {
+		return ErrForbidden.WithMsg("token subject does not match user %d", userID)
+	}
+	return nil
+}
+
+// AuthorizeAdmin allows only admins. Unlike AuthorizeUser it fails closed:
+// without a principal, that is with authentication disabled, nobody is an
+// admin.
+func AuthorizeAdmin(ctx context.Context) error {
+	p, ok := FromContext(ctx)
+	if !ok {
+		return ErrForbidden.WithMsg("admin routes require auth.enabled")
+	}
+	if !p.Admin {
+		return ErrForbidden.WithMsg("admin scope required")
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/vestamart/cart/internal/localErr"
+	"strings"
+	"time"
+)
+
+var ErrMissingToken = localErr.New(localErr.KindUnauthenticated, "missing_token", "missing bearer token")
+var ErrInvalidToken = localErr.New(localErr.KindUnauthenticated, "invalid_token", "invalid token")
+var ErrTokenExpired = localErr.New(localErr.KindUnauthenticated, "token_expired", "token expired")
+var ErrForbidden = localErr.New(localErr.KindPermissionDenied, "forbidden", "access denied")
+
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// Claims are the registered claims cart relies on plus the granted scopes.
+type Claims struct {
+	Subject   string
+	Issuer    string
+	Audience  []string
+	ExpiresAt time.Time
+	NotBefore time.Time
+	Scopes    []string
+}
+
+func (c *Claims) HasScope(scope string) bool {
+	for _, s := range c.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+type rawClaims struct {
+	Sub   string          `json:"sub"`
+	Iss   string          `json:"iss"`
+	Aud   json.RawMessage `json:"aud"`
+	Exp   *json.Number    `json:"exp"`
+	Nbf   *json.Number    `json:"nbf"`
+	Scope string          `json:"scope"`
+	Scp   []string        `json:"scp"`
+}
+
+// Verifier checks JWT signatures and registered claims locally, without
+// calling an identity provider.
+type Verifier struct {
+	keys     Keys
+	issuer   string
+	audience string
+	leeway   time.Duration
+	now      func() time.Time
+}
+
+func NewVerifier(keys Keys, issuer, audience string, leeway time.Duration) *Verifier {
+	return &Verifier{keys: keys, issuer: issuer, audience: audience, leeway: leeway, now: time.Now}
+}
+
+func (v *Verifier) Verify(token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken.WithMsg("malformed token")
+	}
+
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return nil, ErrInvalidToken.WithMsg("header").WithCause(err)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrInvalidToken.WithMsg("signature encoding").WithCause(err)
+	}
+	if err = v.verifySignature(h, parts[0]+"."+parts[1], sig); err != nil {
+		return nil, err
+	}
+
+	var raw rawClaims
+	if err = decodeSegment(parts[1], &raw); err != nil {
+		return nil, ErrInvalidToken.WithMsg("claims").WithCause(err)
+	}
+	claims, err := parseClaims(raw)
+	if err != nil {
+		return nil, ErrInvalidToken.WithMsg("claims").WithCause(err)
+	}
+
+	return claims, v.checkClaims(claims)
+}
+
+func (v *Verifier) verifySignature(h header, signingInput string, sig []byte) error {
+	switch h.Alg {
+	case "HS256":
+		for _, secret := range v.keys.HMAC(h.Kid) {
+			mac := hmac.New(sha256.New, secret)
+			mac.Write([]byte(signingInput))
+			if hmac.Equal(mac.Sum(nil), sig) {
+				return nil
+			}
+		}
+	case "RS256":
+		digest := sha256.Sum256([]byte(signingInput))
+		for _, pub := range v.keys.RSA(h.Kid) {
+			if rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], sig) == nil {
+				return nil
+			}
+		}
+	default:
+		return ErrInvalidToken.WithMsg("unsupported alg %q", h.Alg)
+	}
+
+	return ErrInvalidToken.WithMsg("signature mismatch")
+}
+
+func (v *Verifier) checkClaims(c *Claims) error {
+	now := v.now()
+	if c.Subject == "" {
+		return ErrInvalidToken.WithMsg("missing sub")
+	}
+	if c.ExpiresAt.IsZero() {
+		return ErrInvalidToken.WithMsg("missing exp")
+	}
+	if now.After(c.ExpiresAt.Add(v.leeway)) {
+		return ErrTokenExpired
+	}
+	if !c.NotBefore.IsZero() && now.Add(v.leeway).Before(c.NotBefore) {
+		return ErrInvalidToken.WithMsg("token not valid yet")
+	}
+	if v.issuer != "" && c.Issuer != v.issuer {
+		return ErrInvalidToken.WithMsg("unexpected issuer %q", c.Issuer)
+	}
+	if v.audience != "" && !contains(c.Audience, v.audience) {
+		return ErrInvalidToken.WithMsg("token is not issued for %q", v.audience)
+	}
+	return nil
+}
+
+func parseClaims(raw rawClaims) (*Claims, error) {
+	c := &Claims{Subject: raw.Sub, Issuer: raw.Iss, Scopes: raw.Scp}
+	if raw.Scope != "" {
+		c.Scopes = append(c.Scopes, strings.Fields(raw.Scope)...)
+	}
+
+	if len(raw.Aud) > 0 {
+		var single string
+		if err := json.Unmarshal(raw.Aud, &single); err == nil {
+			c.Audience = []string{single}
+		} else if err = json.Unmarshal(raw.Aud, &c.Audience); err != nil {
+			return nil, fmt.Errorf("aud: %w", err)
+		}
+	}
+
+	var err error
+	if c.ExpiresAt, err = numericDate(raw.Exp); err != nil {
+		return nil, fmt.Errorf("exp: %w", err)
+	}
+	if c.NotBefore, err = numericDate(raw.Nbf); err != nil {
+		return nil, fmt.Errorf("nbf: %w", err)
+	}
+
+	return c, nil
+}
+
+func numericDate(n *json.Number) (time.Time, error) {
+	if n == nil {
+		return time.Time{}, nil
+	}
+	f, err := n.Float64()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(int64(f), 0), nil
+}
+
+func decodeSegment(seg string, dst any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(strings.NewReader(string(raw)))
+	dec.UseNumber()
+	return dec.Decode(dst)
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
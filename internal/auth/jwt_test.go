@@ -0,0 +1,159 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeSegment(t *testing.T, v any) string {
+	t.Helper()
+	raw, err := json.Marshal(v)
+	require.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func signHS256(t *testing.T, secret []byte, claims map[string]any) string {
+	t.Helper()
+	input := encodeSegment(t, map[string]string{"alg": "HS256", "typ": "JWT"}) + "." + encodeSegment(t, claims)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(input))
+	return input + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, claims map[string]any) string {
+	t.Helper()
+	input := encodeSegment(t, map[string]string{"alg": "RS256", "typ": "JWT"}) + "." + encodeSegment(t, claims)
+	digest := sha256.Sum256([]byte(input))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+	return input + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	secret := []byte("test-secret")
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	keys := NewKeys()
+	keys.AddHMAC("", secret)
+	keys.AddRSA("", &rsaKey.PublicKey)
+
+	now := time.Unix(1_700_000_000, 0)
+	verifier := NewVerifier(keys, "idp", "cart", 30*time.Second)
+	verifier.now = func() time.Time { return now }
+
+	valid := func() map[string]any {
+		return map[string]any{
+			"sub":   "42",
+			"iss":   "idp",
+			"aud":   []string{"cart", "loms"},
+			"exp":   now.Add(time.Minute).Unix(),
+			"scope": "cart:read cart:admin",
+		}
+	}
+	with := func(key string, value any) map[string]any {
+		c := valid()
+		if value == nil {
+			delete(c, key)
+		} else {
+			c[key] = value
+		}
+		return c
+	}
+
+	tests := []struct {
+		name        string
+		token       string
+		expectedErr error
+	}{
+		{"HS256 valid", signHS256(t, secret, valid()), nil},
+		{"RS256 valid", signRS256(t, rsaKey, valid()), nil},
+		{"Single audience string", signHS256(t, secret, with("aud", "cart")), nil},
+		{"Expired within leeway", signHS256(t, secret, with("exp", now.Add(-10*time.Second).Unix())), nil},
+		{"Expired", signHS256(t, secret, with("exp", now.Add(-time.Minute).Unix())), ErrTokenExpired},
+		{"Not valid yet", signHS256(t, secret, with("nbf", now.Add(time.Minute).Unix())), ErrInvalidToken},
+		{"Wrong secret", signHS256(t, []byte("other"), valid()), ErrInvalidToken},
+		{"Wrong RSA key", signRS256(t, otherKey, valid()), ErrInvalidToken},
+		{"Wrong issuer", signHS256(t, secret, with("iss", "evil")), ErrInvalidToken},
+		{"Wrong audience", signHS256(t, secret, with("aud", "loms")), ErrInvalidToken},
+		{"Missing subject", signHS256(t, secret, with("sub", nil)), ErrInvalidToken},
+		{"Missing expiry", signHS256(t, secret, with("exp", nil)), ErrInvalidToken},
+		{"Malformed", "not-a-token", ErrInvalidToken},
+		{"alg none", encodeSegment(t, map[string]string{"alg": "none"}) + "." + encodeSegment(t, valid()) + ".", ErrInvalidToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := verifier.Verify(tt.token)
+			if tt.expectedErr != nil {
+				assert.True(t, errors.Is(err, tt.expectedErr), "got %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "42", claims.Subject)
+			assert.True(t, claims.HasScope("cart:admin"))
+		})
+	}
+}
+
+func TestAuthorizeUser(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		userID      uint64
+		expectedErr error
+	}{
+		{"No principal - auth disabled", context.Background(), 42, nil},
+		{"Own cart", WithPrincipal(context.Background(), Principal{Subject: "42"}), 42, nil},
+		{"Foreign cart", WithPrincipal(context.Background(), Principal{Subject: "42"}), 7, ErrForbidden},
+		{"Admin on foreign cart", WithPrincipal(context.Background(), Principal{Subject: "1", Admin: true}), 7, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := AuthorizeUser(tt.ctx, tt.userID)
+			if tt.expectedErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestAuthorizeAdmin(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		expectedErr error
+	}{
+		{"No principal - auth disabled", context.Background(), ErrForbidden},
+		{"User", WithPrincipal(context.Background(), Principal{Subject: "42"}), ErrForbidden},
+		{"Admin", WithPrincipal(context.Background(), Principal{Subject: "1", Admin: true}), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := AuthorizeAdmin(tt.ctx)
+			if tt.expectedErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
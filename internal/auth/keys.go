@@ -0,0 +1,148 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+)
+
+// Keys holds the verification keys, optionally indexed by key id.
+type Keys struct {
+	hmac map[string][][]byte
+	rsa  map[string][]*rsa.PublicKey
+}
+
+func NewKeys() Keys {
+	return Keys{hmac: make(map[string][][]byte), rsa: make(map[string][]*rsa.PublicKey)}
+}
+
+func (k Keys) AddHMAC(kid string, secret []byte) {
+	k.hmac[kid] = append(k.hmac[kid], secret)
+}
+
+func (k Keys) AddRSA(kid string, key *rsa.PublicKey) {
+	k.rsa[kid] = append(k.rsa[kid], key)
+}
+
+func (k Keys) Empty() bool {
+	return len(k.hmac) == 0 && len(k.rsa) == 0
+}
+
+// HMAC returns the secrets for kid. Tokens without kid are tried against
+// every secret.
+func (k Keys) HMAC(kid string) [][]byte {
+	if kid != "" {
+		return k.hmac[kid]
+	}
+	var all [][]byte
+	for _, secrets := range k.hmac {
+		all = append(all, secrets...)
+	}
+	return all
+}
+
+// RSA returns the public keys for kid. Tokens without kid are tried against
+// every key.
+func (k Keys) RSA(kid string) []*rsa.PublicKey {
+	if kid != "" {
+		return k.rsa[kid]
+	}
+	var all []*rsa.PublicKey
+	for _, keys := range k.rsa {
+		all = append(all, keys...)
+	}
+	return all
+}
+
+// LoadRSAPublicKey reads a PEM encoded PKIX or PKCS#1 RSA public key.
+func LoadRSAPublicKey(path string) (*rsa.PublicKey, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, fmt.Errorf("%s: no PEM block", path)
+	}
+
+	if key, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	key, ok := parsed.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%s: not an RSA public key", path)
+	}
+	return key, nil
+}
+
+type jwks struct {
+	Keys []struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		Use string `json:"use"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+		K   string `json:"k"`
+	} `json:"keys"`
+}
+
+// LoadJWKS adds the RSA and symmetric ("oct") signing keys of a local JWKS
+// file to keys.
+func LoadJWKS(path string, keys Keys) error {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var set jwks
+	if err = json.Unmarshal(raw, &set); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	for i, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		switch k.Kty {
+		case "RSA":
+			pub, err := rsaFromJWK(k.N, k.E)
+			if err != nil {
+				return fmt.Errorf("%s: key %d: %w", path, i, err)
+			}
+			keys.AddRSA(k.Kid, pub)
+		case "oct":
+			secret, err := base64.RawURLEncoding.DecodeString(k.K)
+			if err != nil {
+				return fmt.Errorf("%s: key %d: %w", path, i, err)
+			}
+			keys.AddHMAC(k.Kid, secret)
+		}
+	}
+
+	return nil
+}
+
+func rsaFromJWK(n, e string) (*rsa.PublicKey, error) {
+	nb, err := base64.RawURLEncoding.DecodeString(n)
+	if err != nil {
+		return nil, fmt.Errorf("n: %w", err)
+	}
+	eb, err := base64.RawURLEncoding.DecodeString(e)
+	if err != nil {
+		return nil, fmt.Errorf("e: %w", err)
+	}
+	exp := new(big.Int).SetBytes(eb)
+	if !exp.IsInt64() || exp.Int64() > 1<<31-1 {
+		return nil, errors.New("e: too large")
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(nb), E: int(exp.Int64())}, nil
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"github.com/vestamart/cart/internal/domain"
 	"github.com/vestamart/cart/internal/localErr"
+	"golang.org/x/time/rate"
 	"net/http"
 )
 
@@ -15,14 +16,32 @@ type Client struct {
 	httpClient *http.Client
 	url        string
 	token      string
+	limiter    *rate.Limiter
+	deadlines  *Deadlines
 }
 
-func NewClient(url, token string) *Client {
-	return &Client{
+// NewClient creates a product service client limited to rps requests per
+// second. Zero rps disables the limit. Every call is bounded by its budget
+// from deadlines.
+func NewClient(url, token string, rps int, deadlines *Deadlines) *Client {
+	c := &Client{
 		httpClient: &http.Client{},
 		url:        url,
 		token:      token,
+		limiter:    rate.NewLimiter(rate.Inf, 0),
+		deadlines:  deadlines,
 	}
+	c.SetRPS(rps)
+	return c
+}
+
+func (c *Client) SetRPS(rps int) {
+	if rps <= 0 {
+		c.limiter.SetLimit(rate.Inf)
+		return
+	}
+	c.limiter.SetBurst(rps)
+	c.limiter.SetLimit(rate.Limit(rps))
 }
 
 type request struct {
@@ -31,10 +50,25 @@ type request struct {
 }
 
 func (c *Client) ExistItem(ctx context.Context, sku int64) error {
-	jsonBody, err := json.Marshal(request{Token: c.token, SKU: sku})
+	t := c.deadlines.Get()
+	ctx, cancel, err := withBudget(ctx, t.ExistItem, t.MinRemaining)
 	if err != nil {
 		return err
 	}
+	defer cancel()
+
+	return deadlineErr("ExistItem", c.existItem(ctx, sku))
+}
+
+func (c *Client) existItem(ctx context.Context, sku int64) error {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return localErr.ErrDeadlineExceeded.WithMsg("product rate limit wait").WithCause(err)
+	}
+
+	jsonBody, err := json.Marshal(request{Token: c.token, SKU: sku})
+	if err != nil {
+		return localErr.Wrap(err, localErr.KindInternal, "exist item: marshal request")
+	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewBuffer(jsonBody))
 	if err != nil {
@@ -45,24 +79,40 @@ func (c *Client) ExistItem(ctx context.Context, sku int64) error {
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return err
+		return transportErr(err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusNotFound {
 		return localErr.ErrSkuNotExist
 	} else if resp.StatusCode != http.StatusOK {
-		return errors.New("error exist item")
+		return localErr.Errorf(localErr.KindDependency, "exist item: product service status %d", resp.StatusCode)
 	}
 
 	return nil
 }
 
 func (c *Client) GetProduct(ctx context.Context, sku int64) (*domain.ProductServiceResponse, error) {
-	jsonBody, err := json.Marshal(request{Token: c.token, SKU: sku})
+	t := c.deadlines.Get()
+	ctx, cancel, err := withBudget(ctx, t.GetProduct, t.MinRemaining)
 	if err != nil {
 		return nil, err
 	}
+	defer cancel()
+
+	resp, err := c.getProduct(ctx, sku)
+	return resp, deadlineErr("GetProduct", err)
+}
+
+func (c *Client) getProduct(ctx context.Context, sku int64) (*domain.ProductServiceResponse, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, localErr.ErrDeadlineExceeded.WithMsg("product rate limit wait").WithCause(err)
+	}
+
+	jsonBody, err := json.Marshal(request{Token: c.token, SKU: sku})
+	if err != nil {
+		return nil, localErr.Wrap(err, localErr.KindInternal, "get product: marshal request")
+	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewBuffer(jsonBody))
 	if err != nil {
@@ -73,17 +123,49 @@ func (c *Client) GetProduct(ctx context.Context, sku int64) (*domain.ProductServ
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, transportErr(err)
 	}
 	defer resp.Body.Close()
-	fmt.Println(resp.StatusCode)
-	if resp.StatusCode != http.StatusOK {
-		return nil, errors.New("error get product")
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, localErr.ErrSkuNotExist
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, localErr.Errorf(localErr.KindDependency, "get product: product service status %d", resp.StatusCode)
 	}
 
 	var clientResponse domain.ProductServiceResponse
 	if err := json.NewDecoder(resp.Body).Decode(&clientResponse); err != nil {
-		return nil, errors.New("failed parsing request body")
+		return nil, localErr.Wrap(err, localErr.KindDependency, "get product: failed parsing response body")
 	}
 	return &clientResponse, nil
 }
+
+// Ping is a cheap reachability probe: any answer below 500 means the product
+// service is up, even if it rejects the GET itself.
+func (c *Client) Ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return transportErr(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("product service status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// transportErr classifies a failed HTTP round trip. Deadlines are left as is
+// for deadlineErr.
+func transportErr(err error) error {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return err
+	}
+	return localErr.Wrap(err, localErr.KindUnavailable, "product service unavailable")
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"github.com/vestamart/cart/internal/localErr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sync/atomic"
+	"time"
+)
+
+// Timeouts are the per-operation budgets for calls to the product service
+// and LOMS. A call is skipped when the caller's context has less than
+// MinRemaining left.
+type Timeouts struct {
+	ExistItem    time.Duration
+	GetProduct   time.Duration
+	StocksInfo   time.Duration
+	OrderCreate  time.Duration
+	MinRemaining time.Duration
+}
+
+// Deadlines holds the current timeouts so that they can be swapped on
+// config reload.
+type Deadlines struct {
+	timeouts atomic.Pointer[Timeouts]
+}
+
+func NewDeadlines(t Timeouts) *Deadlines {
+	d := &Deadlines{}
+	d.Set(t)
+	return d
+}
+
+func (d *Deadlines) Set(t Timeouts) {
+	d.timeouts.Store(&t)
+}
+
+func (d *Deadlines) Get() Timeouts {
+	return *d.timeouts.Load()
+}
+
+func withBudget(ctx context.Context, budget, minRemaining time.Duration) (context.Context, context.CancelFunc, error) {
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := time.Until(deadline); remaining < minRemaining {
+			return nil, nil, localErr.ErrDeadlineExceeded.WithMsg("call skipped, %v left", remaining)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, budget)
+	return ctx, cancel, nil
+}
+
+func deadlineErr(op string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, context.DeadlineExceeded) || status.Code(err) == codes.DeadlineExceeded {
+		return localErr.ErrDeadlineExceeded.WithMsg("%s", op).WithCause(err)
+	}
+	return err
+}
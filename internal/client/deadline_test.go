@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vestamart/cart/internal/localErr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWithBudget(t *testing.T) {
+	tests := []struct {
+		name         string
+		parent       time.Duration
+		budget       time.Duration
+		minRemaining time.Duration
+		expectedErr  error
+	}{
+		{
+			name:         "No parent deadline - budget applied",
+			budget:       time.Second,
+			minRemaining: 50 * time.Millisecond,
+		},
+		{
+			name:         "Enough time left - budget applied",
+			parent:       time.Minute,
+			budget:       time.Second,
+			minRemaining: 50 * time.Millisecond,
+		},
+		{
+			name:         "Too little time left - call skipped",
+			parent:       10 * time.Millisecond,
+			budget:       time.Second,
+			minRemaining: 50 * time.Millisecond,
+			expectedErr:  localErr.ErrDeadlineExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.parent > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.parent)
+				defer cancel()
+			}
+
+			callCtx, cancel, err := withBudget(ctx, tt.budget, tt.minRemaining)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			defer cancel()
+
+			assert.NoError(t, err)
+			deadline, ok := callCtx.Deadline()
+			assert.True(t, ok)
+			assert.LessOrEqual(t, time.Until(deadline), tt.budget)
+		})
+	}
+}
+
+func TestDeadlineErr(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		isTimeout bool
+	}{
+		{name: "Context deadline", err: context.DeadlineExceeded, isTimeout: true},
+		{name: "gRPC deadline", err: status.Error(codes.DeadlineExceeded, "slow"), isTimeout: true},
+		{name: "Other error", err: errors.New("boom"), isTimeout: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := deadlineErr("op", tt.err)
+			assert.Equal(t, tt.isTimeout, errors.Is(err, localErr.ErrDeadlineExceeded))
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"github.com/vestamart/cart/internal/localErr"
+	"github.com/vestamart/loms/pkg/api/loms/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LOMSClient applies the per-operation deadlines to the LOMS calls made by
+// cart. Other methods are passed through unchanged.
+type LOMSClient struct {
+	loms.LomsClient
+	deadlines *Deadlines
+}
+
+func NewLOMSClient(client loms.LomsClient, deadlines *Deadlines) *LOMSClient {
+	return &LOMSClient{LomsClient: client, deadlines: deadlines}
+}
+
+func (c *LOMSClient) StocksInfo(ctx context.Context, in *loms.StocksInfoRequest, opts ...grpc.CallOption) (*loms.StocksInfoResponse, error) {
+	t := c.deadlines.Get()
+	ctx, cancel, err := withBudget(ctx, t.StocksInfo, t.MinRemaining)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	resp, err := c.LomsClient.StocksInfo(ctx, in, opts...)
+	return resp, lomsErr("StocksInfo", err)
+}
+
+func (c *LOMSClient) OrderCreate(ctx context.Context, in *loms.OrderCreateRequest, opts ...grpc.CallOption) (*loms.OrderCreateResponse, error) {
+	t := c.deadlines.Get()
+	ctx, cancel, err := withBudget(ctx, t.OrderCreate, t.MinRemaining)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	resp, err := c.LomsClient.OrderCreate(ctx, in, opts...)
+	return resp, lomsErr("OrderCreate", err)
+}
+
+// lomsErr translates a LOMS status into a domain error. LOMS answers
+// NotFound for unknown SKUs and ResourceExhausted when stock can't be
+// reserved.
+func lomsErr(op string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return deadlineErr(op, err)
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		return localErr.ErrSkuNotExist.WithCause(err)
+	case codes.ResourceExhausted, codes.FailedPrecondition:
+		return localErr.ItemNotEnoughErr.WithCause(err)
+	case codes.DeadlineExceeded:
+		return deadlineErr(op, err)
+	}
+
+	return localErr.Wrap(err, localErr.KindFromGRPC(st.Code()), "loms "+op)
+}
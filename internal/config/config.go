@@ -1,41 +1,467 @@
 package config
 
 import (
+	"errors"
+	"fmt"
+	"github.com/vestamart/cart/internal/logger"
 	"gopkg.in/yaml.v3"
+	"net"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
+// Fields tagged `reload:"true"` are applied on hot reload; changing any other
+// field requires a restart.
 type ClientConfig struct {
-	URL   string `yaml:"url"`
-	Token string `yaml:"token"`
+	URL       string `yaml:"url" env:"CART_PRODUCT_CLIENT_URL"`
+	Token     string `yaml:"token" env:"CART_PRODUCT_CLIENT_TOKEN" secret:"true"`
+	TokenFile string `yaml:"token_file" env:"CART_PRODUCT_CLIENT_TOKEN_FILE"`
+	RPS       int    `yaml:"rps" env:"CART_PRODUCT_CLIENT_RPS" reload:"true"`
 }
-type gRPCServerConfig struct {
-	Port string `yaml:"gRPCport"`
+
+type gRPCClientConfig struct {
+	Address string `yaml:"address" env:"CART_LOMS_ADDRESS"`
 }
 
 type HTTPServerConfig struct {
-	Port string `yaml:"port"`
+	Port string `yaml:"port" env:"CART_SERVER_PORT"`
+}
+
+type gRPCServerConfig struct {
+	Port string `yaml:"port" env:"CART_GRPC_SERVER_PORT"`
+}
+
+type TimeoutsConfig struct {
+	ExistItem    time.Duration `yaml:"exist_item" env:"CART_TIMEOUTS_EXIST_ITEM"`
+	GetProduct   time.Duration `yaml:"get_product" env:"CART_TIMEOUTS_GET_PRODUCT"`
+	StocksInfo   time.Duration `yaml:"stocks_info" env:"CART_TIMEOUTS_STOCKS_INFO"`
+	OrderCreate  time.Duration `yaml:"order_create" env:"CART_TIMEOUTS_ORDER_CREATE"`
+	MinRemaining time.Duration `yaml:"min_remaining" env:"CART_TIMEOUTS_MIN_REMAINING"`
+}
+
+type AuthConfig struct {
+	Enabled            bool          `yaml:"enabled" env:"CART_AUTH_ENABLED"`
+	HS256Secret        string        `yaml:"hs256_secret" env:"CART_AUTH_HS256_SECRET" secret:"true"`
+	HS256SecretFile    string        `yaml:"hs256_secret_file" env:"CART_AUTH_HS256_SECRET_FILE"`
+	RS256PublicKeyFile string        `yaml:"rs256_public_key_file" env:"CART_AUTH_RS256_PUBLIC_KEY_FILE"`
+	JWKSFile           string        `yaml:"jwks_file" env:"CART_AUTH_JWKS_FILE"`
+	Issuer             string        `yaml:"issuer" env:"CART_AUTH_ISSUER"`
+	Audience           string        `yaml:"audience" env:"CART_AUTH_AUDIENCE"`
+	AdminScope         string        `yaml:"admin_scope" env:"CART_AUTH_ADMIN_SCOPE"`
+	Leeway             time.Duration `yaml:"leeway" env:"CART_AUTH_LEEWAY"`
+}
+
+// RateLimitRule is the limit of a route class. Its env names are prefixed by
+// the class, as in CART_RATE_LIMIT_READ_RPS.
+type RateLimitRule struct {
+	RPS   float64 `yaml:"rps" env:"RPS"`
+	Burst int     `yaml:"burst" env:"BURST"`
+}
+
+// RateLimitConfig limits HTTP requests per caller and route class. A rule
+// with zero rps is not limited. Only the rules and the switch are reloaded;
+// the bucket settings need a restart.
+type RateLimitConfig struct {
+	Enabled        bool          `yaml:"enabled" env:"CART_RATE_LIMIT_ENABLED" reload:"true"`
+	TrustForwarded bool          `yaml:"trust_forwarded" env:"CART_RATE_LIMIT_TRUST_FORWARDED"`
+	IdleTTL        time.Duration `yaml:"idle_ttl" env:"CART_RATE_LIMIT_IDLE_TTL"`
+	MaxVisitors    int           `yaml:"max_visitors" env:"CART_RATE_LIMIT_MAX_VISITORS"`
+	Read           RateLimitRule `yaml:"read" env:"CART_RATE_LIMIT_READ" reload:"true"`
+	Write          RateLimitRule `yaml:"write" env:"CART_RATE_LIMIT_WRITE" reload:"true"`
+	Checkout       RateLimitRule `yaml:"checkout" env:"CART_RATE_LIMIT_CHECKOUT" reload:"true"`
+}
+
+// EventsConfig tunes the cart event stream.
+type EventsConfig struct {
+	BufferSize     int           `yaml:"buffer_size" env:"CART_EVENTS_BUFFER_SIZE"`
+	MaxSubscribers int           `yaml:"max_subscribers" env:"CART_EVENTS_MAX_SUBSCRIBERS"`
+	Heartbeat      time.Duration `yaml:"heartbeat" env:"CART_EVENTS_HEARTBEAT"`
+	Retention      time.Duration `yaml:"retention" env:"CART_EVENTS_RETENTION"`
+}
+
+// OutboxConfig controls delivery of cart events. Sink is "none" or "jsonl";
+// outbox.KafkaSink is wired in code, see its doc.
+type OutboxConfig struct {
+	Sink           string        `yaml:"sink" env:"CART_OUTBOX_SINK"`
+	Path           string        `yaml:"path" env:"CART_OUTBOX_PATH"`
+	DeadLetterPath string        `yaml:"dead_letter_path" env:"CART_OUTBOX_DEAD_LETTER_PATH"`
+	BatchSize      int           `yaml:"batch_size" env:"CART_OUTBOX_BATCH_SIZE"`
+	Interval       time.Duration `yaml:"interval" env:"CART_OUTBOX_INTERVAL"`
+	MaxAttempts    int           `yaml:"max_attempts" env:"CART_OUTBOX_MAX_ATTEMPTS"`
+	Backoff        time.Duration `yaml:"backoff" env:"CART_OUTBOX_BACKOFF"`
+	MaxBackoff     time.Duration `yaml:"max_backoff" env:"CART_OUTBOX_MAX_BACKOFF"`
+}
+
+// WebhooksConfig tunes delivery of partner webhooks.
+type WebhooksConfig struct {
+	Timeout          time.Duration `yaml:"timeout" env:"CART_WEBHOOKS_TIMEOUT"`
+	MaxAttempts      int           `yaml:"max_attempts" env:"CART_WEBHOOKS_MAX_ATTEMPTS"`
+	Backoff          time.Duration `yaml:"backoff" env:"CART_WEBHOOKS_BACKOFF"`
+	MaxBackoff       time.Duration `yaml:"max_backoff" env:"CART_WEBHOOKS_MAX_BACKOFF"`
+	BreakerThreshold int           `yaml:"breaker_threshold" env:"CART_WEBHOOKS_BREAKER_THRESHOLD"`
+	BreakerCooldown  time.Duration `yaml:"breaker_cooldown" env:"CART_WEBHOOKS_BREAKER_COOLDOWN"`
+	QueueSize        int           `yaml:"queue_size" env:"CART_WEBHOOKS_QUEUE_SIZE"`
+	Workers          int           `yaml:"workers" env:"CART_WEBHOOKS_WORKERS"`
+	LogSize          int           `yaml:"log_size" env:"CART_WEBHOOKS_LOG_SIZE"`
+}
+
+// AbandonedConfig defines when a cart counts as abandoned.
+type AbandonedConfig struct {
+	IdleAfter    time.Duration `yaml:"idle_after" env:"CART_ABANDONED_IDLE_AFTER"`
+	ScanInterval time.Duration `yaml:"scan_interval" env:"CART_ABANDONED_SCAN_INTERVAL"`
+	// ReportSize is the number of abandoned carts kept for the admin report.
+	ReportSize int `yaml:"report_size" env:"CART_ABANDONED_REPORT_SIZE"`
+}
+
+// GuestConfig configures the carts of anonymous users.
+type GuestConfig struct {
+	// TTL is how long a guest cart lives after its last change.
+	TTL time.Duration `yaml:"ttl" env:"CART_GUEST_TTL"`
+	// MergePolicy is sum, max or prefer_guest. It is used when a merge
+	// request does not pick one.
+	MergePolicy string `yaml:"merge_policy" env:"CART_GUEST_MERGE_POLICY"`
+	// MaxCarts caps the live guest carts, as creating one needs no token.
+	MaxCarts int `yaml:"max_carts" env:"CART_GUEST_MAX_CARTS"`
+}
+
+// ListsConfig limits the named lists of a user.
+type ListsConfig struct {
+	// MaxPerUser counts the cart and the saved list too, but never keeps a
+	// user from opening the cart.
+	MaxPerUser int `yaml:"max_per_user" env:"CART_LISTS_MAX_PER_USER"`
+}
+
+// WishlistConfig configures wishlists and the back in stock poller.
+type WishlistConfig struct {
+	MaxItems     int           `yaml:"max_items" env:"CART_WISHLIST_MAX_ITEMS"`
+	PollInterval time.Duration `yaml:"poll_interval" env:"CART_WISHLIST_POLL_INTERVAL"`
+	// Threshold is the stock at which a sku counts as back in stock.
+	Threshold uint64 `yaml:"threshold" env:"CART_WISHLIST_THRESHOLD"`
+}
+
+// CheckoutConfig configures checkout previews.
+type CheckoutConfig struct {
+	// PreviewSecret signs preview tokens. If empty, a random secret is used
+	// and tokens do not survive a restart.
+	PreviewSecret string        `yaml:"preview_secret" env:"CART_CHECKOUT_PREVIEW_SECRET" secret:"true"`
+	PreviewTTL    time.Duration `yaml:"preview_ttl" env:"CART_CHECKOUT_PREVIEW_TTL"`
+	// RequirePreview rejects cart checkouts without a matching preview token.
+	RequirePreview bool `yaml:"require_preview" env:"CART_CHECKOUT_REQUIRE_PREVIEW"`
+}
+
+// PromotionsConfig points to the promotion rules. Without a file carts are
+// not discounted and no coupon exists.
+type PromotionsConfig struct {
+	File string `yaml:"file" env:"CART_PROMOTIONS_FILE"`
+}
+
+// CurrencyConfig points to the exchange rates table, reread every
+// ReloadInterval. Without a file carts are only shown in their own currency.
+type CurrencyConfig struct {
+	RatesFile      string        `yaml:"rates_file" env:"CART_CURRENCY_RATES_FILE"`
+	ReloadInterval time.Duration `yaml:"reload_interval" env:"CART_CURRENCY_RELOAD_INTERVAL"`
+}
+
+type LogConfig struct {
+	Level string `yaml:"level" env:"CART_LOG_LEVEL" reload:"true"`
+}
+
+type FeaturesConfig struct {
+	StockCheck bool `yaml:"stock_check" env:"CART_FEATURES_STOCK_CHECK"`
 }
 
 type Config struct {
 	ProductClient ClientConfig     `yaml:"product_client"`
 	CartServer    HTTPServerConfig `yaml:"cart_server"`
-	LOMSServer    gRPCServerConfig `yaml:"loms_server"`
+	GRPCServer    gRPCServerConfig `yaml:"cart_grpc_server"`
+	LOMSClient    gRPCClientConfig `yaml:"loms_client"`
+	Auth          AuthConfig       `yaml:"auth"`
+	RateLimit     RateLimitConfig  `yaml:"rate_limit"`
+	Events        EventsConfig     `yaml:"events"`
+	Outbox        OutboxConfig     `yaml:"outbox"`
+	Webhooks      WebhooksConfig   `yaml:"webhooks"`
+	Abandoned     AbandonedConfig  `yaml:"abandoned"`
+	Guest         GuestConfig      `yaml:"guest"`
+	Lists         ListsConfig      `yaml:"lists"`
+	Wishlist      WishlistConfig   `yaml:"wishlist"`
+	Checkout      CheckoutConfig   `yaml:"checkout"`
+	Promotions    PromotionsConfig `yaml:"promotions"`
+	Currency      CurrencyConfig   `yaml:"currency"`
+	Timeouts      TimeoutsConfig   `yaml:"timeouts" reload:"true"`
+	Log           LogConfig        `yaml:"log"`
+	Features      FeaturesConfig   `yaml:"features" reload:"true"`
 }
 
+func defaultConfig() Config {
+	return Config{
+		ProductClient: ClientConfig{RPS: 10},
+		CartServer:    HTTPServerConfig{Port: "8082"},
+		GRPCServer:    gRPCServerConfig{Port: "50052"},
+		LOMSClient:    gRPCClientConfig{Address: "localhost:50051"},
+		Auth: AuthConfig{
+			AdminScope: "cart:admin",
+			Leeway:     30 * time.Second,
+		},
+		RateLimit: RateLimitConfig{
+			Enabled:     true,
+			IdleTTL:     10 * time.Minute,
+			MaxVisitors: 100000,
+			Read:        RateLimitRule{RPS: 20, Burst: 40},
+			Write:       RateLimitRule{RPS: 5, Burst: 10},
+			Checkout:    RateLimitRule{RPS: 0.2, Burst: 2},
+		},
+		Events: EventsConfig{
+			BufferSize:     100,
+			MaxSubscribers: 5,
+			Heartbeat:      15 * time.Second,
+			Retention:      10 * time.Minute,
+		},
+		Outbox: OutboxConfig{
+			Sink:        "none",
+			BatchSize:   100,
+			Interval:    time.Second,
+			MaxAttempts: 10,
+			Backoff:     time.Second,
+			MaxBackoff:  time.Minute,
+		},
+		Webhooks: WebhooksConfig{
+			Timeout:          5 * time.Second,
+			MaxAttempts:      5,
+			Backoff:          time.Second,
+			MaxBackoff:       5 * time.Minute,
+			BreakerThreshold: 5,
+			BreakerCooldown:  time.Minute,
+			QueueSize:        1000,
+			Workers:          4,
+			LogSize:          1000,
+		},
+		Abandoned: AbandonedConfig{
+			IdleAfter:    24 * time.Hour,
+			ScanInterval: 5 * time.Minute,
+			ReportSize:   1000,
+		},
+		Guest: GuestConfig{
+			TTL:         72 * time.Hour,
+			MergePolicy: "sum",
+			MaxCarts:    100000,
+		},
+		Lists: ListsConfig{MaxPerUser: 10},
+		Wishlist: WishlistConfig{
+			MaxItems:     100,
+			PollInterval: time.Minute,
+			Threshold:    1,
+		},
+		Checkout: CheckoutConfig{PreviewTTL: 10 * time.Minute},
+		Currency: CurrencyConfig{ReloadInterval: time.Minute},
+		Timeouts: TimeoutsConfig{
+			ExistItem:    time.Second,
+			GetProduct:   time.Second,
+			StocksInfo:   time.Second,
+			OrderCreate:  3 * time.Second,
+			MinRemaining: 50 * time.Millisecond,
+		},
+		Log:      LogConfig{Level: "info"},
+		Features: FeaturesConfig{StockCheck: true},
+	}
+}
+
+// LoadConfig reads the yaml file on top of the defaults, applies environment
+// overrides, resolves the product token file and validates the result.
 func LoadConfig(path string) (*Config, error) {
-	//absPath, err := filepath.Abs(path)
-	//fmt.Println(absPath)
+	cfg := defaultConfig()
+
 	file, err := os.Open(path)
 	if err != nil {
 		return nil, err
 	}
+	defer file.Close()
 
-	var cfg Config
-	err = yaml.NewDecoder(file).Decode(&cfg)
-	if err != nil {
+	if err = yaml.NewDecoder(file).Decode(&cfg); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+
+	if err = applyEnv(&cfg); err != nil {
 		return nil, err
 	}
 
+	if cfg.Auth.HS256SecretFile != "" && cfg.Auth.HS256Secret == "" {
+		raw, err := os.ReadFile(cfg.Auth.HS256SecretFile)
+		if err != nil {
+			return nil, fmt.Errorf("auth.hs256_secret_file: %w", err)
+		}
+		cfg.Auth.HS256Secret = strings.TrimSpace(string(raw))
+	}
+
+	if cfg.ProductClient.TokenFile != "" && cfg.ProductClient.Token == "" {
+		raw, err := os.ReadFile(cfg.ProductClient.TokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("product_client.token_file: %w (mount the file or set CART_PRODUCT_CLIENT_TOKEN)", err)
+		}
+		cfg.ProductClient.Token = strings.TrimSpace(string(raw))
+	}
+
+	if err = cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config %s: %w", path, err)
+	}
+
 	return &cfg, nil
 }
+
+func (c *Config) Validate() error {
+	var errs []error
+
+	if c.ProductClient.URL == "" {
+		errs = append(errs, errors.New("product_client.url: required"))
+	} else if u, err := url.Parse(c.ProductClient.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errs = append(errs, fmt.Errorf("product_client.url: %q is not an http(s) URL", c.ProductClient.URL))
+	}
+	if c.ProductClient.Token == "" {
+		errs = append(errs, errors.New("product_client.token: required (set token, token_file or CART_PRODUCT_CLIENT_TOKEN)"))
+	}
+
+	if c.ProductClient.RPS < 0 {
+		errs = append(errs, fmt.Errorf("product_client.rps: %d must not be negative (0 disables the limit)", c.ProductClient.RPS))
+	}
+
+	if err := validatePort(c.CartServer.Port); err != nil {
+		errs = append(errs, fmt.Errorf("cart_server.port: %w", err))
+	}
+
+	if err := validatePort(c.GRPCServer.Port); err != nil {
+		errs = append(errs, fmt.Errorf("cart_grpc_server.port: %w", err))
+	} else if c.GRPCServer.Port == c.CartServer.Port {
+		errs = append(errs, fmt.Errorf("cart_grpc_server.port: %s is already used by cart_server.port", c.GRPCServer.Port))
+	}
+
+	if c.LOMSClient.Address == "" {
+		errs = append(errs, errors.New("loms_client.address: required"))
+	} else if _, port, err := net.SplitHostPort(c.LOMSClient.Address); err != nil {
+		errs = append(errs, fmt.Errorf("loms_client.address: %q must be host:port", c.LOMSClient.Address))
+	} else if err := validatePort(port); err != nil {
+		errs = append(errs, fmt.Errorf("loms_client.address: %w", err))
+	}
+
+	if c.Auth.Enabled && c.Auth.HS256Secret == "" && c.Auth.RS256PublicKeyFile == "" && c.Auth.JWKSFile == "" {
+		errs = append(errs, errors.New("auth: enabled but no key configured (set hs256_secret, hs256_secret_file, rs256_public_key_file or jwks_file)"))
+	}
+	if c.Auth.Leeway < 0 {
+		errs = append(errs, fmt.Errorf("auth.leeway: %v must not be negative", c.Auth.Leeway))
+	}
+
+	if c.RateLimit.IdleTTL <= 0 {
+		errs = append(errs, fmt.Errorf("rate_limit.idle_ttl: %v must be positive", c.RateLimit.IdleTTL))
+	}
+	if c.RateLimit.MaxVisitors < 1 {
+		errs = append(errs, fmt.Errorf("rate_limit.max_visitors: %d must be positive", c.RateLimit.MaxVisitors))
+	}
+	for _, r := range []struct {
+		name string
+		rule RateLimitRule
+	}{
+		{"read", c.RateLimit.Read},
+		{"write", c.RateLimit.Write},
+		{"checkout", c.RateLimit.Checkout},
+	} {
+		if r.rule.RPS < 0 || r.rule.Burst < 0 {
+			errs = append(errs, fmt.Errorf("rate_limit.%s: rps and burst must not be negative", r.name))
+		} else if r.rule.RPS > 0 && r.rule.Burst == 0 {
+			errs = append(errs, fmt.Errorf("rate_limit.%s: burst must be positive when rps is set", r.name))
+		}
+	}
+
+	if c.Events.BufferSize < 1 {
+		errs = append(errs, fmt.Errorf("events.buffer_size: %d must be positive", c.Events.BufferSize))
+	}
+	if c.Events.MaxSubscribers < 1 {
+		errs = append(errs, fmt.Errorf("events.max_subscribers: %d must be positive", c.Events.MaxSubscribers))
+	}
+	if c.Events.Heartbeat <= 0 {
+		errs = append(errs, fmt.Errorf("events.heartbeat: %v must be positive", c.Events.Heartbeat))
+	}
+	if c.Events.Retention <= 0 {
+		errs = append(errs, fmt.Errorf("events.retention: %v must be positive", c.Events.Retention))
+	}
+
+	switch c.Outbox.Sink {
+	case "none":
+	case "jsonl":
+		if c.Outbox.Path == "" {
+			errs = append(errs, errors.New("outbox.path: required for the jsonl sink"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("outbox.sink: %q must be none or jsonl", c.Outbox.Sink))
+	}
+	if c.Outbox.BatchSize < 1 || c.Outbox.MaxAttempts < 1 {
+		errs = append(errs, errors.New("outbox: batch_size and max_attempts must be positive"))
+	}
+	if c.Outbox.Interval <= 0 || c.Outbox.Backoff <= 0 || c.Outbox.MaxBackoff < c.Outbox.Backoff {
+		errs = append(errs, errors.New("outbox: interval and backoff must be positive, max_backoff at least backoff"))
+	}
+
+	if c.Webhooks.MaxAttempts < 1 || c.Webhooks.BreakerThreshold < 1 || c.Webhooks.QueueSize < 1 || c.Webhooks.Workers < 1 || c.Webhooks.LogSize < 1 {
+		errs = append(errs, errors.New("webhooks: max_attempts, breaker_threshold, queue_size, workers and log_size must be positive"))
+	}
+	if c.Webhooks.Timeout <= 0 || c.Webhooks.Backoff <= 0 || c.Webhooks.BreakerCooldown <= 0 || c.Webhooks.MaxBackoff < c.Webhooks.Backoff {
+		errs = append(errs, errors.New("webhooks: timeout, backoff and breaker_cooldown must be positive, max_backoff at least backoff"))
+	}
+	if c.Abandoned.IdleAfter <= 0 || c.Abandoned.ScanInterval <= 0 || c.Abandoned.ReportSize <= 0 {
+		errs = append(errs, errors.New("abandoned: idle_after, scan_interval and report_size must be positive"))
+	}
+	if c.Guest.TTL <= 0 {
+		errs = append(errs, fmt.Errorf("guest.ttl: %v must be positive", c.Guest.TTL))
+	}
+	if c.Guest.MaxCarts < 1 {
+		errs = append(errs, fmt.Errorf("guest.max_carts: %d must be positive", c.Guest.MaxCarts))
+	}
+	if c.Lists.MaxPerUser < 1 {
+		errs = append(errs, fmt.Errorf("lists.max_per_user: %d must be positive", c.Lists.MaxPerUser))
+	}
+	if c.Wishlist.MaxItems < 1 || c.Wishlist.PollInterval <= 0 || c.Wishlist.Threshold < 1 {
+		errs = append(errs, errors.New("wishlist: max_items, poll_interval and threshold must be positive"))
+	}
+	if c.Checkout.PreviewTTL <= 0 {
+		errs = append(errs, fmt.Errorf("checkout.preview_ttl: %v must be positive", c.Checkout.PreviewTTL))
+	}
+	if c.Currency.ReloadInterval <= 0 {
+		errs = append(errs, fmt.Errorf("currency.reload_interval: %v must be positive", c.Currency.ReloadInterval))
+	}
+	switch c.Guest.MergePolicy {
+	case "sum", "max", "prefer_guest":
+	default:
+		errs = append(errs, fmt.Errorf("guest.merge_policy: %q must be sum, max or prefer_guest", c.Guest.MergePolicy))
+	}
+
+	for _, t := range []struct {
+		name string
+		d    time.Duration
+	}{
+		{"exist_item", c.Timeouts.ExistItem},
+		{"get_product", c.Timeouts.GetProduct},
+		{"stocks_info", c.Timeouts.StocksInfo},
+		{"order_create", c.Timeouts.OrderCreate},
+	} {
+		if t.d <= 0 {
+			errs = append(errs, fmt.Errorf("timeouts.%s: %v must be positive", t.name, t.d))
+		}
+	}
+	if c.Timeouts.MinRemaining < 0 {
+		errs = append(errs, fmt.Errorf("timeouts.min_remaining: %v must not be negative", c.Timeouts.MinRemaining))
+	}
+
+	if err := logger.ValidateLevel(c.Log.Level); err != nil {
+		errs = append(errs, fmt.Errorf("log.level: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
+
+func validatePort(raw string) error {
+	port, err := strconv.Atoi(raw)
+	if err != nil || port < 1 || port > 65535 {
+		return fmt.Errorf("%q is not a valid port", raw)
+	}
+	return nil
+}
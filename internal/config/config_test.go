@@ -0,0 +1,132 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+	tokenPath := writeFile(t, dir, "token", "secret\n")
+	envRateLimit := defaultConfig().RateLimit
+	envRateLimit.Read.RPS = 50
+	envRateLimit.Checkout.Burst = 3
+
+	tests := []struct {
+		name        string
+		yaml        string
+		env         map[string]string
+		expected    *Config
+		expectedErr string
+	}{
+		{
+			name: "Defaults and token file - success",
+			yaml: "product_client:\n  url: http://product:8080/get_product\n  token_file: " + tokenPath + "\n",
+			expected: &Config{
+				ProductClient: ClientConfig{URL: "http://product:8080/get_product", Token: "secret", TokenFile: tokenPath, RPS: 10},
+				CartServer:    HTTPServerConfig{Port: "8082"},
+				GRPCServer:    gRPCServerConfig{Port: "50052"},
+				LOMSClient:    gRPCClientConfig{Address: "localhost:50051"},
+				Auth:          defaultConfig().Auth,
+				RateLimit:     defaultConfig().RateLimit,
+				Events:        defaultConfig().Events,
+				Outbox:        defaultConfig().Outbox,
+				Webhooks:      defaultConfig().Webhooks,
+				Abandoned:     defaultConfig().Abandoned,
+				Guest:         defaultConfig().Guest,
+				Lists:         defaultConfig().Lists,
+				Wishlist:      defaultConfig().Wishlist,
+				Checkout:      defaultConfig().Checkout,
+				Currency:      defaultConfig().Currency,
+				Timeouts:      defaultConfig().Timeouts,
+				Log:           LogConfig{Level: "info"},
+				Features:      FeaturesConfig{StockCheck: true},
+			},
+		},
+		{
+			name: "Environment overrides - success",
+			yaml: "product_client:\n  url: http://product:8080/get_product\n  token: yaml\n",
+			env: map[string]string{
+				"CART_PRODUCT_CLIENT_TOKEN":      "env",
+				"CART_SERVER_PORT":               "9000",
+				"CART_LOMS_ADDRESS":              "loms:50052",
+				"CART_RATE_LIMIT_READ_RPS":       "50",
+				"CART_RATE_LIMIT_CHECKOUT_BURST": "3",
+			},
+			expected: &Config{
+				ProductClient: ClientConfig{URL: "http://product:8080/get_product", Token: "env", RPS: 10},
+				CartServer:    HTTPServerConfig{Port: "9000"},
+				GRPCServer:    gRPCServerConfig{Port: "50052"},
+				LOMSClient:    gRPCClientConfig{Address: "loms:50052"},
+				Auth:          defaultConfig().Auth,
+				RateLimit:     envRateLimit,
+				Events:        defaultConfig().Events,
+				Outbox:        defaultConfig().Outbox,
+				Webhooks:      defaultConfig().Webhooks,
+				Abandoned:     defaultConfig().Abandoned,
+				Guest:         defaultConfig().Guest,
+				Lists:         defaultConfig().Lists,
+				Wishlist:      defaultConfig().Wishlist,
+				Checkout:      defaultConfig().Checkout,
+				Currency:      defaultConfig().Currency,
+				Timeouts:      defaultConfig().Timeouts,
+				Log:           LogConfig{Level: "info"},
+				Features:      FeaturesConfig{StockCheck: true},
+			},
+		},
+		{
+			name:        "Auth enabled without keys - error",
+			yaml:        "product_client:\n  url: http://product\n  token: t\nauth:\n  enabled: true\n",
+			expectedErr: "auth: enabled but no key configured",
+		},
+		{
+			name:        "Missing url and token - error",
+			yaml:        "cart_server:\n  port: \"8082\"\n",
+			expectedErr: "product_client.url: required\nproduct_client.token: required",
+		},
+		{
+			name:        "Missing token file - error",
+			yaml:        "product_client:\n  url: http://product\n  token_file: " + filepath.Join(dir, "missing") + "\n",
+			expectedErr: "(mount the file or set CART_PRODUCT_CLIENT_TOKEN)",
+		},
+		{
+			name:        "Invalid port and address - error",
+			yaml:        "product_client:\n  url: http://product\n  token: t\ncart_server:\n  port: \"0\"\nloms_client:\n  address: loms\n",
+			expectedErr: "cart_server.port: \"0\" is not a valid port\nloms_client.address: \"loms\" must be host:port",
+		},
+		{
+			name:        "Unknown merge policy - error",
+			yaml:        "product_client:\n  url: http://product\n  token: t\nguest:\n  merge_policy: min\n",
+			expectedErr: "guest.merge_policy: \"min\" must be sum, max or prefer_guest",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			path := writeFile(t, t.TempDir(), "config.yaml", tt.yaml)
+
+			cfg, err := LoadConfig(path)
+			if tt.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+)
+
+type Change struct {
+	Path       string
+	Old        any
+	New        any
+	Reloadable bool
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %v -> %v", c.Path, c.Old, c.New)
+}
+
+// Diff lists every leaf field that differs between two configs. Secret
+// values are masked.
+func Diff(oldCfg, newCfg *Config) []Change {
+	var changes []Change
+	diffValue("", reflect.ValueOf(*oldCfg), reflect.ValueOf(*newCfg), false, &changes)
+	return changes
+}
+
+func diffValue(prefix string, oldV, newV reflect.Value, reloadable bool, changes *[]Change) {
+	t := oldV.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		path := yamlName(field)
+		if prefix != "" {
+			path = prefix + "." + path
+		}
+		fieldReloadable := reloadable || field.Tag.Get("reload") == "true"
+
+		if field.Type.Kind() == reflect.Struct && field.Type != durationType {
+			diffValue(path, oldV.Field(i), newV.Field(i), fieldReloadable, changes)
+			continue
+		}
+
+		o, n := oldV.Field(i).Interface(), newV.Field(i).Interface()
+		if reflect.DeepEqual(o, n) {
+			continue
+		}
+		if field.Tag.Get("secret") == "true" {
+			o, n = "***", "***"
+		}
+		*changes = append(*changes, Change{Path: path, Old: o, New: n, Reloadable: fieldReloadable})
+	}
+}
+
+func yamlName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+	if name == "" {
+		return strings.ToLower(field.Name)
+	}
+	return name
+}
+
+// mergeReloadable returns a copy of cur with every reloadable field taken
+// from next.
+func mergeReloadable(cur, next *Config) *Config {
+	merged := *cur
+	mergeValue(reflect.ValueOf(&merged).Elem(), reflect.ValueOf(*next), false)
+	return &merged
+}
+
+func mergeValue(dst, src reflect.Value, reloadable bool) {
+	t := dst.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fieldReloadable := reloadable || field.Tag.Get("reload") == "true"
+
+		if field.Type.Kind() == reflect.Struct && field.Type != durationType {
+			mergeValue(dst.Field(i), src.Field(i), fieldReloadable)
+			continue
+		}
+		if fieldReloadable {
+			dst.Field(i).Set(src.Field(i))
+		}
+	}
+}
+
+// Watcher keeps the current config and reloads its runtime-tunable fields
+// when the file changes or the process receives SIGHUP.
+type Watcher struct {
+	path     string
+	interval time.Duration
+	current  atomic.Pointer[Config]
+
+	mu          sync.Mutex
+	modTime     time.Time
+	subscribers []func(*Config)
+}
+
+func NewWatcher(path string, cfg *Config, interval time.Duration) *Watcher {
+	w := &Watcher{path: path, interval: interval}
+	w.current.Store(cfg)
+	if info, err := os.Stat(path); err == nil {
+		w.modTime = info.ModTime()
+	}
+	return w
+}
+
+func (w *Watcher) Config() *Config {
+	return w.current.Load()
+}
+
+// Subscribe registers fn to be called with the new config after every
+// successful reload.
+func (w *Watcher) Subscribe(fn func(*Config)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.subscribers = append(w.subscribers, fn)
+}
+
+// Reload reads the file again. An invalid file is rejected and the current
+// config stays in effect.
+func (w *Watcher) Reload() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	next, err := LoadConfig(w.path)
+	if err != nil {
+		return err
+	}
+
+	cur := w.current.Load()
+	changes := Diff(cur, next)
+	if len(changes) == 0 {
+		slog.Info("config reload: no changes")
+		return nil
+	}
+	for _, c := range changes {
+		if c.Reloadable {
+			slog.Info("config reload", "change", c.String())
+		} else {
+			slog.Warn("config reload: change requires restart, ignored", "change", c.String())
+		}
+	}
+
+	merged := mergeReloadable(cur, next)
+	w.current.Store(merged)
+	for _, fn := range w.subscribers {
+		fn(merged)
+	}
+
+	return nil
+}
+
+func (w *Watcher) Run(ctx context.Context) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			slog.Info("config reload: SIGHUP received")
+			w.reportReload()
+		case <-ticker.C:
+			if w.fileChanged() {
+				slog.Info("config reload: file changed")
+				w.reportReload()
+			}
+		}
+	}
+}
+
+func (w *Watcher) reportReload() {
+	if err := w.Reload(); err != nil {
+		slog.Warn("config reload rejected, keeping current config", "err", err)
+	}
+}
+
+func (w *Watcher) fileChanged() bool {
+	info, err := os.Stat(w.path)
+	if err != nil {
+		return false
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if info.ModTime().Equal(w.modTime) {
+		return false
+	}
+	w.modTime = info.ModTime()
+	return true
+}
@@ -0,0 +1,105 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const reloadBaseYAML = "product_client:\n  url: http://product\n  token: t\n  rps: 10\nlog:\n  level: info\n"
+
+func TestWatcher_Reload(t *testing.T) {
+	tests := []struct {
+		name          string
+		yaml          string
+		expectedErr   bool
+		expectedRPS   int
+		expectedLevel string
+		expectedURL   string
+	}{
+		{
+			name:          "Reloadable fields - applied",
+			yaml:          "product_client:\n  url: http://product\n  token: t\n  rps: 50\nlog:\n  level: debug\n",
+			expectedRPS:   50,
+			expectedLevel: "debug",
+			expectedURL:   "http://product",
+		},
+		{
+			name:          "Restart-only field - ignored",
+			yaml:          "product_client:\n  url: http://other\n  token: t\n  rps: 20\nlog:\n  level: info\n",
+			expectedRPS:   20,
+			expectedLevel: "info",
+			expectedURL:   "http://product",
+		},
+		{
+			name:          "Invalid config - rejected",
+			yaml:          "product_client:\n  url: http://product\n  token: t\n  rps: -1\nlog:\n  level: loud\n",
+			expectedErr:   true,
+			expectedRPS:   10,
+			expectedLevel: "info",
+			expectedURL:   "http://product",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(reloadBaseYAML), 0o600))
+			cfg, err := LoadConfig(path)
+			require.NoError(t, err)
+
+			watcher := NewWatcher(path, cfg, 0)
+			notified := 0
+			watcher.Subscribe(func(*Config) { notified++ })
+
+			require.NoError(t, os.WriteFile(path, []byte(tt.yaml), 0o600))
+			err = watcher.Reload()
+			if tt.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, 0, notified)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 1, notified)
+			}
+
+			assert.Equal(t, tt.expectedRPS, watcher.Config().ProductClient.RPS)
+			assert.Equal(t, tt.expectedLevel, watcher.Config().Log.Level)
+			assert.Equal(t, tt.expectedURL, watcher.Config().ProductClient.URL)
+		})
+	}
+}
+
+func TestDiff_MasksSecrets(t *testing.T) {
+	oldCfg := &Config{ProductClient: ClientConfig{Token: "old"}}
+	newCfg := &Config{ProductClient: ClientConfig{Token: "new"}}
+
+	changes := Diff(oldCfg, newCfg)
+
+	require.Len(t, changes, 1)
+	assert.Equal(t, "product_client.token: *** -> ***", changes[0].String())
+	assert.False(t, changes[0].Reloadable)
+}
+
+func TestDiff_RateLimitReloadsOnlyRules(t *testing.T) {
+	oldCfg := defaultConfig()
+	newCfg := defaultConfig()
+	newCfg.RateLimit.Read.RPS = 50
+	newCfg.RateLimit.IdleTTL *= 2
+	newCfg.RateLimit.MaxVisitors++
+	newCfg.RateLimit.TrustForwarded = true
+
+	reloadable := make(map[string]bool)
+	for _, c := range Diff(&oldCfg, &newCfg) {
+		reloadable[c.Path] = c.Reloadable
+	}
+
+	assert.Equal(t, map[string]bool{
+		"rate_limit.read.rps":        true,
+		"rate_limit.idle_ttl":        false,
+		"rate_limit.max_visitors":    false,
+		"rate_limit.trust_forwarded": false,
+	}, reloadable)
+}
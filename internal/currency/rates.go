@@ -0,0 +1,166 @@
+// Package currency loads the exchange rates table and keeps it up to date.
+package currency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/vestamart/cart/internal/domain"
+	"gopkg.in/yaml.v3"
+	"log/slog"
+	"math/big"
+	"os"
+	"sync/atomic"
+	"time"
+)
+
+// Currency is an entry of the rates file. Rate is the price of a unit of
+// the base currency in this one; the base currency has none.
+type Currency struct {
+	Rate       string              `yaml:"rate"`
+	MinorUnits int                 `yaml:"minor_units"`
+	Rounding   domain.RoundingMode `yaml:"rounding"`
+	// Step rounds to multiples of this many minor units.
+	Step int64 `yaml:"step"`
+}
+
+type file struct {
+	Source     string              `yaml:"source"`
+	UpdatedAt  time.Time           `yaml:"updated_at"`
+	Base       string              `yaml:"base"`
+	Currencies map[string]Currency `yaml:"currencies"`
+}
+
+// Rates is a loaded rates table.
+type Rates struct {
+	source     string
+	updatedAt  time.Time
+	base       string
+	currencies map[string]Currency
+	rates      map[string]*big.Rat
+}
+
+// Parse reads a rates file. updatedAt is used if the file has no
+// updated_at.
+func Parse(raw []byte, updatedAt time.Time) (*Rates, error) {
+	var f file
+	if err := yaml.Unmarshal(raw, &f); err != nil {
+		return nil, err
+	}
+	if !f.UpdatedAt.IsZero() {
+		updatedAt = f.UpdatedAt
+	}
+
+	r := &Rates{
+		source:     f.Source,
+		updatedAt:  updatedAt,
+		base:       f.Base,
+		currencies: f.Currencies,
+		rates:      make(map[string]*big.Rat, len(f.Currencies)),
+	}
+	var errs []error
+	if _, ok := f.Currencies[f.Base]; !ok {
+		errs = append(errs, fmt.Errorf("base: %q is not in currencies", f.Base))
+	}
+	for code, c := range f.Currencies {
+		if c.MinorUnits < 0 || c.MinorUnits > 8 || c.Step < 0 {
+			errs = append(errs, fmt.Errorf("%s: minor_units must be 0 to 8 and step not negative", code))
+		}
+		if c.Rounding == "" {
+			c.Rounding = domain.RoundHalfUp
+			r.currencies[code] = c
+		}
+		if !c.Rounding.Valid() {
+			errs = append(errs, fmt.Errorf("%s: rounding %q must be half_up, half_even, down or up", code, c.Rounding))
+		}
+		if code == f.Base {
+			r.rates[code] = big.NewRat(1, 1)
+			continue
+		}
+		rate, ok := new(big.Rat).SetString(c.Rate)
+		if !ok || rate.Sign() <= 0 {
+			errs = append(errs, fmt.Errorf("%s: rate %q must be a positive decimal", code, c.Rate))
+			continue
+		}
+		r.rates[code] = rate
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return r, nil
+}
+
+// Rate returns the rate from one currency to another, crossed through the
+// base currency.
+func (r *Rates) Rate(from, to string) (domain.ExchangeRate, error) {
+	fromRate, ok := r.rates[from]
+	if !ok {
+		return domain.ExchangeRate{}, domain.ErrUnknownCurrency.WithMsg("%q is not in the rates table", from)
+	}
+	toRate, ok := r.rates[to]
+	if !ok {
+		return domain.ExchangeRate{}, domain.ErrUnknownCurrency.WithMsg("%q is not in the rates table", to)
+	}
+
+	rate := new(big.Rat).Quo(toRate, fromRate)
+	c := r.currencies[to]
+	rounding := domain.Rounding{Mode: c.Rounding, Step: c.Step}
+	return domain.NewExchangeRate(from, to, rate, r.currencies[from].MinorUnits, c.MinorUnits, rounding, r.source, r.updatedAt), nil
+}
+
+// Table is a rates file reloaded periodically. A file that fails to load
+// leaves the previous table in effect.
+type Table struct {
+	path     string
+	interval time.Duration
+	current  atomic.Pointer[Rates]
+}
+
+// Load reads the rates file at path.
+func Load(path string, interval time.Duration) (*Table, error) {
+	t := &Table{path: path, interval: interval}
+	if err := t.Reload(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Reload reads the file again.
+func (t *Table) Reload() error {
+	info, err := os.Stat(t.path)
+	if err != nil {
+		return err
+	}
+	raw, err := os.ReadFile(t.path)
+	if err != nil {
+		return err
+	}
+	rates, err := Parse(raw, info.ModTime())
+	if err != nil {
+		return fmt.Errorf("%s: %w", t.path, err)
+	}
+	t.current.Store(rates)
+	return nil
+}
+
+// Rate looks up a rate in the current table.
+func (t *Table) Rate(from, to string) (domain.ExchangeRate, error) {
+	return t.current.Load().Rate(from, to)
+}
+
+// Run reloads the file every interval until ctx is done.
+func (t *Table) Run(ctx context.Context) {
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := t.Reload(); err != nil {
+				slog.Warn("rates reload rejected, keeping current rates", "err", err)
+			}
+		}
+	}
+}
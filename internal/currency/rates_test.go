@@ -0,0 +1,105 @@
+package currency
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/cart/internal/domain"
+)
+
+const rates = `source: test
+updated_at: 2025-03-01T12:00:00Z
+base: RUB
+currencies:
+  RUB:
+    minor_units: 2
+  USD:
+    rate: "0.0125"
+    minor_units: 2
+    rounding: half_even
+  JPY:
+    rate: "1.5"
+    minor_units: 0
+`
+
+func TestRates_Rate(t *testing.T) {
+	r, err := Parse([]byte(rates), time.Time{})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		from, to    string
+		amount      int64
+		expected    domain.Money
+		expectedErr error
+	}{
+		{"From the base - success", "RUB", "USD", 10000, domain.NewMoney(125, "USD"), nil},
+		{"Half even - tie to even", "RUB", "USD", 20, domain.NewMoney(0, "USD"), nil},
+		{"To the base - success", "USD", "RUB", 125, domain.NewMoney(10000, "RUB"), nil},
+		{"Cross rate - through the base", "USD", "JPY", 100, domain.NewMoney(120, "JPY"), nil},
+		{"Unknown currency - error", "RUB", "GBP", 100, domain.Money{}, domain.ErrUnknownCurrency},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, err := r.Rate(tt.from, tt.to)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "test", rate.Source)
+			assert.Equal(t, time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC), rate.UpdatedAt)
+
+			got, err := rate.Convert(domain.NewMoney(tt.amount, tt.from))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	_, err := Parse([]byte(`base: EUR
+currencies:
+  RUB:
+    minor_units: 12
+  USD:
+    rate: "-1"
+    rounding: nearest
+`), time.Time{})
+	require.Error(t, err)
+	for _, msg := range []string{
+		`base: "EUR" is not in currencies`,
+		`RUB: minor_units must be 0 to 8 and step not negative`,
+		`RUB: rate "" must be a positive decimal`,
+		`USD: rounding "nearest" must be half_up, half_even, down or up`,
+		`USD: rate "-1" must be a positive decimal`,
+	} {
+		assert.ErrorContains(t, err, msg)
+	}
+}
+
+func TestTable_Reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rates.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(rates), 0o600))
+
+	table, err := Load(path, time.Minute)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(path, []byte("base: RUB\ncurrencies: {}\n"), 0o600))
+	assert.Error(t, table.Reload())
+	rate, err := table.Rate("RUB", "USD")
+	require.NoError(t, err)
+	assert.Equal(t, "0.0125", rate.Rate)
+
+	require.NoError(t, os.WriteFile(path, []byte(strings.Replace(rates, `"0.0125"`, `"0.02"`, 1)), 0o600))
+	require.NoError(t, table.Reload())
+	rate, err = table.Rate("RUB", "USD")
+	require.NoError(t, err)
+	assert.Equal(t, "0.02", rate.Rate)
+}
@@ -0,0 +1,77 @@
+package delivery
+
+import (
+	"encoding/json"
+	"github.com/vestamart/cart/internal/app/abandoned"
+	"github.com/vestamart/cart/internal/domain"
+	"github.com/vestamart/cart/internal/problem"
+	"net/http"
+	"time"
+)
+
+const defaultPageSize = 20
+
+type AbandonedServer struct {
+	report *abandoned.Report
+}
+
+func NewAbandonedServer(report *abandoned.Report) *AbandonedServer {
+	return &AbandonedServer{report: report}
+}
+
+// PageQuery Query params of paged endpoints
+type PageQuery struct {
+	Page     int `query:"page" validate:"min=1"`
+	PageSize int `query:"page_size" validate:"min=1,max=100"`
+}
+
+type AbandonedCartResponse struct {
+	UserID     uint64                `json:"user_id"`
+	Items      []GetCartItemResponse `json:"items"`
+	TotalPrice MoneyResponse         `json:"total_price"`
+	Version    uint64                `json:"version"`
+	IdleSince  time.Time             `json:"idle_since"`
+	DetectedAt time.Time             `json:"detected_at"`
+}
+
+type AbandonedCartsResponse struct {
+	Items    []AbandonedCartResponse `json:"items"`
+	Page     int                     `json:"page"`
+	PageSize int                     `json:"page_size"`
+	Total    int                     `json:"total"`
+}
+
+func abandonedCartResponse(cart domain.AbandonedCart) AbandonedCartResponse {
+	return AbandonedCartResponse{
+		UserID:     cart.UserID,
+		Items:      cartItemsResponse(cart.Items),
+		TotalPrice: MoneyResponse(cart.TotalPrice),
+		Version:    cart.Version,
+		IdleSince:  cart.IdleSince,
+		DetectedAt: cart.DetectedAt,
+	}
+}
+
+func (s AbandonedServer) AbandonedCartsHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	query := PageQuery{Page: 1, PageSize: defaultPageSize}
+	if errs := bindRequest(r, &query, nil); len(errs) > 0 {
+		problem.Validation(w, r, errs)
+		return
+	}
+
+	carts, total := s.report.Page(query.Page, query.PageSize)
+	resp := AbandonedCartsResponse{
+		Items:    make([]AbandonedCartResponse, 0, len(carts)),
+		Page:     query.Page,
+		PageSize: query.PageSize,
+		Total:    total,
+	}
+	for _, cart := range carts {
+		resp.Items = append(resp.Items, abandonedCartResponse(cart))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
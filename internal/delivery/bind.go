@@ -0,0 +1,132 @@
+package delivery
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/vestamart/cart/internal/validator"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// CartItemPath Path params of item endpoints
+type CartItemPath struct {
+	UserID uint64 `path:"user_id" validate:"min=1"`
+	SkuID  int64  `path:"sku_id" validate:"min=1"`
+}
+
+// UserPath Path params of cart endpoints
+type UserPath struct {
+	UserID uint64 `path:"user_id" validate:"min=1"`
+}
+
+// bindRequest fills params from the path and query params and body from the
+// JSON body, then validates both. All problems are returned together. Either argument
+// may be nil.
+func bindRequest(r *http.Request, params, body any) validator.Errors {
+	var errs validator.Errors
+	targets := make([]any, 0, 2)
+	if params != nil {
+		errs = append(errs, bindParams(r, params)...)
+		targets = append(targets, params)
+	}
+	if body != nil {
+		// A body that could not be decoded is not validated any further.
+		if bodyErrs := decodeJSON(r, body); len(bodyErrs) > 0 {
+			errs = append(errs, bodyErrs...)
+		} else {
+			targets = append(targets, body)
+		}
+	}
+
+	for _, v := range targets {
+		for _, fe := range validator.Struct(v) {
+			if !errs.Has(fe.Field) {
+				errs = append(errs, fe)
+			}
+		}
+	}
+
+	return errs
+}
+
+// bindParams fills the fields tagged `path` from the path params, the fields
+// tagged `query` from the query string and the fields tagged `header` from
+// the request headers. A missing query param or header keeps the field's
+// current value, so callers can preset defaults.
+func bindParams(r *http.Request, dst any) validator.Errors {
+	var errs validator.Errors
+	query := r.URL.Query()
+
+	v := reflect.ValueOf(dst).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		var name, raw string
+		if name = field.Tag.Get("path"); name != "" {
+			raw = r.PathValue(name)
+		} else if name = field.Tag.Get("query"); name != "" {
+			if !query.Has(name) {
+				continue
+			}
+			raw = query.Get(name)
+		} else if name = field.Tag.Get("header"); name != "" {
+			if raw = r.Header.Get(name); raw == "" {
+				continue
+			}
+		} else {
+			continue
+		}
+
+		switch field.Type.Kind() {
+		case reflect.Uint64:
+			n, err := strconv.ParseUint(raw, 10, 64)
+			if err != nil {
+				errs.Add(name, "must be a positive integer")
+				continue
+			}
+			v.Field(i).SetUint(n)
+		case reflect.Int, reflect.Int64:
+			n, err := strconv.ParseInt(raw, 10, 64)
+			if err != nil {
+				errs.Add(name, "must be an integer")
+				continue
+			}
+			v.Field(i).SetInt(n)
+		case reflect.String:
+			v.Field(i).SetString(raw)
+		}
+	}
+
+	return errs
+}
+
+// decodeJSON decodes a JSON body that must not contain unknown fields.
+func decodeJSON(r *http.Request, dst any) validator.Errors {
+	var errs validator.Errors
+
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	err := dec.Decode(dst)
+
+	var typeErr *json.UnmarshalTypeError
+	var syntaxErr *json.SyntaxError
+	switch {
+	case err == nil:
+	case errors.Is(err, io.EOF):
+		errs.Add("body", "is required")
+	case errors.As(err, &typeErr):
+		errs.Add(typeErr.Field, "must be %s", typeErr.Type.String())
+	case errors.As(err, &syntaxErr), errors.Is(err, io.ErrUnexpectedEOF):
+		errs.Add("body", "is not valid JSON")
+	case strings.HasPrefix(err.Error(), "json: unknown field "):
+		field := strings.Trim(strings.TrimPrefix(err.Error(), "json: unknown field "), `"`)
+		errs.Add(field, "unknown field")
+	default:
+		errs.Add("body", "%v", err)
+	}
+
+	return errs
+}
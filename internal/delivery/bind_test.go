@@ -0,0 +1,115 @@
+package delivery
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vestamart/cart/internal/validator"
+)
+
+func TestBindRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		userID   string
+		skuID    string
+		body     string
+		expected validator.Errors
+	}{
+		{
+			name:   "Valid request - no errors",
+			userID: "1",
+			skuID:  "1003",
+			body:   `{"count": 2}`,
+		},
+		{
+			name:   "Invalid path and count - aggregated",
+			userID: "0",
+			skuID:  "abc",
+			body:   `{"count": 0}`,
+			expected: validator.Errors{
+				{Field: "sku_id", Message: "must be an integer"},
+				{Field: "user_id", Message: "must be at least 1"},
+				{Field: "count", Message: "must be at least 1"},
+			},
+		},
+		{
+			name:     "Unknown field - rejected",
+			userID:   "1",
+			skuID:    "1003",
+			body:     `{"count": 1, "price": 5}`,
+			expected: validator.Errors{{Field: "price", Message: "unknown field"}},
+		},
+		{
+			name:     "Wrong type - rejected",
+			userID:   "1",
+			skuID:    "1003",
+			body:     `{"count": -1}`,
+			expected: validator.Errors{{Field: "count", Message: "must be uint16"}},
+		},
+		{
+			name:     "Empty body - rejected",
+			userID:   "1",
+			skuID:    "1003",
+			body:     ``,
+			expected: validator.Errors{{Field: "body", Message: "is required"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/user/"+tt.userID+"/cart/"+tt.skuID, strings.NewReader(tt.body))
+			req.SetPathValue("user_id", tt.userID)
+			req.SetPathValue("sku_id", tt.skuID)
+
+			var path CartItemPath
+			var body AddToCartRequest
+			errs := bindRequest(req, &path, &body)
+
+			assert.Equal(t, tt.expected, errs)
+		})
+	}
+}
+
+func TestBindRequest_Query(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected PageQuery
+		errs     validator.Errors
+	}{
+		{
+			name:     "Missing params - defaults kept",
+			query:    "",
+			expected: PageQuery{Page: 1, PageSize: defaultPageSize},
+		},
+		{
+			name:     "Valid params - success",
+			query:    "?page=3&page_size=50",
+			expected: PageQuery{Page: 3, PageSize: 50},
+		},
+		{
+			name:     "Invalid params - aggregated",
+			query:    "?page=x&page_size=500",
+			expected: PageQuery{Page: 1, PageSize: 500},
+			errs: validator.Errors{
+				{Field: "page", Message: "must be an integer"},
+				{Field: "page_size", Message: "must be at most 100"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/admin/abandoned-carts"+tt.query, nil)
+
+			query := PageQuery{Page: 1, PageSize: defaultPageSize}
+			errs := bindRequest(req, &query, nil)
+
+			assert.Equal(t, tt.errs, errs)
+			assert.Equal(t, tt.expected, query)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package delivery
+
+import (
+	"encoding/json"
+	"github.com/vestamart/cart/internal/auth"
+	"github.com/vestamart/cart/internal/domain"
+	"github.com/vestamart/cart/internal/problem"
+	"net/http"
+	"time"
+)
+
+// CheckoutPreviewRequest Request form, the checkout request without a token
+type CheckoutPreviewRequest struct {
+	UserID uint64                `json:"user" validate:"min=1"`
+	Items  []CheckoutItemRequest `json:"items" validate:"max=100"`
+}
+
+type CheckoutPreviewResponse struct {
+	Version    uint64                   `json:"version"`
+	Items      []PreviewItemResponse    `json:"items"`
+	Subtotal   MoneyResponse            `json:"subtotal"`
+	Discounts  []DiscountResponse       `json:"discounts,omitempty"`
+	TotalPrice MoneyResponse            `json:"total_price"`
+	Problems   []PreviewProblemResponse `json:"problems"`
+	Token      string                   `json:"token,omitempty"`
+	ExpiresAt  *time.Time               `json:"expires_at,omitempty"`
+}
+
+type PreviewItemResponse struct {
+	GetCartItemResponse
+	Available *uint64 `json:"available,omitempty"`
+}
+
+type PreviewProblemResponse struct {
+	SkuID  int64  `json:"sku_id"`
+	Code   string `json:"code"`
+	Detail string `json:"detail"`
+}
+
+func (s Server) PreviewCheckoutHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var request CheckoutPreviewRequest
+	if errs := bindRequest(r, nil, &request); len(errs) > 0 {
+		problem.Validation(w, r, errs)
+		return
+	}
+
+	if err := auth.AuthorizeUser(r.Context(), request.UserID); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	preview, err := s.cartService.PreviewCheckout(withIfMatch(r), request.UserID, checkoutLines(request.Items))
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	w.Header().Set("ETag", cartETag(preview.Version))
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(previewResponse(preview))
+}
+
+func previewResponse(preview *domain.CheckoutPreview) CheckoutPreviewResponse {
+	resp := CheckoutPreviewResponse{
+		Version:    preview.Version,
+		Items:      make([]PreviewItemResponse, 0, len(preview.Items)),
+		Subtotal:   MoneyResponse(preview.Subtotal),
+		Discounts:  discountsResponse(preview.Discounts),
+		TotalPrice: MoneyResponse(preview.TotalPrice),
+		Problems:   make([]PreviewProblemResponse, 0, len(preview.Problems)),
+		Token:      preview.Token,
+		ExpiresAt:  preview.ExpiresAt,
+	}
+	for _, item := range preview.Items {
+		resp.Items = append(resp.Items, PreviewItemResponse{
+			GetCartItemResponse: cartItemResponse(item.CartItem),
+			Available:           item.Available,
+		})
+	}
+	for _, p := range preview.Problems {
+		resp.Problems = append(resp.Problems, PreviewProblemResponse(p))
+	}
+	return resp
+}
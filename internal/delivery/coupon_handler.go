@@ -0,0 +1,61 @@
+package delivery
+
+import (
+	"encoding/json"
+	"github.com/vestamart/cart/internal/auth"
+	"github.com/vestamart/cart/internal/problem"
+	"net/http"
+)
+
+// ApplyCouponRequest Request form
+type ApplyCouponRequest struct {
+	Code string `json:"code" validate:"required,max=32"`
+}
+
+func (s Server) ApplyCouponHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var path UserPath
+	var request ApplyCouponRequest
+	if errs := bindRequest(r, &path, &request); len(errs) > 0 {
+		problem.Validation(w, r, errs)
+		return
+	}
+
+	if err := auth.AuthorizeUser(r.Context(), path.UserID); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	cart, err := s.cartService.ApplyCoupon(withIfMatch(r), path.UserID, request.Code)
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	w.Header().Set("ETag", cartETag(cart.Version))
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(cartResponse(cart))
+}
+
+func (s Server) RemoveCouponHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var path UserPath
+	if errs := bindRequest(r, &path, nil); len(errs) > 0 {
+		problem.Validation(w, r, errs)
+		return
+	}
+
+	if err := auth.AuthorizeUser(r.Context(), path.UserID); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	if err := s.cartService.RemoveCoupon(withIfMatch(r), path.UserID); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,55 @@
+package delivery
+
+import (
+	"embed"
+	"github.com/vestamart/cart/internal/localErr"
+	"github.com/vestamart/cart/internal/problem"
+	"net/http"
+)
+
+//go:embed docs/openapi.json docs/swagger.html docs/swagger-ui
+var docsFS embed.FS
+
+// swaggerAssets are the files of swagger-ui-dist that `make swagger-ui`
+// vendors into docs/swagger-ui, at the version in docs/swagger-ui/VERSION.
+var swaggerAssets = map[string]string{
+	"swagger-ui.css":                  "text/css; charset=utf-8",
+	"swagger-ui-bundle.js":            "text/javascript; charset=utf-8",
+	"swagger-ui-standalone-preset.js": "text/javascript; charset=utf-8",
+}
+
+var errAssetNotFound = localErr.New(localErr.KindNotFound, "not_found", "no such swagger-ui asset")
+
+func OpenAPIHandler(w http.ResponseWriter, _ *http.Request) {
+	spec, _ := docsFS.ReadFile("docs/openapi.json")
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(spec)
+}
+
+func SwaggerUIHandler(w http.ResponseWriter, _ *http.Request) {
+	page, _ := docsFS.ReadFile("docs/swagger.html")
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(page)
+}
+
+// SwaggerAssetHandler serves the vendored Swagger UI files, so /docs needs
+// neither a CDN nor network access.
+func SwaggerAssetHandler(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("file")
+	contentType, ok := swaggerAssets[name]
+	if !ok {
+		problem.Error(w, r, errAssetNotFound)
+		return
+	}
+	asset, err := docsFS.ReadFile("docs/swagger-ui/" + name)
+	if err != nil {
+		problem.Error(w, r, errAssetNotFound.WithMsg("%s is not vendored, run make swagger-ui", name))
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "public, max-age=86400")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(asset)
+}
@@ -0,0 +1,58 @@
+package delivery
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type openAPISpec struct {
+	Paths map[string]map[string]json.RawMessage `json:"paths"`
+}
+
+func TestOpenAPI_CoversAllRoutes(t *testing.T) {
+	rec := httptest.NewRecorder()
+	OpenAPIHandler(rec, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var spec openAPISpec
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&spec))
+
+	router := NewRouter(&Server{}, &HealthServer{}, &EventsServer{}, &WebhooksServer{}, &AbandonedServer{}, &WishlistServer{})
+	for _, rt := range router.routes() {
+		method, path, ok := strings.Cut(rt.pattern, " ")
+		require.True(t, ok, "route %q has no method", rt.pattern)
+
+		operations, ok := spec.Paths[path]
+		if assert.True(t, ok, "path %s is missing from openapi.json", path) {
+			_, ok = operations[strings.ToLower(method)]
+			assert.True(t, ok, "operation %s %s is missing from openapi.json", method, path)
+		}
+	}
+}
+
+func TestSwaggerUI_NoExternalAssets(t *testing.T) {
+	rec := httptest.NewRecorder()
+	SwaggerUIHandler(rec, httptest.NewRequest(http.MethodGet, "/docs", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "https://")
+	for name := range swaggerAssets {
+		assert.Contains(t, rec.Body.String(), "/docs/swagger-ui/"+name)
+	}
+}
+
+func TestSwaggerAssetHandler_UnknownFile(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /docs/swagger-ui/{file}", SwaggerAssetHandler)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/docs/swagger-ui/VERSION", nil))
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,58 @@
+package delivery
+
+import (
+	"context"
+	"github.com/vestamart/cart/internal/domain"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// cartETag renders a cart version as a strong entity tag.
+func cartETag(version uint64) string {
+	return `"` + strconv.FormatUint(version, 10) + `"`
+}
+
+func entityTags(header string) []string {
+	var tags []string
+	for _, tag := range strings.Split(header, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// withIfMatch turns an If-Match header into a precondition on the cart
+// version. If-Match uses the strong comparison, so weak tags never match.
+func withIfMatch(r *http.Request) context.Context {
+	header := r.Header.Get("If-Match")
+	if header == "" || strings.TrimSpace(header) == "*" {
+		return r.Context()
+	}
+
+	var versions []uint64
+	for _, tag := range entityTags(header) {
+		if strings.HasPrefix(tag, "W/") {
+			continue
+		}
+		v, err := strconv.ParseUint(strings.Trim(tag, `"`), 10, 64)
+		if err == nil {
+			versions = append(versions, v)
+		}
+	}
+
+	return domain.WithIfMatch(r.Context(), versions...)
+}
+
+// notModified reports whether the If-None-Match header matches version,
+// using the weak comparison.
+func notModified(r *http.Request, version uint64) bool {
+	etag := cartETag(version)
+	for _, tag := range entityTags(r.Header.Get("If-None-Match")) {
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package delivery
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vestamart/cart/internal/domain"
+)
+
+func TestWithIfMatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		version  uint64
+		expected error
+	}{
+		{"No header - unconditional", "", 7, nil},
+		{"Wildcard - unconditional", "*", 7, nil},
+		{"Matching tag", `"7"`, 7, nil},
+		{"One of several tags", `"3", "7"`, 7, nil},
+		{"Stale tag", `"6"`, 7, domain.ErrVersionMismatch},
+		{"Weak tag never matches", `W/"7"`, 7, domain.ErrVersionMismatch},
+		{"Garbage never matches", `"abc"`, 7, domain.ErrVersionMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodDelete, "/user/1/cart", nil)
+			if tt.header != "" {
+				req.Header.Set("If-Match", tt.header)
+			}
+
+			err := domain.CheckVersion(withIfMatch(req), tt.version)
+			if tt.expected == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
+
+func TestNotModified(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		expected bool
+	}{
+		{"Same version", `"7"`, true},
+		{"Weak tag matches", `W/"7"`, true},
+		{"Wildcard", "*", true},
+		{"Other version", `"6"`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/user/1/cart", nil).WithContext(context.Background())
+			req.Header.Set("If-None-Match", tt.header)
+			assert.Equal(t, tt.expected, notModified(req, 7))
+		})
+	}
+}
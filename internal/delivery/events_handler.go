@@ -0,0 +1,95 @@
+package delivery
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/vestamart/cart/internal/auth"
+	"github.com/vestamart/cart/internal/domain"
+	"github.com/vestamart/cart/internal/events"
+	"github.com/vestamart/cart/internal/problem"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// retryMillis is the reconnection delay suggested to EventSource clients.
+const retryMillis = 3000
+
+type EventsServer struct {
+	hub       *events.Hub
+	heartbeat time.Duration
+}
+
+func NewEventsServer(hub *events.Hub, heartbeat time.Duration) *EventsServer {
+	return &EventsServer{hub: hub, heartbeat: heartbeat}
+}
+
+// CartEventsHandler streams the cart events of a user as Server-Sent Events.
+// A client that reconnects with Last-Event-ID gets the events it missed; if
+// they are no longer buffered it gets a "reset" event and should reload the
+// cart.
+func (e EventsServer) CartEventsHandler(w http.ResponseWriter, r *http.Request) {
+	var path UserPath
+	if errs := bindRequest(r, &path, nil); len(errs) > 0 {
+		problem.Validation(w, r, errs)
+		return
+	}
+
+	if err := auth.AuthorizeUser(r.Context(), path.UserID); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	lastEventID, _ := strconv.ParseUint(r.Header.Get("Last-Event-ID"), 10, 64)
+	sub, replay, complete, err := e.hub.Subscribe(path.UserID, lastEventID)
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+	defer e.hub.Unsubscribe(sub)
+
+	rc := http.NewResponseController(w)
+	_ = rc.SetWriteDeadline(time.Time{})
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	_, _ = fmt.Fprintf(w, "retry: %d\n\n", retryMillis)
+	if !complete {
+		_, _ = io.WriteString(w, "event: reset\ndata: {}\n\n")
+	}
+	for _, event := range replay {
+		writeEvent(w, event)
+	}
+	if err = rc.Flush(); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(e.heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case event, ok := <-sub.C:
+			if !ok {
+				return
+			}
+			writeEvent(w, event)
+		case <-heartbeat.C:
+			_, _ = io.WriteString(w, ": heartbeat\n\n")
+		}
+		if err = rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func writeEvent(w io.Writer, event domain.CartEvent) {
+	data, _ := json.Marshal(event)
+	_, _ = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+}
@@ -0,0 +1,55 @@
+package delivery
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/cart/internal/domain"
+	"github.com/vestamart/cart/internal/events"
+	"github.com/vestamart/cart/internal/mw"
+)
+
+func TestCartEventsHandler(t *testing.T) {
+	hub := events.NewHub(10, 1, time.Minute)
+	hub.Publish(domain.CartEvent{Type: domain.EventItemAdded, UserID: 1, SkuID: 100, Count: 1})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /user/{user_id}/cart/events", NewEventsServer(hub, time.Hour).CartEventsHandler)
+	srv := httptest.NewServer(mw.LoggerHTTP(mux))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/user/1/cart/events", nil)
+	require.NoError(t, err)
+	req.Header.Set("Last-Event-ID", "0")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	// The stream is open, so a second one exceeds the limit of one.
+	second, err := http.Get(srv.URL + "/user/1/cart/events")
+	require.NoError(t, err)
+	second.Body.Close()
+	assert.Equal(t, http.StatusTooManyRequests, second.StatusCode)
+
+	hub.Publish(domain.CartEvent{Type: domain.EventCartCleared, UserID: 1})
+
+	lines := bufio.NewScanner(resp.Body)
+	var got []string
+	for lines.Scan() && len(got) < 2 {
+		if line := lines.Text(); strings.HasPrefix(line, "id: ") || strings.HasPrefix(line, "event: ") {
+			got = append(got, line)
+		}
+	}
+	assert.Equal(t, []string{"id: 2", "event: cart_cleared"}, got)
+}
@@ -0,0 +1,98 @@
+package delivery
+
+import (
+	"context"
+	"github.com/vestamart/cart/internal/app/cart"
+	"github.com/vestamart/cart/internal/domain"
+	"github.com/vestamart/cart/internal/localErr"
+	desc "github.com/vestamart/cart/pkg/api/cart/v1"
+	"google.golang.org/grpc/metadata"
+	"math"
+)
+
+// previewTokenKey is the metadata key of the checkout preview token.
+const previewTokenKey = "x-preview-token"
+
+type GRPCServer struct {
+	desc.UnimplementedCartServer
+	cartService cart.Service
+}
+
+func NewGRPCServer(cartService cart.Service) *GRPCServer {
+	return &GRPCServer{cartService: cartService}
+}
+
+func (s GRPCServer) AddItem(ctx context.Context, request *desc.AddItemRequest) (*desc.AddItemResponse, error) {
+	err := s.cartService.AddToCart(ctx, request.GetSku(), request.GetUser(), uint16(request.GetCount()))
+	if err != nil {
+		return nil, localErr.ToGRPC(err)
+	}
+
+	return &desc.AddItemResponse{}, nil
+}
+
+func (s GRPCServer) RemoveItem(ctx context.Context, request *desc.RemoveItemRequest) (*desc.RemoveItemResponse, error) {
+	if err := s.cartService.RemoveFromCart(ctx, request.GetSku(), request.GetUser()); err != nil {
+		return nil, localErr.ToGRPC(err)
+	}
+
+	return &desc.RemoveItemResponse{}, nil
+}
+
+func (s GRPCServer) ClearCart(ctx context.Context, request *desc.ClearCartRequest) (*desc.ClearCartResponse, error) {
+	if err := s.cartService.ClearCart(ctx, request.GetUser()); err != nil {
+		return nil, localErr.ToGRPC(err)
+	}
+
+	return &desc.ClearCartResponse{}, nil
+}
+
+func (s GRPCServer) ListCart(ctx context.Context, request *desc.ListCartRequest) (*desc.ListCartResponse, error) {
+	userCart, err := s.cartService.GetCart(ctx, request.GetUser())
+	if err != nil {
+		return nil, localErr.ToGRPC(err)
+	}
+
+	totalPrice, err := uint32Amount(userCart.TotalPrice)
+	if err != nil {
+		return nil, localErr.ToGRPC(err)
+	}
+	resp := &desc.ListCartResponse{
+		Items:      make([]*desc.Item, 0, len(userCart.Items)),
+		TotalPrice: totalPrice,
+	}
+	for _, item := range userCart.Items {
+		price, err := uint32Amount(item.Price)
+		if err != nil {
+			return nil, localErr.ToGRPC(err)
+		}
+		resp.Items = append(resp.Items, &desc.Item{
+			Sku:   item.Sku,
+			Name:  item.Name,
+			Count: uint32(item.Count),
+			Price: price,
+		})
+	}
+
+	return resp, nil
+}
+
+// uint32Amount converts m for the uint32 amounts of the gRPC API.
+func uint32Amount(m domain.Money) (uint32, error) {
+	if m.Amount < 0 || m.Amount > math.MaxUint32 {
+		return 0, domain.ErrMoneyOverflow.WithMsg("%d %s does not fit the gRPC API", m.Amount, m.Currency)
+	}
+	return uint32(m.Amount), nil
+}
+
+func (s GRPCServer) Checkout(ctx context.Context, request *desc.CheckoutRequest) (*desc.CheckoutResponse, error) {
+	if token := metadata.ValueFromIncomingContext(ctx, previewTokenKey); len(token) > 0 {
+		ctx = domain.WithPreviewToken(ctx, token[0])
+	}
+	orderID, err := s.cartService.CheckoutCart(ctx, request.GetUser())
+	if err != nil {
+		return nil, localErr.ToGRPC(err)
+	}
+
+	return &desc.CheckoutResponse{OrderId: orderID}, nil
+}
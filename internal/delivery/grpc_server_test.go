@@ -0,0 +1,154 @@
+package delivery
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/cart/internal/app/cart"
+	"github.com/vestamart/cart/internal/app/cart/mock"
+	"github.com/vestamart/cart/internal/auth"
+	"github.com/vestamart/cart/internal/domain"
+	"github.com/vestamart/cart/internal/localErr"
+	"github.com/vestamart/cart/internal/mw"
+	desc "github.com/vestamart/cart/pkg/api/cart/v1"
+	"github.com/vestamart/loms/pkg/api/loms/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newGRPCTestClient(t *testing.T, service *cart.Service, interceptors ...grpc.UnaryServerInterceptor) desc.CartClient {
+	lis := bufconn.Listen(1 << 20)
+	interceptors = append([]grpc.UnaryServerInterceptor{mw.PanicGRPC}, append(interceptors, ValidateGRPC)...)
+	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptors...))
+	desc.RegisterCartServer(srv, NewGRPCServer(*service))
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return desc.NewCartClient(conn)
+}
+
+func TestGRPCServer(t *testing.T) {
+	mc := minimock.NewController(t)
+	repoMock := mock.NewCartRepositoryMock(mc)
+	productMock := mock.NewProductServiceMock(mc)
+	lomsMock := mock.NewLomsClientMock(mc)
+	client := newGRPCTestClient(t, cart.NewCartService(repoMock, productMock, lomsMock))
+	ctx := context.Background()
+
+	t.Run("AddItem invalid request - InvalidArgument", func(t *testing.T) {
+		_, err := client.AddItem(ctx, &desc.AddItemRequest{User: 0, Sku: 1, Count: 1})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("AddItem count over the HTTP limit - InvalidArgument", func(t *testing.T) {
+		_, err := client.AddItem(ctx, &desc.AddItemRequest{User: 1, Sku: 1, Count: maxItemCount + 1})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("AddItem not enough stock - FailedPrecondition", func(t *testing.T) {
+		productMock.ExistItemMock.Return(nil)
+		lomsMock.StocksInfoMock.Return(&loms.StocksInfoResponse{Count: 1}, nil)
+
+		_, err := client.AddItem(ctx, &desc.AddItemRequest{User: 1, Sku: 1003, Count: 5})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("ListCart - success", func(t *testing.T) {
+		repoMock.GetVersionMock.Return(1, nil)
+		repoMock.GetCartMock.Return(map[int64]uint16{1003: 2}, nil)
+		productMock.GetProductMock.Return(&domain.ProductServiceResponse{Name: "Book", Price: 100}, nil)
+
+		resp, err := client.ListCart(ctx, &desc.ListCartRequest{User: 1})
+		require.NoError(t, err)
+		assert.Equal(t, uint32(200), resp.GetTotalPrice())
+		require.Len(t, resp.GetItems(), 1)
+		assert.Equal(t, "Book", resp.GetItems()[0].GetName())
+	})
+
+	t.Run("ClearCart missing cart - NotFound", func(t *testing.T) {
+		repoMock.ClearCartMock.Return(localErr.ErrCartNotFound)
+
+		_, err := client.ClearCart(ctx, &desc.ClearCartRequest{User: 1})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestMaxItemCount_MatchesHTTPTags(t *testing.T) {
+	for _, v := range []any{AddToCartRequest{}, CheckoutItemRequest{}, MoveItemRequest{}} {
+		field, ok := reflect.TypeOf(v).FieldByName("Count")
+		require.True(t, ok)
+		assert.Contains(t, field.Tag.Get("validate"), "max="+strconv.Itoa(maxItemCount), reflect.TypeOf(v).Name())
+	}
+}
+
+func signHS256(t *testing.T, secret []byte, claims map[string]any) string {
+	t.Helper()
+	segment := func(v any) string {
+		raw, err := json.Marshal(v)
+		require.NoError(t, err)
+		return base64.RawURLEncoding.EncodeToString(raw)
+	}
+	input := segment(map[string]string{"alg": "HS256", "typ": "JWT"}) + "." + segment(claims)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(input))
+	return input + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestGRPCServer_Auth(t *testing.T) {
+	secret := []byte("test-secret")
+	keys := auth.NewKeys()
+	keys.AddHMAC("", secret)
+	verifier := auth.NewVerifier(keys, "", "", 0)
+
+	mc := minimock.NewController(t)
+	repoMock := mock.NewCartRepositoryMock(mc)
+	repoMock.ClearCartMock.Return(nil)
+	client := newGRPCTestClient(t, cart.NewCartService(repoMock, mock.NewProductServiceMock(mc), mock.NewLomsClientMock(mc)),
+		mw.AuthGRPC(verifier, "cart:admin"))
+
+	withToken := func(claims map[string]any) context.Context {
+		claims["exp"] = time.Now().Add(time.Hour).Unix()
+		return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+signHS256(t, secret, claims))
+	}
+
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		expectedCode codes.Code
+	}{
+		{"No token", context.Background(), codes.Unauthenticated},
+		{"Forged token", metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer a.b.c"), codes.Unauthenticated},
+		{"Token of another user", withToken(map[string]any{"sub": "7"}), codes.PermissionDenied},
+		{"Own cart", withToken(map[string]any{"sub": "42"}), codes.OK},
+		{"Admin on another cart", withToken(map[string]any{"sub": "1", "scope": "cart:admin"}), codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.ClearCart(tt.ctx, &desc.ClearCartRequest{User: 42})
+			assert.Equal(t, tt.expectedCode, status.Code(err), "%v", err)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/vestamart/cart/internal/localErr"
+	desc "github.com/vestamart/cart/pkg/api/cart/v1"
+	"google.golang.org/grpc"
+)
+
+// maxItemCount caps the count of one add request, over HTTP and gRPC alike.
+// The validate tags of the HTTP requests spell it out as max=1000.
+const maxItemCount = 1000
+
+// ValidateGRPC rejects cart.v1 requests that fail validateGRPC with
+// InvalidArgument before they reach the service.
+func ValidateGRPC(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := validateGRPC(req); err != nil {
+		return nil, localErr.ToGRPC(localErr.ErrInvalidArgument.WithMsg("%v", err))
+	}
+
+	return handler(ctx, req)
+}
+
+func validateGRPC(req any) error {
+	switch r := req.(type) {
+	case *desc.AddItemRequest:
+		return errors.Join(validateUser(r.GetUser()), validateSku(r.GetSku()), validateCount(r.GetCount()))
+	case *desc.RemoveItemRequest:
+		return errors.Join(validateUser(r.GetUser()), validateSku(r.GetSku()))
+	case *desc.ClearCartRequest:
+		return validateUser(r.GetUser())
+	case *desc.ListCartRequest:
+		return validateUser(r.GetUser())
+	case *desc.CheckoutRequest:
+		return validateUser(r.GetUser())
+	}
+	return nil
+}
+
+func validateUser(user uint64) error {
+	if user < 1 {
+		return errors.New("user: must be greater than 0")
+	}
+	return nil
+}
+
+func validateSku(sku int64) error {
+	if sku < 1 {
+		return errors.New("sku: must be greater than 0")
+	}
+	return nil
+}
+
+func validateCount(count uint32) error {
+	if count < 1 || count > maxItemCount {
+		return fmt.Errorf("count: must be between 1 and %d", maxItemCount)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package delivery
+
+import (
+	"encoding/json"
+	"github.com/vestamart/cart/internal/auth"
+	"github.com/vestamart/cart/internal/domain"
+	"github.com/vestamart/cart/internal/problem"
+	"net/http"
+)
+
+// guestTokenHeader carries the token of a guest cart.
+const guestTokenHeader = "X-Guest-Token"
+
+// AddToGuestCartParams Path params and headers of the guest add endpoint. The
+// token is optional: a new one is issued on the first add.
+type AddToGuestCartParams struct {
+	SkuID int64  `path:"sku_id" validate:"min=1"`
+	Token string `header:"X-Guest-Token" validate:"max=64"`
+}
+
+// GuestItemParams Path params and headers of guest item endpoints
+type GuestItemParams struct {
+	SkuID int64  `path:"sku_id" validate:"min=1"`
+	Token string `header:"X-Guest-Token" validate:"required,max=64"`
+}
+
+// GuestParams Headers of guest cart endpoints
+type GuestParams struct {
+	Token string `header:"X-Guest-Token" validate:"required,max=64"`
+}
+
+type AddToGuestCartResponse struct {
+	GuestToken string `json:"guest_token"`
+}
+
+// MergeCartRequest Request form
+type MergeCartRequest struct {
+	GuestToken string `json:"guest_token" validate:"required,max=64"`
+	Policy     string `json:"policy" validate:"max=16"`
+}
+
+type MergeCartResponse struct {
+	GetCartResponse
+	Adjusted []domain.MergeAdjustment `json:"adjusted"`
+}
+
+func (s Server) AddToGuestCartHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var params AddToGuestCartParams
+	var req AddToCartRequest
+	if errs := bindRequest(r, &params, &req); len(errs) > 0 {
+		problem.Validation(w, r, errs)
+		return
+	}
+
+	token, err := s.cartService.AddToGuestCart(r.Context(), params.Token, params.SkuID, req.Count)
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	w.Header().Set(guestTokenHeader, token)
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(AddToGuestCartResponse{GuestToken: token})
+}
+
+func (s Server) RemoveFromGuestCartHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var params GuestItemParams
+	if errs := bindRequest(r, &params, nil); len(errs) > 0 {
+		problem.Validation(w, r, errs)
+		return
+	}
+
+	if err := s.cartService.RemoveFromGuestCart(r.Context(), params.Token, params.SkuID); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s Server) GetGuestCartHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var params GuestParams
+	if errs := bindRequest(r, &params, nil); len(errs) > 0 {
+		problem.Validation(w, r, errs)
+		return
+	}
+
+	cart, err := s.cartService.GetGuestCart(r.Context(), params.Token)
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(cartResponse(cart))
+}
+
+func (s Server) MergeCartHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var path UserPath
+	var req MergeCartRequest
+	if errs := bindRequest(r, &path, &req); len(errs) > 0 {
+		problem.Validation(w, r, errs)
+		return
+	}
+
+	if err := auth.AuthorizeUser(r.Context(), path.UserID); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	result, err := s.cartService.MergeGuestCart(withIfMatch(r), path.UserID, req.GuestToken, domain.MergePolicy(req.Policy))
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	resp := MergeCartResponse{
+		GetCartResponse: cartResponse(result.Cart),
+		Adjusted:        result.Adjusted,
+	}
+	if resp.Adjusted == nil {
+		resp.Adjusted = []domain.MergeAdjustment{}
+	}
+
+	w.Header().Set("ETag", cartETag(result.Cart.Version))
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,54 @@
+package delivery
+
+import (
+	"encoding/json"
+	"github.com/vestamart/cart/internal/health"
+	"github.com/vestamart/cart/internal/problem"
+	"net/http"
+)
+
+type HealthServer struct {
+	checker *health.Checker
+}
+
+func NewHealthServer(checker *health.Checker) *HealthServer {
+	return &HealthServer{checker: checker}
+}
+
+// SetReadinessRequest Request form
+type SetReadinessRequest struct {
+	Ready bool `json:"ready"`
+}
+
+func (h HealthServer) LivenessHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(map[string]string{"status": health.StatusUp})
+}
+
+func (h HealthServer) ReadinessHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	report, ok := h.checker.Readiness(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+	_ = json.NewEncoder(w).Encode(report)
+}
+
+func (h HealthServer) SetReadinessHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var req SetReadinessRequest
+	if errs := bindRequest(r, nil, &req); len(errs) > 0 {
+		problem.Validation(w, r, errs)
+		return
+	}
+
+	h.checker.SetReady(req.Ready)
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(req)
+}
@@ -4,41 +4,110 @@ import (
 	"encoding/json"
 	"errors"
 	"github.com/vestamart/cart/internal/app/cart"
+	"github.com/vestamart/cart/internal/app/wishlist"
+	"github.com/vestamart/cart/internal/auth"
+	"github.com/vestamart/cart/internal/domain"
 	"github.com/vestamart/cart/internal/localErr"
+	"github.com/vestamart/cart/internal/problem"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
-	"strconv"
+	"time"
 )
 
 type GetCartResponse struct {
 	Items      []GetCartItemResponse `json:"items"`
-	TotalPrice uint32                `json:"total_price"`
+	Subtotal   MoneyResponse         `json:"subtotal"`
+	Discounts  []DiscountResponse    `json:"discounts,omitempty"`
+	Coupon     string                `json:"coupon,omitempty"`
+	TotalPrice MoneyResponse         `json:"total_price"`
+	Rate       *ExchangeRateResponse `json:"rate,omitempty"`
+}
+
+// ExchangeRateResponse The rate the amounts were converted with and where it
+// came from
+type ExchangeRateResponse struct {
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	Rate      string    `json:"rate"`
+	Source    string    `json:"source"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 type GetCartItemResponse struct {
-	Sku   int64  `json:"sku_id"`
-	Name  string `json:"name"`
-	Count uint16 `json:"count"`
-	Price uint32 `json:"price"`
+	Sku       int64          `json:"sku_id"`
+	Name      string         `json:"name"`
+	Count     uint16         `json:"count"`
+	Price     MoneyResponse  `json:"price"`
+	Discount  *MoneyResponse `json:"discount,omitempty"`
+	Promotion string         `json:"promotion,omitempty"`
+}
+
+type DiscountResponse struct {
+	Promotion string        `json:"promotion"`
+	Amount    MoneyResponse `json:"amount"`
+}
+
+// MoneyResponse An amount in whole rubles for RUB, the unit of the product
+// prices
+type MoneyResponse struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
 }
 
 type Server struct {
 	cartService cart.Service
+	wishlist    *wishlist.Service
 }
 
 func NewServer(cartService cart.Service) *Server {
 	return &Server{cartService: cartService}
 }
 
+// WithWishlist lets AddToCartHandler wishlist skus that are out of stock.
+func (s *Server) WithWishlist(wishlist *wishlist.Service) *Server {
+	s.wishlist = wishlist
+	return s
+}
+
+// AddToCartParams Path and query params of the add endpoint. With
+// on_out_of_stock=wishlist a sku without enough stock is wishlisted instead
+// of rejected.
+type AddToCartParams struct {
+	UserID       uint64 `path:"user_id" validate:"min=1"`
+	SkuID        int64  `path:"sku_id" validate:"min=1"`
+	OnOutOfStock string `query:"on_out_of_stock" validate:"max=16"`
+}
+
+// GetCartParams Path and query params of the cart endpoint. With currency
+// the amounts are converted with the exchange rates table.
+type GetCartParams struct {
+	UserID   uint64 `path:"user_id" validate:"min=1"`
+	Currency string `query:"currency" validate:"max=3"`
+}
+
 // AddToCartRequest Request form
 type AddToCartRequest struct {
-	Count uint16 `json:"count"`
+	Count uint16 `json:"count" validate:"min=1,max=1000"`
 }
 
-// GetCartByUserID
+// GetCartByUserID Checkout request form. Without items the whole cart is
+// checked out. PreviewToken comes from the checkout preview.
 type GetCartByUserIDRequest struct {
-	UserID uint64 `json:"user"`
+	UserID       uint64                `json:"user" validate:"min=1"`
+	Items        []CheckoutItemRequest `json:"items" validate:"max=100"`
+	PreviewToken string                `json:"preview_token" validate:"max=128"`
+}
+
+// CheckoutItemRequest selects a sku for a partial checkout. A zero count
+// buys all of it.
+type CheckoutItemRequest struct {
+	SkuID int64  `json:"sku_id" validate:"min=1"`
+	Count uint16 `json:"count" validate:"max=1000"`
+}
+
+type CheckoutResponse struct {
+	OrderID int64 `json:"order_id"`
 }
 
 // Server Handlers
@@ -46,49 +115,43 @@ type GetCartByUserIDRequest struct {
 func (s Server) AddToCartHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 
-	rawUserID := r.PathValue("user_id")
-	userID, err := strconv.ParseUint(rawUserID, 10, 64)
-	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		return
-	}
-
-	RawSkuID := r.PathValue("sku_id")
-	skuID, err := strconv.ParseInt(RawSkuID, 10, 64)
-	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		return
-	}
-
 	defer func(Body io.ReadCloser) {
 		err := Body.Close()
 		if err != nil {
-			log.Println("DROP ON HTTP:", err)
+			slog.Warn("DROP ON HTTP", "err", err)
 		}
 	}(r.Body)
 
+	var params AddToCartParams
 	var addToCartRequest AddToCartRequest
-	if err = json.NewDecoder(r.Body).Decode(&addToCartRequest); err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+	errs := bindRequest(r, &params, &addToCartRequest)
+	if params.OnOutOfStock != "" && params.OnOutOfStock != "wishlist" {
+		errs.Add("on_out_of_stock", "must be wishlist")
+	}
+	if len(errs) > 0 {
+		problem.Validation(w, r, errs)
 		return
 	}
-	if addToCartRequest.Count < 1 {
-		w.WriteHeader(http.StatusBadRequest)
+
+	if err := auth.AuthorizeUser(r.Context(), params.UserID); err != nil {
+		problem.Error(w, r, err)
 		return
 	}
-	err = s.cartService.AddToCart(r.Context(), skuID, userID, addToCartRequest.Count)
-	if err != nil {
-		if errors.Is(err, localErr.ErrSkuNotExist) {
-			w.WriteHeader(http.StatusPreconditionFailed)
-			return
-		}
-		if errors.Is(err, localErr.ItemNotEnoughErr) {
-			w.WriteHeader(http.StatusPreconditionFailed)
+
+	err := s.cartService.AddToCart(withIfMatch(r), params.SkuID, params.UserID, addToCartRequest.Count)
+	if errors.Is(err, localErr.ItemNotEnoughErr) && params.OnOutOfStock == "wishlist" && s.wishlist != nil {
+		item, err := s.wishlist.Add(r.Context(), params.UserID, params.SkuID)
+		if err != nil {
+			problem.Error(w, r, err)
 			return
 		}
-		w.WriteHeader(http.StatusBadRequest)
+		w.WriteHeader(http.StatusAccepted)
+		_ = json.NewEncoder(w).Encode(wishlistItemResponse(item))
+		return
+	}
+	if err != nil {
+		problem.Error(w, r, err)
 		return
-
 	}
 
 	w.WriteHeader(http.StatusOK)
@@ -97,84 +160,178 @@ func (s Server) AddToCartHandler(w http.ResponseWriter, r *http.Request) {
 func (s Server) RemoveFromCartHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 
-	rawUserID := r.PathValue("user_id")
-	userID, err := strconv.ParseUint(rawUserID, 10, 64)
-	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+	var path CartItemPath
+	if errs := bindRequest(r, &path, nil); len(errs) > 0 {
+		problem.Validation(w, r, errs)
 		return
 	}
 
-	RawSkuID := r.PathValue("sku_id")
-	skuID, err := strconv.ParseInt(RawSkuID, 10, 64)
+	if err := auth.AuthorizeUser(r.Context(), path.UserID); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	err := s.cartService.RemoveFromCart(withIfMatch(r), path.SkuID, path.UserID)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		problem.Error(w, r, err)
 		return
 	}
 
-	err = s.cartService.RemoveFromCart(r.Context(), skuID, userID)
+	w.WriteHeader(http.StatusOK)
 }
 
 func (s Server) ClearCartHandler(w http.ResponseWriter, r *http.Request) {
-	rawUserID := r.PathValue("user_id")
-	userID, err := strconv.ParseUint(rawUserID, 10, 64)
-	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+	w.Header().Set("Content-Type", "application/json")
+
+	var path UserPath
+	if errs := bindRequest(r, &path, nil); len(errs) > 0 {
+		problem.Validation(w, r, errs)
+		return
+	}
+
+	if err := auth.AuthorizeUser(r.Context(), path.UserID); err != nil {
+		problem.Error(w, r, err)
 		return
 	}
 
-	err = s.cartService.ClearCart(r.Context(), userID)
+	err := s.cartService.ClearCart(withIfMatch(r), path.UserID)
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
 
+	w.WriteHeader(http.StatusOK)
 }
 
 func (s Server) GetCartHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 
-	rawUserID := r.PathValue("user_id")
-	userID, err := strconv.ParseUint(rawUserID, 10, 64)
-	if err != nil || userID < 1 {
-		w.WriteHeader(http.StatusBadRequest)
+	var params GetCartParams
+	if errs := bindRequest(r, &params, nil); len(errs) > 0 {
+		problem.Validation(w, r, errs)
+		return
+	}
+
+	if err := auth.AuthorizeUser(r.Context(), params.UserID); err != nil {
+		problem.Error(w, r, err)
 		return
 	}
 
-	cart, err := s.cartService.GetCart(r.Context(), userID)
+	// The version does not cover the rates, so converted carts are always
+	// sent in full.
+	if r.Header.Get("If-None-Match") != "" && params.Currency == "" {
+		version, err := s.cartService.CartVersion(r.Context(), params.UserID)
+		if err != nil {
+			problem.Error(w, r, err)
+			return
+		}
+		if notModified(r, version) {
+			w.Header().Set("ETag", cartETag(version))
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+
+	cart, err := s.cartService.GetCartIn(r.Context(), params.UserID, params.Currency)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		problem.Error(w, r, err)
 		return
 	}
 
+	w.Header().Set("ETag", cartETag(cart.Version))
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(cartResponse(cart))
+}
+
+func cartResponse(cart *domain.UserCart) GetCartResponse {
 	resp := GetCartResponse{
-		Items:      make([]GetCartItemResponse, 0, len(cart.Items)),
-		TotalPrice: 0,
+		Items:      cartItemsResponse(cart.Items),
+		Subtotal:   MoneyResponse(cart.Subtotal),
+		Discounts:  discountsResponse(cart.Discounts),
+		Coupon:     cart.Coupon,
+		TotalPrice: MoneyResponse(cart.TotalPrice),
 	}
+	if cart.Rate != nil {
+		resp.Rate = &ExchangeRateResponse{
+			From:      cart.Rate.From,
+			To:        cart.Rate.To,
+			Rate:      cart.Rate.Rate,
+			Source:    cart.Rate.Source,
+			UpdatedAt: cart.Rate.UpdatedAt,
+		}
+	}
+	return resp
+}
 
-	for _, item := range cart.Items {
-		resp.Items = append(resp.Items, GetCartItemResponse{
-			Sku:   item.Sku,
-			Name:  item.Name,
-			Count: item.Count,
-			Price: item.Price,
-		})
+func cartItemsResponse(items []domain.CartItem) []GetCartItemResponse {
+	resp := make([]GetCartItemResponse, 0, len(items))
+	for _, item := range items {
+		resp = append(resp, cartItemResponse(item))
 	}
-	resp.TotalPrice = cart.TotalPrice
+	return resp
+}
 
-	w.WriteHeader(http.StatusOK)
-	_ = json.NewEncoder(w).Encode(resp)
+func cartItemResponse(item domain.CartItem) GetCartItemResponse {
+	line := GetCartItemResponse{
+		Sku:       item.Sku,
+		Name:      item.Name,
+		Count:     item.Count,
+		Price:     MoneyResponse(item.Price),
+		Promotion: item.Promotion,
+	}
+	if !item.Discount.IsZero() {
+		discount := MoneyResponse(item.Discount)
+		line.Discount = &discount
+	}
+	return line
+}
+
+func discountsResponse(discounts []domain.Discount) []DiscountResponse {
+	var resp []DiscountResponse
+	for _, d := range discounts {
+		resp = append(resp, DiscountResponse{Promotion: d.Promotion, Amount: MoneyResponse(d.Amount)})
+	}
+	return resp
 }
 
 func (s Server) GetCartByUserIDHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 
 	var getCartByUserID GetCartByUserIDRequest
-	if err := json.NewDecoder(r.Body).Decode(&getCartByUserID); err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+	if errs := bindRequest(r, nil, &getCartByUserID); len(errs) > 0 {
+		problem.Validation(w, r, errs)
+		return
+	}
+
+	if err := auth.AuthorizeUser(r.Context(), getCartByUserID.UserID); err != nil {
+		problem.Error(w, r, err)
 		return
 	}
 
-	_, err := s.cartService.CheckoutCart(r.Context(), getCartByUserID.UserID)
+	ctx := withIfMatch(r)
+	if getCartByUserID.PreviewToken != "" {
+		ctx = domain.WithPreviewToken(ctx, getCartByUserID.PreviewToken)
+	}
+	var orderID int64
+	var err error
+	if len(getCartByUserID.Items) > 0 {
+		orderID, err = s.cartService.CheckoutItems(ctx, getCartByUserID.UserID, checkoutLines(getCartByUserID.Items))
+	} else {
+		orderID, err = s.cartService.CheckoutCart(ctx, getCartByUserID.UserID)
+	}
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		problem.Error(w, r, err)
 		return
 	}
 
 	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(CheckoutResponse{OrderID: orderID})
+}
+
+func checkoutLines(items []CheckoutItemRequest) []domain.CheckoutLine {
+	lines := make([]domain.CheckoutLine, 0, len(items))
+	for _, item := range items {
+		lines = append(lines, domain.CheckoutLine{SkuID: item.SkuID, Count: item.Count})
+	}
+	return lines
 }
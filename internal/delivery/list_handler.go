@@ -0,0 +1,216 @@
+package delivery
+
+import (
+	"encoding/json"
+	"github.com/vestamart/cart/internal/auth"
+	"github.com/vestamart/cart/internal/domain"
+	"github.com/vestamart/cart/internal/problem"
+	"net/http"
+)
+
+// ListPath Path params of list endpoints
+type ListPath struct {
+	UserID uint64 `path:"user_id" validate:"min=1"`
+	List   string `path:"list" validate:"required,max=32"`
+}
+
+// CheckoutListParams Path params and header of the list checkout. The cart
+// list needs the token of a checkout preview when previews are required.
+type CheckoutListParams struct {
+	UserID       uint64 `path:"user_id" validate:"min=1"`
+	List         string `path:"list" validate:"required,max=32"`
+	PreviewToken string `header:"X-Preview-Token" validate:"max=128"`
+}
+
+// ListItemPath Path params of list item endpoints
+type ListItemPath struct {
+	UserID uint64 `path:"user_id" validate:"min=1"`
+	List   string `path:"list" validate:"required,max=32"`
+	SkuID  int64  `path:"sku_id" validate:"min=1"`
+}
+
+// MoveItemRequest Request form. A zero count moves all of the sku.
+type MoveItemRequest struct {
+	SkuID int64  `json:"sku_id" validate:"min=1"`
+	To    string `json:"to" validate:"required,max=32"`
+	Count uint16 `json:"count" validate:"max=1000"`
+}
+
+type ListSummaryResponse struct {
+	Name  string `json:"name"`
+	Items int    `json:"items"`
+	Count int    `json:"count"`
+}
+
+func (s Server) ListsHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var path UserPath
+	if errs := bindRequest(r, &path, nil); len(errs) > 0 {
+		problem.Validation(w, r, errs)
+		return
+	}
+
+	if err := auth.AuthorizeUser(r.Context(), path.UserID); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	lists, err := s.cartService.Lists(r.Context(), path.UserID)
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	resp := make([]ListSummaryResponse, 0, len(lists))
+	for _, list := range lists {
+		resp = append(resp, ListSummaryResponse{Name: list.Name, Items: list.Items, Count: list.Count})
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (s Server) GetListHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var path ListPath
+	if errs := bindRequest(r, &path, nil); len(errs) > 0 {
+		problem.Validation(w, r, errs)
+		return
+	}
+
+	if err := auth.AuthorizeUser(r.Context(), path.UserID); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	list, err := s.cartService.GetList(r.Context(), path.UserID, path.List)
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	w.Header().Set("ETag", cartETag(list.Version))
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(cartResponse(list))
+}
+
+func (s Server) AddToListHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var path ListItemPath
+	var req AddToCartRequest
+	if errs := bindRequest(r, &path, &req); len(errs) > 0 {
+		problem.Validation(w, r, errs)
+		return
+	}
+
+	if err := auth.AuthorizeUser(r.Context(), path.UserID); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	if err := s.cartService.AddToList(withIfMatch(r), path.UserID, path.List, path.SkuID, req.Count); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s Server) RemoveFromListHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var path ListItemPath
+	if errs := bindRequest(r, &path, nil); len(errs) > 0 {
+		problem.Validation(w, r, errs)
+		return
+	}
+
+	if err := auth.AuthorizeUser(r.Context(), path.UserID); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	if err := s.cartService.RemoveFromList(withIfMatch(r), path.UserID, path.List, path.SkuID); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s Server) MoveItemHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var path ListPath
+	var req MoveItemRequest
+	if errs := bindRequest(r, &path, &req); len(errs) > 0 {
+		problem.Validation(w, r, errs)
+		return
+	}
+
+	if err := auth.AuthorizeUser(r.Context(), path.UserID); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	err := s.cartService.MoveItem(withIfMatch(r), path.UserID, path.List, req.To, req.SkuID, req.Count)
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s Server) DeleteListHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var path ListPath
+	if errs := bindRequest(r, &path, nil); len(errs) > 0 {
+		problem.Validation(w, r, errs)
+		return
+	}
+
+	if err := auth.AuthorizeUser(r.Context(), path.UserID); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	if err := s.cartService.DeleteList(withIfMatch(r), path.UserID, path.List); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s Server) CheckoutListHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var params CheckoutListParams
+	if errs := bindRequest(r, &params, nil); len(errs) > 0 {
+		problem.Validation(w, r, errs)
+		return
+	}
+
+	if err := auth.AuthorizeUser(r.Context(), params.UserID); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	ctx := withIfMatch(r)
+	if params.PreviewToken != "" {
+		ctx = domain.WithPreviewToken(ctx, params.PreviewToken)
+	}
+	orderID, err := s.cartService.CheckoutList(ctx, params.UserID, params.List)
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(CheckoutResponse{OrderID: orderID})
+}
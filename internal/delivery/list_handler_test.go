@@ -0,0 +1,59 @@
+package delivery
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/cart/internal/app/cart"
+	"github.com/vestamart/cart/internal/app/cart/mock"
+	"github.com/vestamart/cart/internal/domain"
+	"github.com/vestamart/cart/internal/problem"
+)
+
+func TestCheckoutListHandler_RequirePreview(t *testing.T) {
+	mc := minimock.NewController(t)
+	repoMock := mock.NewCartRepositoryMock(mc)
+	productMock := mock.NewProductServiceMock(mc)
+	lomsMock := mock.NewLomsClientMock(mc)
+	service := cart.NewCartService(repoMock, productMock, lomsMock).
+		WithCheckoutPreview([]byte("secret"), time.Minute, true)
+
+	mux := http.NewServeMux()
+	NewRouter(NewServer(*service), &HealthServer{}, &EventsServer{}, &WebhooksServer{}, &AbandonedServer{}, &WishlistServer{}).SetupRoutes(mux)
+
+	tests := []struct {
+		name         string
+		token        string
+		expectedCode string
+	}{
+		{name: "No token - preview required", expectedCode: "preview_required"},
+		{name: "Forged token - preview stale", token: "9999999999.00", expectedCode: "preview_stale"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock.GetVersionMock.Return(3, nil)
+			repoMock.GetCartMock.Return(map[int64]uint16{123: 2}, nil)
+			productMock.GetProductMock.Return(&domain.ProductServiceResponse{Name: "Test Product", Price: 100}, nil)
+
+			req := httptest.NewRequest(http.MethodPost, "/user/456/lists/cart/checkout", nil)
+			if tt.token != "" {
+				req.Header.Set("X-Preview-Token", tt.token)
+			}
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, req)
+
+			// No order is created: OrderCreate has no expectation.
+			assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+			var body problem.Problem
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+			assert.Equal(t, tt.expectedCode, body.Code)
+		})
+	}
+}
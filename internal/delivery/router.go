@@ -1,21 +1,137 @@
 package delivery
 
 import (
+	"github.com/vestamart/cart/internal/auth"
+	"github.com/vestamart/cart/internal/mw"
+	"github.com/vestamart/cart/internal/problem"
 	"net/http"
 )
 
 type Router struct {
-	server *Server
+	server    *Server
+	health    *HealthServer
+	events    *EventsServer
+	hooks     *WebhooksServer
+	abandoned *AbandonedServer
+	wishlist  *WishlistServer
+	auth      func(http.Handler) http.Handler
+	limit     func(mw.RouteClass) func(http.Handler) http.Handler
 }
 
-func NewRouter(server *Server) *Router {
-	return &Router{server: server}
+func NewRouter(server *Server, health *HealthServer, events *EventsServer, hooks *WebhooksServer, abandoned *AbandonedServer, wishlist *WishlistServer) *Router {
+	return &Router{server: server, health: health, events: events, hooks: hooks, abandoned: abandoned, wishlist: wishlist}
+}
+
+// WithAuth requires a valid token on every non-public route.
+func (r *Router) WithAuth(mw func(http.Handler) http.Handler) *Router {
+	r.auth = mw
+	return r
+}
+
+// WithRateLimit limits every route that has a route class.
+func (r *Router) WithRateLimit(limit func(mw.RouteClass) func(http.Handler) http.Handler) *Router {
+	r.limit = limit
+	return r
+}
+
+type access int
+
+const (
+	accessPublic access = iota
+	accessUser
+	accessAdmin
+)
+
+// Request body limits. Routes without a body still accept a few bytes so
+// that clients sending an empty JSON object are not rejected.
+const (
+	maxJSONBody     = 1 << 10
+	maxCheckoutBody = 8 << 10
+	maxAdminBody    = 8 << 10
+	maxEmptyBody    = 64
+)
+
+type route struct {
+	pattern string
+	handler http.HandlerFunc
+	access  access
+	class   mw.RouteClass
+	maxBody int64
+}
+
+// routes lists every registered endpoint. Each of them must be described in
+// the OpenAPI spec.
+func (r *Router) routes() []route {
+	return []route{
+		{"POST /user/{user_id}/cart/{sku_id}", r.server.AddToCartHandler, accessUser, mw.ClassWrite, maxJSONBody},
+		{"DELETE /user/{user_id}/cart/{sku_id}", r.server.RemoveFromCartHandler, accessUser, mw.ClassWrite, maxEmptyBody},
+		{"DELETE /user/{user_id}/cart", r.server.ClearCartHandler, accessUser, mw.ClassWrite, maxEmptyBody},
+		{"GET /user/{user_id}/cart", r.server.GetCartHandler, accessUser, mw.ClassRead, maxEmptyBody},
+		{"GET /user/{user_id}/cart/events", r.events.CartEventsHandler, accessUser, mw.ClassRead, maxEmptyBody},
+		{"POST /user/{user_id}/cart/merge", r.server.MergeCartHandler, accessUser, mw.ClassWrite, maxJSONBody},
+		{"POST /user/{user_id}/cart/coupon", r.server.ApplyCouponHandler, accessUser, mw.ClassWrite, maxJSONBody},
+		{"DELETE /user/{user_id}/cart/coupon", r.server.RemoveCouponHandler, accessUser, mw.ClassWrite, maxEmptyBody},
+		{"GET /user/{user_id}/lists", r.server.ListsHandler, accessUser, mw.ClassRead, maxEmptyBody},
+		{"GET /user/{user_id}/lists/{list}", r.server.GetListHandler, accessUser, mw.ClassRead, maxEmptyBody},
+		{"DELETE /user/{user_id}/lists/{list}", r.server.DeleteListHandler, accessUser, mw.ClassWrite, maxEmptyBody},
+		{"POST /user/{user_id}/lists/{list}/{sku_id}", r.server.AddToListHandler, accessUser, mw.ClassWrite, maxJSONBody},
+		{"DELETE /user/{user_id}/lists/{list}/{sku_id}", r.server.RemoveFromListHandler, accessUser, mw.ClassWrite, maxEmptyBody},
+		{"POST /user/{user_id}/lists/{list}/move", r.server.MoveItemHandler, accessUser, mw.ClassWrite, maxJSONBody},
+		{"POST /user/{user_id}/lists/{list}/checkout", r.server.CheckoutListHandler, accessUser, mw.ClassCheckout, maxEmptyBody},
+		{"GET /user/{user_id}/wishlist", r.wishlist.ListWishlistHandler, accessUser, mw.ClassRead, maxEmptyBody},
+		{"POST /user/{user_id}/wishlist/{sku_id}", r.wishlist.AddToWishlistHandler, accessUser, mw.ClassWrite, maxEmptyBody},
+		{"DELETE /user/{user_id}/wishlist/{sku_id}", r.wishlist.RemoveFromWishlistHandler, accessUser, mw.ClassWrite, maxEmptyBody},
+		{"POST /cart/checkout", r.server.GetCartByUserIDHandler, accessUser, mw.ClassCheckout, maxCheckoutBody},
+		{"POST /cart/checkout/preview", r.server.PreviewCheckoutHandler, accessUser, mw.ClassCheckout, maxCheckoutBody},
+
+		{"POST /guest/cart/{sku_id}", r.server.AddToGuestCartHandler, accessPublic, mw.ClassWrite, maxJSONBody},
+		{"DELETE /guest/cart/{sku_id}", r.server.RemoveFromGuestCartHandler, accessPublic, mw.ClassWrite, maxEmptyBody},
+		{"GET /guest/cart", r.server.GetGuestCartHandler, accessPublic, mw.ClassRead, maxEmptyBody},
+
+		{"GET /healthz", r.health.LivenessHandler, accessPublic, "", maxEmptyBody},
+		{"GET /readyz", r.health.ReadinessHandler, accessPublic, "", maxEmptyBody},
+		{"PUT /admin/readiness", r.health.SetReadinessHandler, accessAdmin, "", maxJSONBody},
+
+		{"POST /admin/webhooks", r.hooks.CreateWebhookHandler, accessAdmin, "", maxAdminBody},
+		{"GET /admin/webhooks", r.hooks.ListWebhooksHandler, accessAdmin, "", maxEmptyBody},
+		{"DELETE /admin/webhooks/{webhook_id}", r.hooks.DeleteWebhookHandler, accessAdmin, "", maxEmptyBody},
+		{"GET /admin/webhooks/{webhook_id}/deliveries", r.hooks.WebhookDeliveriesHandler, accessAdmin, "", maxEmptyBody},
+
+		{"GET /admin/abandoned-carts", r.abandoned.AbandonedCartsHandler, accessAdmin, "", maxEmptyBody},
+
+		{"GET /openapi.json", OpenAPIHandler, accessPublic, "", maxEmptyBody},
+		{"GET /docs", SwaggerUIHandler, accessPublic, "", maxEmptyBody},
+		{"GET /docs/swagger-ui/{file}", SwaggerAssetHandler, accessPublic, "", maxEmptyBody},
+	}
 }
 
 func (r *Router) SetupRoutes(mux *http.ServeMux) {
-	mux.HandleFunc("POST /user/{user_id}/cart/{sku_id}", r.server.AddToCartHandler)
-	mux.HandleFunc("DELETE /user/{user_id}/cart/{sku_id}", r.server.RemoveFromCartHandler)
-	mux.HandleFunc("DELETE /user/{user_id}/cart", r.server.ClearCartHandler)
-	mux.HandleFunc("GET /user/{user_id}/cart", r.server.GetCartHandler)
-	mux.HandleFunc("POST /cart/checkout", r.server.GetCartByUserIDHandler)
+	for _, rt := range r.routes() {
+		mux.Handle(rt.pattern, r.protect(rt))
+	}
+}
+
+func (r *Router) protect(rt route) http.Handler {
+	h := mw.MaxBytes(rt.maxBody)(rt.handler)
+	// The limiter runs after auth so it can key buckets by the token subject.
+	if rt.class != "" && r.limit != nil {
+		h = r.limit(rt.class)(h)
+	}
+	if rt.access == accessAdmin {
+		h = requireAdmin(h)
+	}
+	if rt.access != accessPublic && r.auth != nil {
+		h = r.auth(h)
+	}
+	return h
+}
+
+func requireAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := auth.AuthorizeAdmin(r.Context()); err != nil {
+			problem.Error(w, r, err)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
 }
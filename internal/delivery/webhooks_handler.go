@@ -0,0 +1,118 @@
+package delivery
+
+import (
+	"encoding/json"
+	"github.com/vestamart/cart/internal/problem"
+	"github.com/vestamart/cart/internal/webhook"
+	"net/http"
+	"time"
+)
+
+type WebhooksServer struct {
+	registry   *webhook.Registry
+	dispatcher *webhook.Dispatcher
+	deliveries *webhook.DeliveryLog
+}
+
+func NewWebhooksServer(registry *webhook.Registry, dispatcher *webhook.Dispatcher, deliveries *webhook.DeliveryLog) *WebhooksServer {
+	return &WebhooksServer{registry: registry, dispatcher: dispatcher, deliveries: deliveries}
+}
+
+// CreateWebhookRequest Request form
+type CreateWebhookRequest struct {
+	URL    string   `json:"url" validate:"required,max=2048"`
+	Events []string `json:"events" validate:"min=1,max=3"`
+	Secret string   `json:"secret" validate:"max=256"`
+}
+
+// WebhookPath Path params of webhook endpoints
+type WebhookPath struct {
+	ID string `path:"webhook_id" validate:"required"`
+}
+
+type WebhookResponse struct {
+	ID          string    `json:"id"`
+	URL         string    `json:"url"`
+	Events      []string  `json:"events"`
+	CreatedAt   time.Time `json:"created_at"`
+	CircuitOpen bool      `json:"circuit_open"`
+	// Secret is only returned when the subscription is created.
+	Secret string `json:"secret,omitempty"`
+}
+
+func (s WebhooksServer) response(sub webhook.Subscription) WebhookResponse {
+	return WebhookResponse{
+		ID:          sub.ID,
+		URL:         sub.URL,
+		Events:      sub.Events,
+		CreatedAt:   sub.CreatedAt,
+		CircuitOpen: s.dispatcher.Open(sub.ID),
+	}
+}
+
+func (s WebhooksServer) CreateWebhookHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var req CreateWebhookRequest
+	if errs := bindRequest(r, nil, &req); len(errs) > 0 {
+		problem.Validation(w, r, errs)
+		return
+	}
+
+	sub, err := s.registry.Create(req.URL, req.Events, req.Secret)
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	resp := s.response(sub)
+	resp.Secret = sub.Secret
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (s WebhooksServer) ListWebhooksHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	subs := s.registry.List()
+	resp := make([]WebhookResponse, 0, len(subs))
+	for _, sub := range subs {
+		resp = append(resp, s.response(sub))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (s WebhooksServer) DeleteWebhookHandler(w http.ResponseWriter, r *http.Request) {
+	var path WebhookPath
+	if errs := bindRequest(r, &path, nil); len(errs) > 0 {
+		problem.Validation(w, r, errs)
+		return
+	}
+
+	if err := s.dispatcher.DeleteSubscription(path.ID); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s WebhooksServer) WebhookDeliveriesHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var path WebhookPath
+	if errs := bindRequest(r, &path, nil); len(errs) > 0 {
+		problem.Validation(w, r, errs)
+		return
+	}
+
+	if _, err := s.registry.Get(path.ID); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(s.deliveries.List(path.ID))
+}
@@ -0,0 +1,108 @@
+package delivery
+
+import (
+	"encoding/json"
+	"github.com/vestamart/cart/internal/app/wishlist"
+	"github.com/vestamart/cart/internal/auth"
+	"github.com/vestamart/cart/internal/domain"
+	"github.com/vestamart/cart/internal/problem"
+	"net/http"
+	"time"
+)
+
+type WishlistServer struct {
+	wishlist *wishlist.Service
+}
+
+func NewWishlistServer(wishlist *wishlist.Service) *WishlistServer {
+	return &WishlistServer{wishlist: wishlist}
+}
+
+type WishlistItemResponse struct {
+	SkuID   int64     `json:"sku_id"`
+	AddedAt time.Time `json:"added_at"`
+	// NotifiedAt is set while the user has been told the sku is back in
+	// stock.
+	NotifiedAt *time.Time `json:"notified_at,omitempty"`
+}
+
+func wishlistItemResponse(item domain.WishlistItem) WishlistItemResponse {
+	resp := WishlistItemResponse{SkuID: item.SkuID, AddedAt: item.AddedAt}
+	if !item.NotifiedAt.IsZero() {
+		resp.NotifiedAt = &item.NotifiedAt
+	}
+	return resp
+}
+
+func (s WishlistServer) ListWishlistHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var path UserPath
+	if errs := bindRequest(r, &path, nil); len(errs) > 0 {
+		problem.Validation(w, r, errs)
+		return
+	}
+
+	if err := auth.AuthorizeUser(r.Context(), path.UserID); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	items, err := s.wishlist.List(r.Context(), path.UserID)
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	resp := make([]WishlistItemResponse, 0, len(items))
+	for _, item := range items {
+		resp = append(resp, wishlistItemResponse(item))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (s WishlistServer) AddToWishlistHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var path CartItemPath
+	if errs := bindRequest(r, &path, nil); len(errs) > 0 {
+		problem.Validation(w, r, errs)
+		return
+	}
+
+	if err := auth.AuthorizeUser(r.Context(), path.UserID); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	item, err := s.wishlist.Add(r.Context(), path.UserID, path.SkuID)
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(wishlistItemResponse(item))
+}
+
+func (s WishlistServer) RemoveFromWishlistHandler(w http.ResponseWriter, r *http.Request) {
+	var path CartItemPath
+	if errs := bindRequest(r, &path, nil); len(errs) > 0 {
+		problem.Validation(w, r, errs)
+		return
+	}
+
+	if err := auth.AuthorizeUser(r.Context(), path.UserID); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	if err := s.wishlist.Remove(r.Context(), path.UserID, path.SkuID); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -1,18 +1,56 @@
 package domain
 
+import "time"
+
+// UserCart is a priced cart. TotalPrice is Subtotal minus the discounts of
+// the items and the order level Discounts. Rate is set when the amounts were
+// converted from the product service currency.
 type UserCart struct {
-	Items      []CartItem `json:"items"`
-	TotalPrice uint32     `json:"total_price"`
+	Items      []CartItem    `json:"items"`
+	Subtotal   Money         `json:"subtotal"`
+	Discounts  []Discount    `json:"discounts,omitempty"`
+	Coupon     string        `json:"coupon,omitempty"`
+	TotalPrice Money         `json:"total_price"`
+	Version    uint64        `json:"version"`
+	Rate       *ExchangeRate `json:"rate,omitempty"`
 }
 
+// CartItem is a priced sku. Discount is the amount Promotion takes off the
+// line.
 type CartItem struct {
-	Sku   int64  `json:"sku"`
-	Name  string `json:"name"`
-	Count uint16 `json:"count"`
-	Price uint32 `json:"price"`
+	Sku       int64  `json:"sku"`
+	Name      string `json:"name"`
+	Count     uint16 `json:"count"`
+	Price     Money  `json:"price"`
+	Discount  Money  `json:"discount"`
+	Promotion string `json:"promotion,omitempty"`
+}
+
+// Discount is an order level discount.
+type Discount struct {
+	Promotion string `json:"promotion"`
+	Amount    Money  `json:"amount"`
 }
 
 type ProductServiceResponse struct {
 	Name  string `json:"name"`
 	Price uint32 `json:"price"`
 }
+
+// IdleCart is a non-empty cart that has not changed for a while.
+type IdleCart struct {
+	UserID    uint64           `json:"user_id"`
+	Items     map[int64]uint16 `json:"items"`
+	Version   uint64           `json:"version"`
+	UpdatedAt time.Time        `json:"updated_at"`
+}
+
+// AbandonedCart is an idle cart priced at the time it was detected.
+type AbandonedCart struct {
+	UserID     uint64     `json:"user_id"`
+	Items      []CartItem `json:"items"`
+	TotalPrice Money      `json:"total_price"`
+	Version    uint64     `json:"version"`
+	IdleSince  time.Time  `json:"idle_since"`
+	DetectedAt time.Time  `json:"detected_at"`
+}
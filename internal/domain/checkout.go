@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"github.com/vestamart/cart/internal/localErr"
+)
+
+// CheckoutLine selects a sku of the cart for a partial checkout. A zero
+// Count selects all of it.
+type CheckoutLine struct {
+	SkuID int64
+	Count uint16
+}
+
+// SelectItems returns the items of cart selected by lines, in the order of
+// lines and with the selected counts.
+func SelectItems(cart *UserCart, lines []CheckoutLine) ([]CartItem, error) {
+	inCart := make(map[int64]CartItem, len(cart.Items))
+	for _, item := range cart.Items {
+		inCart[item.Sku] = item
+	}
+
+	selected := make([]CartItem, 0, len(lines))
+	seen := make(map[int64]struct{}, len(lines))
+	for _, line := range lines {
+		if _, ok := seen[line.SkuID]; ok {
+			return nil, localErr.ErrInvalidArgument.WithMsg("sku %d is selected twice", line.SkuID)
+		}
+		seen[line.SkuID] = struct{}{}
+
+		item, ok := inCart[line.SkuID]
+		if !ok {
+			return nil, ErrItemNotInList.WithMsg("sku %d is not in the cart", line.SkuID)
+		}
+		if line.Count > item.Count {
+			return nil, localErr.ErrInvalidArgument.WithMsg("sku %d: %d selected, %d in the cart", line.SkuID, line.Count, item.Count)
+		}
+		if line.Count != 0 {
+			item.Count = line.Count
+		}
+		selected = append(selected, item)
+	}
+	return selected, nil
+}
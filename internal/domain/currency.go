@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"github.com/vestamart/cart/internal/localErr"
+	"math/big"
+	"time"
+)
+
+var ErrUnknownCurrency = localErr.New(localErr.KindInvalidArgument, "unknown_currency", "currency is not in the rates table")
+
+type RoundingMode string
+
+const (
+	RoundHalfUp   RoundingMode = "half_up"
+	RoundHalfEven RoundingMode = "half_even"
+	// RoundDown rounds toward zero and RoundUp away from it.
+	RoundDown RoundingMode = "down"
+	RoundUp   RoundingMode = "up"
+)
+
+func (m RoundingMode) Valid() bool {
+	switch m {
+	case RoundHalfUp, RoundHalfEven, RoundDown, RoundUp:
+		return true
+	}
+	return false
+}
+
+// Rounding rounds converted amounts to a multiple of Step minor units, 5
+// for amounts in steps of 0.05. The zero Rounding rounds half up to a minor
+// unit.
+type Rounding struct {
+	Mode RoundingMode
+	Step int64
+}
+
+// ExchangeRate converts amounts From one currency To another. Rate is the
+// price of a unit of From in To, as a decimal for display.
+type ExchangeRate struct {
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	Rate      string    `json:"rate"`
+	Source    string    `json:"source"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// factor is Rate in minor units of To per minor unit of From.
+	factor   *big.Rat
+	rounding Rounding
+}
+
+// NewExchangeRate builds a rate from the price of a unit of from in to and
+// the minor units (decimal places) of both currencies.
+func NewExchangeRate(from, to string, rate *big.Rat, fromMinor, toMinor int, rounding Rounding, source string, updatedAt time.Time) ExchangeRate {
+	factor := new(big.Rat).Mul(rate, new(big.Rat).SetFrac(pow10(toMinor), pow10(fromMinor)))
+	if rounding.Step < 1 {
+		rounding.Step = 1
+	}
+	return ExchangeRate{
+		From:      from,
+		To:        to,
+		Rate:      trimDecimal(rate.FloatString(8)),
+		Source:    source,
+		UpdatedAt: updatedAt,
+		factor:    factor,
+		rounding:  rounding,
+	}
+}
+
+// Convert returns m in To, rounded by the rounding of To. It fails with
+// ErrCurrencyMismatch if m is not in From and ErrMoneyOverflow if the result
+// does not fit in Money.
+func (r ExchangeRate) Convert(m Money) (Money, error) {
+	if m.Currency != r.From {
+		return Money{}, ErrCurrencyMismatch.WithMsg("%s amount converted from %s", m.Currency, r.From)
+	}
+	if r.factor == nil {
+		return Money{}, ErrUnknownCurrency.WithMsg("no rate from %s to %s", r.From, r.To)
+	}
+
+	steps := new(big.Rat).Mul(big.NewRat(m.Amount, 1), r.factor)
+	steps.Quo(steps, big.NewRat(r.rounding.Step, 1))
+	amount := round(steps, r.rounding.Mode)
+	amount.Mul(amount, big.NewInt(r.rounding.Step))
+	if !amount.IsInt64() {
+		return Money{}, ErrMoneyOverflow.WithMsg("%d %s is out of range in %s", m.Amount, m.Currency, r.To)
+	}
+	return Money{Amount: amount.Int64(), Currency: r.To}, nil
+}
+
+func round(x *big.Rat, mode RoundingMode) *big.Int {
+	quo, rem := new(big.Int).QuoRem(x.Num(), x.Denom(), new(big.Int))
+	if rem.Sign() == 0 {
+		return quo
+	}
+
+	away := false
+	switch mode {
+	case RoundUp:
+		away = true
+	case RoundDown:
+	default:
+		// Compare the remainder with half the denominator.
+		half := new(big.Int).Abs(rem)
+		half.Lsh(half, 1)
+		switch half.Cmp(x.Denom()) {
+		case 1:
+			away = true
+		case 0:
+			away = mode != RoundHalfEven || quo.Bit(0) == 1
+		}
+	}
+	if away {
+		quo.Add(quo, big.NewInt(int64(x.Sign())))
+	}
+	return quo
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// trimDecimal drops the trailing zeros of a decimal string.
+func trimDecimal(s string) string {
+	for len(s) > 1 && s[len(s)-1] == '0' {
+		s = s[:len(s)-1]
+	}
+	if s[len(s)-1] == '.' {
+		s = s[:len(s)-1]
+	}
+	return s
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExchangeRate_Convert(t *testing.T) {
+	quarter := func(mode RoundingMode) ExchangeRate {
+		return NewExchangeRate("RUB", "USD", big.NewRat(1, 4), 2, 2, Rounding{Mode: mode}, "test", time.Time{})
+	}
+	usd := func(amount int64) Money { return NewMoney(amount, "USD") }
+	cash := NewExchangeRate("RUB", "CHF", big.NewRat(1, 1), 2, 2, Rounding{Mode: RoundHalfUp, Step: 5}, "test", time.Time{})
+	yen := NewExchangeRate("RUB", "JPY", big.NewRat(167, 100), 2, 0, Rounding{Mode: RoundDown}, "test", time.Time{})
+
+	tests := []struct {
+		name        string
+		rate        ExchangeRate
+		amount      int64
+		expected    Money
+		expectedErr error
+	}{
+		{"Half up - tie away from zero", quarter(RoundHalfUp), 10, usd(3), nil},
+		{"Half up negative - tie away from zero", quarter(RoundHalfUp), -10, usd(-3), nil},
+		{"Half even - tie to even", quarter(RoundHalfEven), 10, usd(2), nil},
+		{"Half even odd - tie to even", quarter(RoundHalfEven), 14, usd(4), nil},
+		{"Down - toward zero", quarter(RoundDown), 11, usd(2), nil},
+		{"Up - away from zero", quarter(RoundUp), 9, usd(3), nil},
+		{"Exact - not rounded", quarter(RoundUp), 8, usd(2), nil},
+		{"Step - rounded down to a multiple", cash, 12, NewMoney(10, "CHF"), nil},
+		{"Step - rounded up to a multiple", cash, 13, NewMoney(15, "CHF"), nil},
+		{"Minor units - scaled", yen, 10099, NewMoney(168, "JPY"), nil},
+		{"Overflow - error", NewExchangeRate("RUB", "USD", big.NewRat(10, 1), 2, 2, Rounding{}, "test", time.Time{}), math.MaxInt64, Money{}, ErrMoneyOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.rate.Convert(NewMoney(tt.amount, "RUB"))
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+
+	_, err := quarter(RoundHalfUp).Convert(usd(1))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+	assert.Equal(t, "0.25", quarter(RoundHalfUp).Rate)
+	assert.Equal(t, "1.67", yen.Rate)
+}
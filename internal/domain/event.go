@@ -0,0 +1,36 @@
+package domain
+
+import "time"
+
+type CartEventType string
+
+const (
+	EventItemAdded   CartEventType = "item_added"
+	EventItemRemoved CartEventType = "item_removed"
+	EventCartCleared CartEventType = "cart_cleared"
+	EventCheckout    CartEventType = "checkout"
+	// EventCartMerged is recorded when a guest cart is merged into the cart.
+	EventCartMerged CartEventType = "cart_merged"
+	// EventItemMoved is recorded when Count of a sku moves from List to ToList.
+	EventItemMoved CartEventType = "item_moved"
+	// EventCouponApplied and EventCouponRemoved change the Coupon of the cart.
+	EventCouponApplied CartEventType = "coupon_applied"
+	EventCouponRemoved CartEventType = "coupon_removed"
+)
+
+// CartEvent describes a change of a list of a user, DefaultList for the cart.
+// ID is assigned when the event is published. Items holds the purchased
+// counts of a checkout.
+type CartEvent struct {
+	ID      uint64           `json:"id"`
+	Type    CartEventType    `json:"type"`
+	UserID  uint64           `json:"user_id"`
+	List    string           `json:"list,omitempty"`
+	ToList  string           `json:"to_list,omitempty"`
+	SkuID   int64            `json:"sku_id,omitempty"`
+	Count   uint16           `json:"count,omitempty"`
+	OrderID int64            `json:"order_id,omitempty"`
+	Items   map[int64]uint16 `json:"items,omitempty"`
+	Coupon  string           `json:"coupon,omitempty"`
+	Time    time.Time        `json:"time"`
+}
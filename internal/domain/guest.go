@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"github.com/vestamart/cart/internal/localErr"
+)
+
+var (
+	ErrGuestCartNotFound = localErr.New(localErr.KindNotFound, "guest_cart_not_found", "guest cart not found")
+	ErrTooManyGuestCarts = localErr.New(localErr.KindResourceExhausted, "too_many_guest_carts", "too many guest carts, try again later")
+)
+
+// MergePolicy decides the count of a sku that is both in the guest cart and
+// in the user cart.
+type MergePolicy string
+
+const (
+	// MergeSum adds both counts.
+	MergeSum MergePolicy = "sum"
+	// MergeMax keeps the larger count.
+	MergeMax MergePolicy = "max"
+	// MergePreferGuest keeps the guest count.
+	MergePreferGuest MergePolicy = "prefer_guest"
+)
+
+func (p MergePolicy) Valid() bool {
+	switch p {
+	case MergeSum, MergeMax, MergePreferGuest:
+		return true
+	}
+	return false
+}
+
+// Merge returns the count of a sku held userCount times in the user cart and
+// guestCount times in the guest cart, or ErrCountOverflow if a sum does not
+// fit.
+func (p MergePolicy) Merge(userCount, guestCount uint16) (uint16, error) {
+	switch p {
+	case MergeMax:
+		return max(userCount, guestCount), nil
+	case MergePreferGuest:
+		return guestCount, nil
+	default:
+		return AddCount(userCount, guestCount)
+	}
+}
+
+// MergeAdjustment reports a sku whose merged count was lowered to the stock
+// left in LOMS. A zero Count means the guest sku was not added.
+type MergeAdjustment struct {
+	Sku       int64  `json:"sku_id"`
+	Requested uint16 `json:"requested"`
+	Count     uint16 `json:"count"`
+}
+
+type MergeResult struct {
+	Cart     *UserCart
+	Adjusted []MergeAdjustment
+}
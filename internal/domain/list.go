@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"github.com/vestamart/cart/internal/localErr"
+)
+
+const (
+	// DefaultList is the cart every user has. The cart endpoints act on it.
+	DefaultList = "cart"
+	// SavedList is the saved-for-later list.
+	SavedList = "saved"
+
+	maxListName = 32
+)
+
+var (
+	ErrListNotFound  = localErr.New(localErr.KindNotFound, "list_not_found", "list not found")
+	ErrItemNotInList = localErr.New(localErr.KindNotFound, "item_not_in_list", "item not in list")
+	ErrTooManyLists  = localErr.New(localErr.KindConflict, "too_many_lists", "too many lists")
+)
+
+// ListSummary describes a named list of a user.
+type ListSummary struct {
+	Name  string `json:"name"`
+	Items int    `json:"items"`
+	Count int    `json:"count"`
+}
+
+// ValidateListName accepts names of up to 32 lowercase letters, digits, '-'
+// and '_'.
+func ValidateListName(name string) error {
+	if name == "" || len(name) > maxListName {
+		return localErr.ErrInvalidArgument.WithMsg("list name must be 1 to %d characters long", maxListName)
+	}
+	for _, c := range name {
+		if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+			return localErr.ErrInvalidArgument.WithMsg("list name %q may only contain a-z, 0-9, '-' and '_'", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"github.com/vestamart/cart/internal/localErr"
+	"math"
+)
+
+// DefaultCurrency is the currency of the product service prices.
+const DefaultCurrency = "RUB"
+
+var (
+	ErrMoneyOverflow    = localErr.New(localErr.KindInvalidArgument, "money_overflow", "amount out of range")
+	ErrCurrencyMismatch = localErr.New(localErr.KindInvalidArgument, "currency_mismatch", "amounts in different currencies")
+	ErrCountOverflow    = localErr.New(localErr.KindInvalidArgument, "count_overflow", "item count out of range")
+)
+
+// Money is an amount in the smallest unit of Currency the cart prices in.
+// Product service prices are whole units and are taken as is, so RUB amounts
+// are rubles, not kopecks. The zero Money has no currency and adds to an
+// amount in any currency.
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+func NewMoney(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// Add returns m+o, or ErrMoneyOverflow if it does not fit in an int64.
+func (m Money) Add(o Money) (Money, error) {
+	currency, err := m.currency(o)
+	if err != nil {
+		return Money{}, err
+	}
+	sum := m.Amount + o.Amount
+	if (o.Amount > 0 && sum < m.Amount) || (o.Amount < 0 && sum > m.Amount) {
+		return Money{}, ErrMoneyOverflow.WithMsg("%d + %d %s is out of range", m.Amount, o.Amount, currency)
+	}
+	return Money{Amount: sum, Currency: currency}, nil
+}
+
+// Sub returns m-o, or ErrMoneyOverflow if it does not fit in an int64.
+func (m Money) Sub(o Money) (Money, error) {
+	if o.Amount == math.MinInt64 {
+		return Money{}, ErrMoneyOverflow.WithMsg("%d %s cannot be negated", o.Amount, o.Currency)
+	}
+	return m.Add(Money{Amount: -o.Amount, Currency: o.Currency})
+}
+
+// Mul returns m*n, or ErrMoneyOverflow if it does not fit in an int64.
+func (m Money) Mul(n int64) (Money, error) {
+	if m.Amount == 0 || n == 0 {
+		return Money{Currency: m.Currency}, nil
+	}
+	product := m.Amount * n
+	if product/n != m.Amount || (m.Amount == -1 && n == math.MinInt64) || (n == -1 && m.Amount == math.MinInt64) {
+		return Money{}, ErrMoneyOverflow.WithMsg("%d %s × %d is out of range", m.Amount, m.Currency, n)
+	}
+	return Money{Amount: product, Currency: m.Currency}, nil
+}
+
+// Percent returns p percent of m rounded toward zero. p must be at most 100,
+// so the result cannot overflow.
+func (m Money) Percent(p uint32) Money {
+	whole, part := m.Amount/100, m.Amount%100
+	return Money{Amount: whole*int64(p) + part*int64(p)/100, Currency: m.Currency}
+}
+
+func (m Money) currency(o Money) (string, error) {
+	switch {
+	case m.Currency == o.Currency || o.Currency == "":
+		return m.Currency, nil
+	case m.Currency == "":
+		return o.Currency, nil
+	}
+	return "", ErrCurrencyMismatch.WithMsg("%s and %s", m.Currency, o.Currency)
+}
+
+// AddCount returns have+count, or ErrCountOverflow if it does not fit in a
+// uint16.
+func AddCount(have, count uint16) (uint16, error) {
+	if have > math.MaxUint16-count {
+		return 0, ErrCountOverflow.WithMsg("%d + %d exceeds %d", have, count, math.MaxUint16)
+	}
+	return have + count, nil
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_Arithmetic(t *testing.T) {
+	rub := func(amount int64) Money { return NewMoney(amount, "RUB") }
+
+	tests := []struct {
+		name        string
+		op          func() (Money, error)
+		expected    Money
+		expectedErr error
+	}{
+		{"Add - success", func() (Money, error) { return rub(150).Add(rub(50)) }, rub(200), nil},
+		{"Add to zero - takes the currency", func() (Money, error) { return Money{}.Add(rub(50)) }, rub(50), nil},
+		{"Add overflow - error", func() (Money, error) { return rub(math.MaxInt64).Add(rub(1)) }, Money{}, ErrMoneyOverflow},
+		{"Add other currency - error", func() (Money, error) { return rub(1).Add(NewMoney(1, "USD")) }, Money{}, ErrCurrencyMismatch},
+		{"Sub - success", func() (Money, error) { return rub(150).Sub(rub(50)) }, rub(100), nil},
+		{"Sub overflow - error", func() (Money, error) { return rub(math.MinInt64).Sub(rub(1)) }, Money{}, ErrMoneyOverflow},
+		{"Mul - success", func() (Money, error) { return rub(4294967295).Mul(65535) }, rub(281470681677825), nil},
+		{"Mul overflow - error", func() (Money, error) { return rub(math.MaxInt64 / 2).Mul(3) }, Money{}, ErrMoneyOverflow},
+		{"Percent - rounded down", func() (Money, error) { return rub(999).Percent(15), nil }, rub(149), nil},
+		{"Percent of max - no overflow", func() (Money, error) { return rub(math.MaxInt64).Percent(100), nil }, rub(math.MaxInt64), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.op()
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestAddCount(t *testing.T) {
+	sum, err := AddCount(65000, 535)
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(65535), sum)
+
+	_, err = AddCount(65000, 536)
+	assert.ErrorIs(t, err, ErrCountOverflow)
+}
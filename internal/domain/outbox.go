@@ -0,0 +1,12 @@
+package domain
+
+import "time"
+
+// OutboxRecord is a cart event waiting to be delivered by the outbox relay.
+type OutboxRecord struct {
+	ID          uint64
+	Event       CartEvent
+	Attempts    int
+	NextAttempt time.Time
+	LastError   string
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"context"
+	"github.com/vestamart/cart/internal/localErr"
+	"time"
+)
+
+var (
+	ErrPreviewRequired = localErr.New(localErr.KindFailedPrecondition, "preview_required", "checkout requires a preview token")
+	ErrPreviewStale    = localErr.New(localErr.KindFailedPrecondition, "preview_stale", "the cart no longer matches the preview")
+)
+
+// CheckoutPreview is what a checkout would send to LOMS right now, priced
+// with the promotions and the coupon of the cart. Token is only issued when
+// there are no problems.
+type CheckoutPreview struct {
+	UserID     uint64           `json:"user_id"`
+	Version    uint64           `json:"version"`
+	Items      []PreviewItem    `json:"items"`
+	Subtotal   Money            `json:"subtotal"`
+	Discounts  []Discount       `json:"discounts,omitempty"`
+	TotalPrice Money            `json:"total_price"`
+	Problems   []PreviewProblem `json:"problems"`
+	Token      string           `json:"token,omitempty"`
+	ExpiresAt  *time.Time       `json:"expires_at,omitempty"`
+}
+
+// PreviewItem is a line of the order with the stock LOMS reports for it,
+// nil when the stock check is off.
+type PreviewItem struct {
+	CartItem
+	Available *uint64 `json:"available,omitempty"`
+}
+
+// PreviewProblem blocks the checkout of a preview. Code matches the problem
+// code the checkout itself would fail with.
+type PreviewProblem struct {
+	SkuID  int64  `json:"sku_id"`
+	Code   string `json:"code"`
+	Detail string `json:"detail"`
+}
+
+type previewTokenKey struct{}
+
+// WithPreviewToken attaches the token of a checkout preview to ctx.
+func WithPreviewToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, previewTokenKey{}, token)
+}
+
+// PreviewToken returns the token set by WithPreviewToken, or "".
+func PreviewToken(ctx context.Context) string {
+	token, _ := ctx.Value(previewTokenKey{}).(string)
+	return token
+}
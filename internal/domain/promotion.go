@@ -0,0 +1,5 @@
+package domain
+
+import "github.com/vestamart/cart/internal/localErr"
+
+var ErrCouponNotFound = localErr.New(localErr.KindNotFound, "coupon_not_found", "coupon not found")
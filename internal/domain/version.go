@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"context"
+	"github.com/vestamart/cart/internal/localErr"
+	"slices"
+)
+
+var ErrVersionMismatch = localErr.New(localErr.KindFailedPrecondition, "version_mismatch", "cart version does not match")
+
+type ifMatchKey struct{}
+
+// WithIfMatch makes the cart mutations of ctx conditional: they only apply
+// while the cart version is one of versions. An empty list never matches.
+func WithIfMatch(ctx context.Context, versions ...uint64) context.Context {
+	if versions == nil {
+		versions = []uint64{}
+	}
+	return context.WithValue(ctx, ifMatchKey{}, versions)
+}
+
+// WithoutIfMatch drops the precondition set by WithIfMatch.
+func WithoutIfMatch(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ifMatchKey{}, []uint64(nil))
+}
+
+// CheckVersion returns ErrVersionMismatch if ctx carries a precondition that
+// current does not satisfy.
+func CheckVersion(ctx context.Context, current uint64) error {
+	versions, _ := ctx.Value(ifMatchKey{}).([]uint64)
+	if versions == nil || slices.Contains(versions, current) {
+		return nil
+	}
+	return ErrVersionMismatch.WithMsg("cart version is %d", current)
+}
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"github.com/vestamart/cart/internal/localErr"
+	"time"
+)
+
+var (
+	ErrNotInWishlist = localErr.New(localErr.KindNotFound, "not_in_wishlist", "sku not in wishlist")
+	ErrWishlistFull  = localErr.New(localErr.KindConflict, "wishlist_full", "wishlist is full")
+)
+
+// WishlistItem is a sku a user waits for. NotifiedAt is set when the user
+// was told it is back in stock and cleared when it runs out again.
+type WishlistItem struct {
+	SkuID      int64
+	AddedAt    time.Time
+	NotifiedAt time.Time
+}
+
+// BackInStock tells a user that a wishlisted sku can be bought again.
+type BackInStock struct {
+	UserID    uint64    `json:"user_id"`
+	SkuID     int64     `json:"sku_id"`
+	Available uint64    `json:"available"`
+	Time      time.Time `json:"time"`
+}
@@ -0,0 +1,187 @@
+package events
+
+import (
+	"context"
+	"github.com/vestamart/cart/internal/domain"
+	"github.com/vestamart/cart/internal/localErr"
+	"sync"
+	"time"
+)
+
+var ErrTooManySubscribers = localErr.New(localErr.KindResourceExhausted, "too_many_subscribers", "too many event streams for this user")
+
+// subscriberBuffer is how many events a subscriber may lag behind before it
+// is dropped. A dropped client reconnects and resumes from the user buffer.
+const subscriberBuffer = 16
+
+// Subscription receives the events of one user. C is closed when the
+// subscriber is dropped for lagging or the hub is closed.
+type Subscription struct {
+	C      <-chan domain.CartEvent
+	c      chan domain.CartEvent
+	userID uint64
+}
+
+type userState struct {
+	buffer      []domain.CartEvent
+	evicted     uint64
+	subscribers map[*Subscription]struct{}
+	lastEvent   time.Time
+}
+
+// Hub fans cart events out to the subscribers of each user and keeps the
+// last bufferSize events per user for Last-Event-ID resume.
+type Hub struct {
+	mu             sync.Mutex
+	seq            uint64
+	users          map[uint64]*userState
+	bufferSize     int
+	maxSubscribers int
+	retention      time.Duration
+	closed         bool
+	now            func() time.Time
+}
+
+func NewHub(bufferSize, maxSubscribers int, retention time.Duration) *Hub {
+	return &Hub{
+		users:          make(map[uint64]*userState),
+		bufferSize:     bufferSize,
+		maxSubscribers: maxSubscribers,
+		retention:      retention,
+		now:            time.Now,
+	}
+}
+
+// Publish assigns the next event ID, buffers the event and delivers it to
+// the user's subscribers without blocking.
+func (h *Hub) Publish(event domain.CartEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return
+	}
+	st := h.user(event.UserID)
+	h.seq++
+	event.ID = h.seq
+	if event.Time.IsZero() {
+		event.Time = h.now()
+	}
+
+	st.buffer = append(st.buffer, event)
+	if len(st.buffer) > h.bufferSize {
+		st.evicted = st.buffer[0].ID
+		st.buffer = st.buffer[1:]
+	}
+	st.lastEvent = event.Time
+
+	for sub := range st.subscribers {
+		select {
+		case sub.c <- event:
+		default:
+			h.drop(st, sub)
+		}
+	}
+}
+
+// Subscribe registers a subscriber for userID. If lastEventID is set, the
+// buffered events after it are returned for replay; complete is false when
+// some events after lastEventID are no longer buffered.
+func (h *Hub) Subscribe(userID, lastEventID uint64) (sub *Subscription, replay []domain.CartEvent, complete bool, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return nil, nil, false, localErr.Errorf(localErr.KindUnavailable, "event hub is closed")
+	}
+	st := h.user(userID)
+	if len(st.subscribers) >= h.maxSubscribers {
+		return nil, nil, false, ErrTooManySubscribers.WithMsg("at most %d event streams per user", h.maxSubscribers)
+	}
+
+	complete = true
+	if lastEventID > 0 {
+		if lastEventID > h.seq || lastEventID < st.evicted {
+			complete = false
+		}
+		for _, e := range st.buffer {
+			if e.ID > lastEventID {
+				replay = append(replay, e)
+			}
+		}
+	}
+
+	c := make(chan domain.CartEvent, subscriberBuffer)
+	sub = &Subscription{C: c, c: c, userID: userID}
+	st.subscribers[sub] = struct{}{}
+
+	return sub, replay, complete, nil
+}
+
+func (h *Hub) Unsubscribe(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if st, ok := h.users[sub.userID]; ok {
+		h.drop(st, sub)
+	}
+}
+
+// Close ends every subscription. It is meant to run before the HTTP server
+// shuts down, so that open streams don't hold the shutdown.
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for _, st := range h.users {
+		for sub := range st.subscribers {
+			h.drop(st, sub)
+		}
+	}
+}
+
+// Run forgets the buffers of users without subscribers and without events
+// for the retention period until ctx is done.
+func (h *Hub) Run(ctx context.Context) {
+	ticker := time.NewTicker(h.retention)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			h.prune(h.now())
+		}
+	}
+}
+
+func (h *Hub) prune(now time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for userID, st := range h.users {
+		if len(st.subscribers) == 0 && now.Sub(st.lastEvent) > h.retention {
+			delete(h.users, userID)
+		}
+	}
+}
+
+// user returns the state of userID, creating it if needed. Events before a
+// new state are unknown, so they count as evicted.
+func (h *Hub) user(userID uint64) *userState {
+	st, ok := h.users[userID]
+	if !ok {
+		st = &userState{evicted: h.seq, subscribers: make(map[*Subscription]struct{}), lastEvent: h.now()}
+		h.users[userID] = st
+	}
+	return st
+}
+
+func (h *Hub) drop(st *userState, sub *Subscription) {
+	if _, ok := st.subscribers[sub]; ok {
+		delete(st.subscribers, sub)
+		close(sub.c)
+	}
+}
@@ -0,0 +1,92 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/cart/internal/domain"
+)
+
+func TestHub_PublishSubscribe(t *testing.T) {
+	hub := NewHub(10, 2, time.Minute)
+
+	sub, replay, complete, err := hub.Subscribe(1, 0)
+	require.NoError(t, err)
+	assert.Empty(t, replay)
+	assert.True(t, complete)
+
+	hub.Publish(domain.CartEvent{Type: domain.EventItemAdded, UserID: 1, SkuID: 100, Count: 2})
+	hub.Publish(domain.CartEvent{Type: domain.EventItemAdded, UserID: 2, SkuID: 100, Count: 1})
+
+	event := <-sub.C
+	assert.Equal(t, uint64(1), event.ID)
+	assert.Equal(t, domain.EventItemAdded, event.Type)
+	assert.Empty(t, sub.C, "events of other users must not be delivered")
+
+	hub.Unsubscribe(sub)
+	_, ok := <-sub.C
+	assert.False(t, ok)
+}
+
+func TestHub_Resume(t *testing.T) {
+	hub := NewHub(2, 2, time.Minute)
+	for i := 0; i < 5; i++ {
+		hub.Publish(domain.CartEvent{Type: domain.EventItemAdded, UserID: 1, SkuID: int64(i)})
+	}
+
+	tests := []struct {
+		name             string
+		lastEventID      uint64
+		expectedIDs      []uint64
+		expectedComplete bool
+	}{
+		{"Up to date", 5, nil, true},
+		{"Missed buffered events", 3, []uint64{4, 5}, true},
+		{"Missed evicted events", 2, []uint64{4, 5}, false},
+		{"Unknown future id - hub restarted", 42, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sub, replay, complete, err := hub.Subscribe(1, tt.lastEventID)
+			require.NoError(t, err)
+			defer hub.Unsubscribe(sub)
+
+			var ids []uint64
+			for _, e := range replay {
+				ids = append(ids, e.ID)
+			}
+			assert.Equal(t, tt.expectedIDs, ids)
+			assert.Equal(t, tt.expectedComplete, complete)
+		})
+	}
+}
+
+func TestHub_Limits(t *testing.T) {
+	hub := NewHub(10, 1, time.Minute)
+
+	sub, _, _, err := hub.Subscribe(1, 0)
+	require.NoError(t, err)
+
+	_, _, _, err = hub.Subscribe(1, 0)
+	assert.ErrorIs(t, err, ErrTooManySubscribers)
+
+	// A subscriber that does not keep up is dropped instead of blocking.
+	for i := 0; i < subscriberBuffer+1; i++ {
+		hub.Publish(domain.CartEvent{Type: domain.EventItemAdded, UserID: 1})
+	}
+	received := 0
+	for range sub.C {
+		received++
+	}
+	assert.Equal(t, subscriberBuffer, received)
+
+	_, _, _, err = hub.Subscribe(1, 0)
+	assert.NoError(t, err)
+
+	hub.Close()
+	_, _, _, err = hub.Subscribe(2, 0)
+	assert.Error(t, err)
+}
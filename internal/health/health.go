@@ -0,0 +1,176 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+var ErrNotReady = errors.New("instance marked not ready")
+
+// Check probes a single dependency and returns nil if it is usable.
+type Check func(ctx context.Context) error
+
+type DependencyStatus struct {
+	Status    string    `json:"status"`
+	Error     string    `json:"error,omitempty"`
+	LatencyMs int64     `json:"latency_ms"`
+	CheckedAt time.Time `json:"checked_at"`
+}
+
+type Report struct {
+	Status       string                      `json:"status"`
+	Error        string                      `json:"error,omitempty"`
+	Dependencies map[string]DependencyStatus `json:"dependencies"`
+}
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Checker runs readiness checks and caches their results for ttl, so that
+// frequent probes don't hammer the dependencies.
+type Checker struct {
+	checks  []namedCheck
+	ttl     time.Duration
+	timeout time.Duration
+
+	mu     sync.Mutex
+	cache  map[string]DependencyStatus
+	expiry time.Time
+
+	ready atomic.Bool
+}
+
+func NewChecker(ttl, timeout time.Duration) *Checker {
+	c := &Checker{ttl: ttl, timeout: timeout, cache: make(map[string]DependencyStatus)}
+	c.ready.Store(true)
+	return c
+}
+
+func (c *Checker) Register(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checks = append(c.checks, namedCheck{name: name, check: check})
+	c.expiry = time.Time{}
+}
+
+// SetReady toggles the admin switch. A not-ready instance fails readiness
+// regardless of its dependencies.
+func (c *Checker) SetReady(ready bool) {
+	c.ready.Store(ready)
+}
+
+func (c *Checker) Ready() bool {
+	return c.ready.Load()
+}
+
+func (c *Checker) Readiness(ctx context.Context) (Report, bool) {
+	deps := c.dependencies(ctx)
+
+	report := Report{Status: StatusUp, Dependencies: deps}
+	ok := true
+	for _, dep := range deps {
+		if dep.Status != StatusUp {
+			ok = false
+		}
+	}
+	if !c.Ready() {
+		ok = false
+		report.Error = ErrNotReady.Error()
+	}
+	if !ok {
+		report.Status = StatusDown
+	}
+
+	return report, ok
+}
+
+func (c *Checker) dependencies(ctx context.Context) map[string]DependencyStatus {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if time.Now().Before(c.expiry) {
+		return copyStatuses(c.cache)
+	}
+
+	results := make(map[string]DependencyStatus, len(c.checks))
+	var wg sync.WaitGroup
+	var resMu sync.Mutex
+	for _, nc := range c.checks {
+		wg.Add(1)
+		go func(nc namedCheck) {
+			defer wg.Done()
+			st := c.run(ctx, nc.check)
+			resMu.Lock()
+			results[nc.name] = st
+			resMu.Unlock()
+		}(nc)
+	}
+	wg.Wait()
+
+	c.cache = results
+	c.expiry = time.Now().Add(c.ttl)
+
+	return copyStatuses(results)
+}
+
+func (c *Checker) run(ctx context.Context, check Check) DependencyStatus {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := check(ctx)
+	st := DependencyStatus{
+		Status:    StatusUp,
+		LatencyMs: time.Since(start).Milliseconds(),
+		CheckedAt: start,
+	}
+	if err != nil {
+		st.Status = StatusDown
+		st.Error = err.Error()
+	}
+
+	return st
+}
+
+func copyStatuses(in map[string]DependencyStatus) map[string]DependencyStatus {
+	out := make(map[string]DependencyStatus, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
+
+// GRPC checks a connection with the standard gRPC health-checking protocol.
+// Servers that don't implement the protocol still prove the connection is
+// alive by answering Unimplemented, so that is treated as healthy.
+func GRPC(conn grpc.ClientConnInterface, service string) Check {
+	client := healthpb.NewHealthClient(conn)
+	return func(ctx context.Context) error {
+		resp, err := client.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+		if err != nil {
+			if status.Code(err) == codes.Unimplemented {
+				return nil
+			}
+			return err
+		}
+		if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+			return fmt.Errorf("service status %s", resp.GetStatus())
+		}
+		return nil
+	}
+}
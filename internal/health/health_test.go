@@ -0,0 +1,80 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChecker_Readiness(t *testing.T) {
+	tests := []struct {
+		name       string
+		checks     map[string]Check
+		ready      bool
+		expectedOK bool
+		expected   map[string]string
+	}{
+		{
+			name: "All dependencies up - ready",
+			checks: map[string]Check{
+				"loms":    func(context.Context) error { return nil },
+				"product": func(context.Context) error { return nil },
+			},
+			ready:      true,
+			expectedOK: true,
+			expected:   map[string]string{"loms": StatusUp, "product": StatusUp},
+		},
+		{
+			name: "One dependency down - not ready",
+			checks: map[string]Check{
+				"loms":    func(context.Context) error { return errors.New("connection refused") },
+				"product": func(context.Context) error { return nil },
+			},
+			ready:      true,
+			expectedOK: false,
+			expected:   map[string]string{"loms": StatusDown, "product": StatusUp},
+		},
+		{
+			name: "Admin switch off - not ready",
+			checks: map[string]Check{
+				"loms": func(context.Context) error { return nil },
+			},
+			ready:      false,
+			expectedOK: false,
+			expected:   map[string]string{"loms": StatusUp},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := NewChecker(time.Minute, time.Second)
+			for name, check := range tt.checks {
+				checker.Register(name, check)
+			}
+			checker.SetReady(tt.ready)
+
+			report, ok := checker.Readiness(context.Background())
+			assert.Equal(t, tt.expectedOK, ok)
+			for name, status := range tt.expected {
+				assert.Equal(t, status, report.Dependencies[name].Status)
+			}
+		})
+	}
+}
+
+func TestChecker_ReadinessCached(t *testing.T) {
+	calls := 0
+	checker := NewChecker(time.Minute, time.Second)
+	checker.Register("loms", func(context.Context) error {
+		calls++
+		return nil
+	})
+
+	_, _ = checker.Readiness(context.Background())
+	_, _ = checker.Readiness(context.Background())
+
+	assert.Equal(t, 1, calls)
+}
@@ -1,6 +1,112 @@
 package localErr
 
-import "errors"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc/status"
+)
 
-var ErrSkuNotExist = errors.New("sku not exist")
-var ItemNotEnoughErr = errors.New("item not enough")
+var ErrSkuNotExist = New(KindFailedPrecondition, "sku_not_found", "sku not exist")
+var ItemNotEnoughErr = New(KindFailedPrecondition, "insufficient_stock", "item not enough")
+var ErrDeadlineExceeded = New(KindDeadlineExceeded, "dependency_timeout", "dependency deadline exceeded")
+var ErrCartNotFound = New(KindNotFound, "cart_not_found", "user not found")
+var ErrInvalidArgument = New(KindInvalidArgument, "invalid_argument", "invalid argument")
+var ErrInternal = New(KindInternal, "internal", "internal error")
+var ErrBodyTooLarge = New(KindTooLarge, "body_too_large", "request body too large")
+
+// Error is a domain error with a kind, a stable machine-readable code and an
+// optional cause.
+type Error struct {
+	Kind  Kind
+	Code  string
+	Msg   string
+	Cause error
+}
+
+func New(kind Kind, code, msg string) *Error {
+	return &Error{Kind: kind, Code: code, Msg: msg}
+}
+
+// Errorf creates an error of kind with the default code for that kind.
+func Errorf(kind Kind, format string, args ...any) *Error {
+	return &Error{Kind: kind, Code: kind.String(), Msg: fmt.Sprintf(format, args...)}
+}
+
+// Wrap creates an error of kind caused by err.
+func Wrap(err error, kind Kind, msg string) *Error {
+	return &Error{Kind: kind, Code: kind.String(), Msg: msg, Cause: err}
+}
+
+func (e *Error) Error() string {
+	if e.Cause != nil {
+		return e.Msg + ": " + e.Cause.Error()
+	}
+	return e.Msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.Cause
+}
+
+// Is reports errors with the same code as equal, so that a sentinel still
+// matches after WithCause or WithMsg.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+	return e.Kind == t.Kind && e.Code == t.Code
+}
+
+// WithCause returns a copy of e caused by err.
+func (e *Error) WithCause(err error) *Error {
+	c := *e
+	c.Cause = err
+	return &c
+}
+
+// WithMsg returns a copy of e with its message extended by msg.
+func (e *Error) WithMsg(format string, args ...any) *Error {
+	c := *e
+	c.Msg = e.Msg + ": " + fmt.Sprintf(format, args...)
+	return &c
+}
+
+// As extracts the outermost *Error from the chain.
+func As(err error) (*Error, bool) {
+	var e *Error
+	if errors.As(err, &e) {
+		return e, true
+	}
+	return nil, false
+}
+
+// KindOf returns the kind of err. Errors that are not *Error are classified
+// by their gRPC status or context error, and are KindInternal otherwise.
+func KindOf(err error) Kind {
+	if err == nil {
+		return KindUnknown
+	}
+	if e, ok := As(err); ok {
+		return e.Kind
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return KindDeadlineExceeded
+	}
+	if errors.Is(err, context.Canceled) {
+		return KindCanceled
+	}
+	if st, ok := status.FromError(err); ok {
+		return KindFromGRPC(st.Code())
+	}
+	return KindInternal
+}
+
+// CodeOf returns the stable code of err, falling back to its kind.
+func CodeOf(err error) string {
+	if e, ok := As(err); ok && e.Code != "" {
+		return e.Code
+	}
+	return KindOf(err).String()
+}
@@ -0,0 +1,64 @@
+package localErr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestError_Is(t *testing.T) {
+	cause := errors.New("connection reset")
+
+	tests := []struct {
+		name     string
+		err      error
+		target   error
+		expected bool
+	}{
+		{"Same sentinel", ErrSkuNotExist, ErrSkuNotExist, true},
+		{"Sentinel with cause", ErrSkuNotExist.WithCause(cause), ErrSkuNotExist, true},
+		{"Sentinel with message", ErrInvalidArgument.WithMsg("count"), ErrInvalidArgument, true},
+		{"Wrapped by fmt", fmt.Errorf("add: %w", ItemNotEnoughErr), ItemNotEnoughErr, true},
+		{"Cause is reachable", ErrSkuNotExist.WithCause(cause), cause, true},
+		{"Different code same kind", ErrSkuNotExist, ItemNotEnoughErr, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, errors.Is(tt.err, tt.target))
+		})
+	}
+}
+
+func TestKindOf(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		expectedKind Kind
+		expectedCode string
+		expectedHTTP int
+		expectedGRPC codes.Code
+	}{
+		{"Typed error", ErrCartNotFound, KindNotFound, "cart_not_found", http.StatusNotFound, codes.NotFound},
+		{"Wrapped typed error", fmt.Errorf("clear: %w", ErrDeadlineExceeded), KindDeadlineExceeded, "dependency_timeout", http.StatusGatewayTimeout, codes.DeadlineExceeded},
+		{"Errorf default code", Errorf(KindConflict, "version %d", 2), KindConflict, "conflict", http.StatusConflict, codes.Aborted},
+		{"Context deadline", context.DeadlineExceeded, KindDeadlineExceeded, "dependency_timeout", http.StatusGatewayTimeout, codes.DeadlineExceeded},
+		{"gRPC status", status.Error(codes.Unavailable, "down"), KindUnavailable, "dependency_unavailable", http.StatusServiceUnavailable, codes.Unavailable},
+		{"Plain error", errors.New("boom"), KindInternal, "internal", http.StatusInternalServerError, codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedKind, KindOf(tt.err))
+			assert.Equal(t, tt.expectedCode, CodeOf(tt.err))
+			assert.Equal(t, tt.expectedHTTP, HTTPStatus(tt.err))
+			assert.Equal(t, tt.expectedGRPC, status.Code(ToGRPC(tt.err)))
+		})
+	}
+}
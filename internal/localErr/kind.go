@@ -0,0 +1,116 @@
+package localErr
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+)
+
+type Kind int
+
+const (
+	KindUnknown Kind = iota
+	KindInvalidArgument
+	KindNotFound
+	KindFailedPrecondition
+	KindConflict
+	KindUnauthenticated
+	KindPermissionDenied
+	KindResourceExhausted
+	KindUnavailable
+	KindDeadlineExceeded
+	KindCanceled
+	KindDependency
+	KindInternal
+	KindTooLarge
+)
+
+// kindMapping is the single place where kinds are mapped to their code,
+// HTTP status and gRPC status.
+var kindMapping = map[Kind]struct {
+	name string
+	http int
+	grpc codes.Code
+}{
+	KindUnknown:            {"unknown", http.StatusInternalServerError, codes.Unknown},
+	KindInvalidArgument:    {"invalid_argument", http.StatusBadRequest, codes.InvalidArgument},
+	KindNotFound:           {"not_found", http.StatusNotFound, codes.NotFound},
+	KindFailedPrecondition: {"failed_precondition", http.StatusPreconditionFailed, codes.FailedPrecondition},
+	KindConflict:           {"conflict", http.StatusConflict, codes.Aborted},
+	KindUnauthenticated:    {"unauthenticated", http.StatusUnauthorized, codes.Unauthenticated},
+	KindPermissionDenied:   {"permission_denied", http.StatusForbidden, codes.PermissionDenied},
+	KindResourceExhausted:  {"resource_exhausted", http.StatusTooManyRequests, codes.ResourceExhausted},
+	KindUnavailable:        {"dependency_unavailable", http.StatusServiceUnavailable, codes.Unavailable},
+	KindDeadlineExceeded:   {"dependency_timeout", http.StatusGatewayTimeout, codes.DeadlineExceeded},
+	KindCanceled:           {"canceled", 499, codes.Canceled},
+	KindDependency:         {"dependency_failed", http.StatusBadGateway, codes.Internal},
+	KindInternal:           {"internal", http.StatusInternalServerError, codes.Internal},
+	KindTooLarge:           {"too_large", http.StatusRequestEntityTooLarge, codes.ResourceExhausted},
+}
+
+func (k Kind) String() string {
+	if m, ok := kindMapping[k]; ok {
+		return m.name
+	}
+	return kindMapping[KindUnknown].name
+}
+
+func (k Kind) HTTPStatus() int {
+	if m, ok := kindMapping[k]; ok {
+		return m.http
+	}
+	return http.StatusInternalServerError
+}
+
+func (k Kind) GRPCCode() codes.Code {
+	if m, ok := kindMapping[k]; ok {
+		return m.grpc
+	}
+	return codes.Unknown
+}
+
+func KindFromGRPC(code codes.Code) Kind {
+	switch code {
+	case codes.OK:
+		return KindUnknown
+	case codes.InvalidArgument, codes.OutOfRange:
+		return KindInvalidArgument
+	case codes.NotFound:
+		return KindNotFound
+	case codes.FailedPrecondition:
+		return KindFailedPrecondition
+	case codes.AlreadyExists, codes.Aborted:
+		return KindConflict
+	case codes.Unauthenticated:
+		return KindUnauthenticated
+	case codes.PermissionDenied:
+		return KindPermissionDenied
+	case codes.ResourceExhausted:
+		return KindResourceExhausted
+	case codes.Unavailable:
+		return KindUnavailable
+	case codes.DeadlineExceeded:
+		return KindDeadlineExceeded
+	case codes.Canceled:
+		return KindCanceled
+	}
+	return KindDependency
+}
+
+// HTTPStatus returns the HTTP status for err.
+func HTTPStatus(err error) int {
+	return KindOf(err).HTTPStatus()
+}
+
+// ToGRPC converts err to a gRPC status error carrying its code.
+func ToGRPC(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := As(err); !ok {
+		if st, ok := status.FromError(err); ok {
+			return st.Err()
+		}
+	}
+	return status.Error(KindOf(err).GRPCCode(), err.Error())
+}
@@ -0,0 +1,51 @@
+package logger
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+var level = new(slog.LevelVar)
+
+// Init installs a slog handler with a level that can be changed at runtime.
+// Code logs through slog at the level of each message; what is left on the
+// standard log package, the log.Fatal calls of main, is logged as errors so
+// that no level hides it.
+func Init(lvl string) error {
+	if err := SetLevel(lvl); err != nil {
+		return err
+	}
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: level})))
+	slog.SetLogLoggerLevel(slog.LevelError)
+	return nil
+}
+
+func SetLevel(lvl string) error {
+	parsed, err := parseLevel(lvl)
+	if err != nil {
+		return err
+	}
+	level.Set(parsed)
+	return nil
+}
+
+func ValidateLevel(lvl string) error {
+	_, err := parseLevel(lvl)
+	return err
+}
+
+func parseLevel(lvl string) (slog.Level, error) {
+	switch strings.ToLower(lvl) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "info", "":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	}
+	return 0, fmt.Errorf("unknown level %q", lvl)
+}
@@ -0,0 +1,91 @@
+package mw
+
+import (
+	"context"
+	"github.com/vestamart/cart/internal/auth"
+	"github.com/vestamart/cart/internal/localErr"
+	"github.com/vestamart/cart/internal/problem"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"net/http"
+	"strings"
+)
+
+// Auth verifies the bearer token of every request and stores the caller in
+// the request context. Callers holding adminScope act as admins.
+func Auth(verifier *auth.Verifier, adminScope string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, ok := bearerToken(r)
+			if !ok {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="cart"`)
+				problem.Error(w, r, auth.ErrMissingToken)
+				return
+			}
+
+			claims, err := verifier.Verify(token)
+			if err != nil {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="cart", error="invalid_token"`)
+				problem.Error(w, r, err)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(withClaims(r.Context(), claims, adminScope)))
+		})
+	}
+}
+
+// healthService is left open so that orchestrators can probe the gRPC port
+// without a token.
+const healthService = "/grpc.health.v1.Health/"
+
+// AuthGRPC is Auth for unary gRPC calls: it verifies the bearer token of the
+// authorization metadata and, for requests naming a user, lets through only
+// that user or an admin.
+func AuthGRPC(verifier *auth.Verifier, adminScope string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if strings.HasPrefix(info.FullMethod, healthService) {
+			return handler(ctx, req)
+		}
+
+		var header string
+		if v := metadata.ValueFromIncomingContext(ctx, "authorization"); len(v) > 0 {
+			header = v[0]
+		}
+		token, ok := parseBearer(header)
+		if !ok {
+			return nil, localErr.ToGRPC(auth.ErrMissingToken)
+		}
+		claims, err := verifier.Verify(token)
+		if err != nil {
+			return nil, localErr.ToGRPC(err)
+		}
+
+		ctx = withClaims(ctx, claims, adminScope)
+		if r, ok := req.(interface{ GetUser() uint64 }); ok {
+			if err = auth.AuthorizeUser(ctx, r.GetUser()); err != nil {
+				return nil, localErr.ToGRPC(err)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+func withClaims(ctx context.Context, claims *auth.Claims, adminScope string) context.Context {
+	return auth.WithPrincipal(ctx, auth.Principal{
+		Subject: claims.Subject,
+		Admin:   adminScope != "" && claims.HasScope(adminScope),
+	})
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	return parseBearer(r.Header.Get("Authorization"))
+}
+
+func parseBearer(header string) (string, bool) {
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+	return strings.TrimSpace(token), true
+}
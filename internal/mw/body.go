@@ -0,0 +1,38 @@
+package mw
+
+import (
+	"bytes"
+	"errors"
+	"github.com/vestamart/cart/internal/localErr"
+	"github.com/vestamart/cart/internal/problem"
+	"io"
+	"net/http"
+)
+
+// MaxBytes rejects request bodies larger than limit with 413. Bodies are
+// small JSON documents, so they are read up front; this also catches chunked
+// bodies that carry no Content-Length.
+func MaxBytes(limit int64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.ContentLength > limit {
+				problem.Error(w, r, localErr.ErrBodyTooLarge.WithMsg("request body must not exceed %d bytes", limit))
+				return
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, limit))
+			var maxErr *http.MaxBytesError
+			switch {
+			case errors.As(err, &maxErr):
+				problem.Error(w, r, localErr.ErrBodyTooLarge.WithMsg("request body must not exceed %d bytes", limit))
+				return
+			case err != nil:
+				problem.Error(w, r, localErr.ErrInvalidArgument.WithMsg("read request body").WithCause(err))
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
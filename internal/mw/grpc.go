@@ -0,0 +1,62 @@
+package mw
+
+import (
+	"context"
+	"github.com/vestamart/cart/internal/localErr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"log/slog"
+	"runtime/debug"
+)
+
+// serverErrorMessage replaces the message of gRPC server errors, whose
+// causes can name internal hosts or dependencies and are only logged.
+const serverErrorMessage = "the request could not be completed, report it with request_id "
+
+// LoggerGRPC logs calls, with secrets redacted from the bodies. Server
+// errors are logged with their cause and reach the client as a generic
+// message with the request ID, as problem.Error does over HTTP.
+func LoggerGRPC(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	slog.Debug("request", "id", RequestIDFrom(ctx), "method", info.FullMethod, "req", marshalRedacted(req))
+
+	if resp, err = handler(ctx, req); err != nil {
+		slog.Log(ctx, statusLevel(localErr.HTTPStatus(err)), "response", "id", RequestIDFrom(ctx), "method", info.FullMethod, "err", err)
+		return resp, hideCause(ctx, err)
+	}
+
+	slog.Debug("response", "id", RequestIDFrom(ctx), "method", info.FullMethod, "resp", marshalRedacted(resp))
+
+	return resp, err
+}
+
+func marshalRedacted(v any) string {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return ""
+	}
+	raw, _ := protojson.Marshal(msg)
+	return redact(raw)
+}
+
+// hideCause replaces the message of errors the client cannot act on.
+func hideCause(ctx context.Context, err error) error {
+	switch code := status.Code(err); code {
+	case codes.Internal, codes.Unknown, codes.Unavailable:
+		return status.Error(code, serverErrorMessage+RequestIDFrom(ctx))
+	}
+	return err
+}
+
+func PanicGRPC(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			slog.Error("panic", "id", RequestIDFrom(ctx), "method", info.FullMethod, "err", p, "stack", string(debug.Stack()))
+			err = status.Error(codes.Internal, serverErrorMessage+RequestIDFrom(ctx))
+		}
+	}()
+
+	return handler(ctx, req)
+}
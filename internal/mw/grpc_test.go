@@ -0,0 +1,65 @@
+package mw
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vestamart/cart/internal/localErr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestLoggerGRPC_HidesServerCauses(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		expectedMsg string
+	}{
+		{"Internal cause is logged only", localErr.ToGRPC(errors.New("repository: lock poisoned")), serverErrorMessage + "req-1"},
+		{"Unavailable cause is logged only", status.Error(codes.Unavailable, "dial loms:50051"), serverErrorMessage + "req-1"},
+		{"Client error keeps the cause", localErr.ToGRPC(localErr.ErrInvalidArgument.WithMsg("bad count")), "invalid argument: bad count"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := captureLogs(t, slog.LevelInfo)
+			ctx := context.WithValue(context.Background(), requestIDKey{}, "req-1")
+			handler := func(context.Context, any) (any, error) { return nil, tt.err }
+
+			_, err := LoggerGRPC(ctx, &structpb.Struct{}, &grpc.UnaryServerInfo{FullMethod: "/cart.v1.Cart/ListCart"}, handler)
+
+			assert.Equal(t, status.Code(tt.err), status.Code(err))
+			assert.Equal(t, tt.expectedMsg, status.Convert(err).Message())
+			assert.Contains(t, buf.String(), status.Convert(tt.err).Message())
+		})
+	}
+}
+
+func TestLoggerGRPC_RedactsBodies(t *testing.T) {
+	buf := captureLogs(t, slog.LevelDebug)
+	req, err := structpb.NewStruct(map[string]any{"user": 1, "preview_token": "abc"})
+	assert.NoError(t, err)
+	handler := func(context.Context, any) (any, error) { return "not a proto message", nil }
+
+	_, err = LoggerGRPC(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: "/cart.v1.Cart/Checkout"}, handler)
+
+	assert.NoError(t, err)
+	assert.NotContains(t, buf.String(), "abc")
+	assert.Contains(t, buf.String(), "[REDACTED]")
+}
+
+func TestPanicGRPC_HidesPanicValue(t *testing.T) {
+	captureLogs(t, slog.LevelError)
+	ctx := context.WithValue(context.Background(), requestIDKey{}, "req-1")
+	handler := func(context.Context, any) (any, error) { panic("secret state") }
+
+	_, err := PanicGRPC(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/cart.v1.Cart/ListCart"}, handler)
+
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Equal(t, serverErrorMessage+"req-1", status.Convert(err).Message())
+}
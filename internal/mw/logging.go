@@ -3,21 +3,41 @@ package mw
 import (
 	"bytes"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
+	"regexp"
 )
 
+// maxLoggedBody caps how much of a request or response body is logged. The
+// rest of a request body is streamed to the handler untouched so body limits
+// still apply.
+const maxLoggedBody = 4 << 10
+
+// secretField matches the string value of a JSON field named secret, token
+// or *_token, also when the logged body cuts it short. Webhook signing
+// secrets, guest tokens and preview tokens are credentials and stay out of
+// the logs.
+var secretField = regexp.MustCompile(`("(?:secret|token|[a-z_]+_token)"\s*:\s*)"(?:[^"\\]|\\.)*"?`)
+
+// redact hides the values of secretField in a logged body.
+func redact(body []byte) string {
+	return secretField.ReplaceAllString(string(body), `$1"[REDACTED]"`)
+}
+
 func LoggerHTTP(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		reqBody, _ := io.ReadAll(r.Body)
-		r.Body = io.NopCloser(bytes.NewBuffer(reqBody))
-		log.Printf("request: method: %v, url: %v,\nbody: %v\n", r.Method, r.URL.Path, string(reqBody))
+		reqBody, _ := io.ReadAll(io.LimitReader(r.Body, maxLoggedBody))
+		r.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(reqBody), r.Body), r.Body}
+		slog.Debug("request", "id", RequestIDFrom(r.Context()), "method", r.Method, "url", r.URL.Path, "body", redact(reqBody))
 
 		rw := &responseWriter{ResponseWriter: w, statusCode: http.StatusOK}
 
 		next.ServeHTTP(rw, r)
 
-		log.Printf("response: method: %v, status: %v, body: %v\n", r.Method, rw.statusCode, rw.body.String())
+		slog.Log(r.Context(), statusLevel(rw.statusCode), "response", "id", RequestIDFrom(r.Context()), "method", r.Method, "url", r.URL.Path, "status", rw.statusCode, "body", redact(rw.body.Bytes()))
 	})
 }
 
@@ -28,11 +48,31 @@ type responseWriter struct {
 }
 
 func (rw *responseWriter) Write(b []byte) (int, error) {
-	rw.body.Write(b)
+	if room := maxLoggedBody - rw.body.Len(); room > 0 {
+		rw.body.Write(b[:min(len(b), room)])
+	}
 	return rw.ResponseWriter.Write(b)
 }
 
+// Unwrap lets http.ResponseController reach the underlying writer, which
+// streaming handlers need to flush.
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
 func (rw *responseWriter) WriteHeader(statusCode int) {
 	rw.statusCode = statusCode
 	rw.ResponseWriter.WriteHeader(statusCode)
 }
+
+// statusLevel logs server errors as errors and client errors as warnings,
+// so that raising the level never hides a failure.
+func statusLevel(status int) slog.Level {
+	switch {
+	case status >= http.StatusInternalServerError:
+		return slog.LevelError
+	case status >= http.StatusBadRequest:
+		return slog.LevelWarn
+	}
+	return slog.LevelInfo
+}
@@ -0,0 +1,96 @@
+package mw
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func captureLogs(t *testing.T, level slog.Level) *bytes.Buffer {
+	var buf bytes.Buffer
+	prev := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: level})))
+	t.Cleanup(func() { slog.SetDefault(prev) })
+	return &buf
+}
+
+func TestLoggerHTTP_Levels(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		expected string
+	}{
+		{"Success - hidden at warn", http.StatusOK, ""},
+		{"Client error", http.StatusNotFound, "level=WARN"},
+		{"Server error", http.StatusServiceUnavailable, "level=ERROR"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := captureLogs(t, slog.LevelWarn)
+			h := LoggerHTTP(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+
+			h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/user/1/cart", nil))
+
+			if tt.expected == "" {
+				assert.Empty(t, buf.String())
+				return
+			}
+			assert.Contains(t, buf.String(), tt.expected)
+			assert.NotContains(t, buf.String(), "msg=request ")
+		})
+	}
+}
+
+func TestPanicHTTP_LoggedAtError(t *testing.T) {
+	buf := captureLogs(t, slog.LevelError)
+	h := PanicHTTP(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic("boom")
+	}))
+
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/user/1/cart", nil))
+
+	assert.Contains(t, buf.String(), "level=ERROR msg=panic")
+	assert.Contains(t, buf.String(), "boom")
+}
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{"Webhook secret", `{"url":"https://a.example","secret":"s3cr\"et"}`, `{"url":"https://a.example","secret":"[REDACTED]"}`},
+		{"Tokens", `{"guest_token": "g1","preview": {"token":"p1"}}`, `{"guest_token": "[REDACTED]","preview": {"token":"[REDACTED]"}}`},
+		{"Cut short", `{"secret":"abc`, `{"secret":"[REDACTED]"`},
+		{"Nothing to hide", `{"count":1,"tokens":2}`, `{"count":1,"tokens":2}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, redact([]byte(tt.body)))
+		})
+	}
+}
+
+func TestLoggerHTTP_RedactsSecrets(t *testing.T) {
+	buf := captureLogs(t, slog.LevelDebug)
+	h := LoggerHTTP(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"wh_1","secret":"generated"}`))
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/webhooks", strings.NewReader(`{"url":"https://a.example","secret":"chosen"}`))
+	h.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.NotContains(t, buf.String(), "chosen")
+	assert.NotContains(t, buf.String(), "generated")
+	assert.Contains(t, buf.String(), "[REDACTED]")
+}
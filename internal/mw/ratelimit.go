@@ -0,0 +1,248 @@
+package mw
+
+import (
+	"context"
+	"github.com/vestamart/cart/internal/auth"
+	"github.com/vestamart/cart/internal/localErr"
+	"github.com/vestamart/cart/internal/problem"
+	"golang.org/x/time/rate"
+	"maps"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var ErrRateLimited = localErr.New(localErr.KindResourceExhausted, "rate_limited", "too many requests")
+
+// RouteClass groups routes that share a rate limit.
+type RouteClass string
+
+const (
+	ClassRead     RouteClass = "read"
+	ClassWrite    RouteClass = "write"
+	ClassCheckout RouteClass = "checkout"
+)
+
+// Limit is a token bucket: RPS tokens are added per second up to Burst.
+// A zero RPS disables limiting for the class.
+type Limit struct {
+	RPS   float64
+	Burst int
+}
+
+type visitor struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// RateLimiter keeps a token bucket per route class and caller. Callers are
+// identified by the token subject or, without a token, by the client IP. As
+// the client picks the {user_id} path value, it only adds a second bucket
+// that the request must pass too. Buckets idle for longer than idleTTL are
+// evicted, and at most maxVisitors are kept: callers that would need a new
+// bucket beyond that are limited.
+type RateLimiter struct {
+	mu             sync.Mutex
+	limits         map[RouteClass]Limit
+	visitors       map[string]*visitor
+	idleTTL        time.Duration
+	maxVisitors    int
+	trustForwarded bool
+	now            func() time.Time
+}
+
+func NewRateLimiter(limits map[RouteClass]Limit, idleTTL time.Duration, maxVisitors int, trustForwarded bool) *RateLimiter {
+	l := &RateLimiter{
+		visitors:       make(map[string]*visitor),
+		idleTTL:        idleTTL,
+		maxVisitors:    maxVisitors,
+		trustForwarded: trustForwarded,
+		now:            time.Now,
+	}
+	l.SetLimits(limits)
+	return l
+}
+
+// SetLimits replaces the limits. Existing buckets are dropped so the new
+// limits apply right away.
+func (l *RateLimiter) SetLimits(limits map[RouteClass]Limit) {
+	copied := make(map[RouteClass]Limit, len(limits))
+	for class, limit := range limits {
+		copied[class] = limit
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if maps.Equal(l.limits, copied) {
+		return
+	}
+	l.limits = copied
+	l.visitors = make(map[string]*visitor)
+}
+
+// Middleware limits the requests of one route class.
+func (l *RateLimiter) Middleware(class RouteClass) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			now := l.now()
+			limiters, limit, ok := l.limiters(class, l.keys(r), now)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if limiters == nil {
+				w.Header().Set("Retry-After", strconv.Itoa(ceilSeconds(l.idleTTL)))
+				problem.Error(w, r, ErrRateLimited.WithMsg("too many callers, try again later"))
+				return
+			}
+
+			var delay time.Duration
+			reservations := make([]*rate.Reservation, 0, len(limiters))
+			for _, limiter := range limiters {
+				res := limiter.ReserveN(now, 1)
+				reservations = append(reservations, res)
+				delay = max(delay, res.DelayFrom(now))
+			}
+			if delay > 0 {
+				for _, res := range reservations {
+					res.CancelAt(now)
+				}
+				setRateLimitHeaders(w, limit, tokensAt(limiters, now))
+				w.Header().Set("Retry-After", strconv.Itoa(ceilSeconds(delay)))
+				problem.Error(w, r, ErrRateLimited.WithMsg("%s limit of %g requests per second exceeded", class, limit.RPS))
+				return
+			}
+
+			setRateLimitHeaders(w, limit, tokensAt(limiters, now))
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Run evicts idle buckets until ctx is done.
+func (l *RateLimiter) Run(ctx context.Context) {
+	ticker := time.NewTicker(l.idleTTL)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			l.evict(l.now())
+		}
+	}
+}
+
+func (l *RateLimiter) evict(now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.evictLocked(now)
+}
+
+// evictLocked must be called with l.mu held.
+func (l *RateLimiter) evictLocked(now time.Time) {
+	for key, v := range l.visitors {
+		if now.Sub(v.lastSeen) > l.idleTTL {
+			delete(l.visitors, key)
+		}
+	}
+}
+
+// limiters returns the buckets of keys for class, false if the class is not
+// limited. The buckets are nil if they do not fit in maxVisitors.
+func (l *RateLimiter) limiters(class RouteClass, keys []string, now time.Time) ([]*rate.Limiter, Limit, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	limit, ok := l.limits[class]
+	if !ok || limit.RPS <= 0 {
+		return nil, limit, false
+	}
+
+	visitors := make([]*visitor, 0, len(keys))
+	var missing []string
+	for _, key := range keys {
+		key = string(class) + "|" + key
+		if v, ok := l.visitors[key]; ok {
+			visitors = append(visitors, v)
+		} else {
+			missing = append(missing, key)
+		}
+	}
+	if len(l.visitors)+len(missing) > l.maxVisitors {
+		l.evictLocked(now)
+		if len(l.visitors)+len(missing) > l.maxVisitors {
+			return nil, limit, true
+		}
+	}
+	for _, key := range missing {
+		v := &visitor{limiter: rate.NewLimiter(rate.Limit(limit.RPS), max(limit.Burst, 1))}
+		l.visitors[key] = v
+		visitors = append(visitors, v)
+	}
+
+	limiters := make([]*rate.Limiter, 0, len(visitors))
+	for _, v := range visitors {
+		v.lastSeen = now
+		limiters = append(limiters, v.limiter)
+	}
+	return limiters, limit, true
+}
+
+// keys returns the buckets a request must pass.
+func (l *RateLimiter) keys(r *http.Request) []string {
+	if p, ok := auth.FromContext(r.Context()); ok {
+		return []string{"user:" + p.Subject}
+	}
+	keys := []string{"ip:" + l.clientIP(r)}
+	if id := r.PathValue("user_id"); id != "" {
+		keys = append(keys, "user:"+id)
+	}
+	return keys
+}
+
+func tokensAt(limiters []*rate.Limiter, now time.Time) float64 {
+	tokens := math.Inf(1)
+	for _, limiter := range limiters {
+		tokens = min(tokens, limiter.TokensAt(now))
+	}
+	return tokens
+}
+
+func (l *RateLimiter) clientIP(r *http.Request) string {
+	if l.trustForwarded {
+		if fwd := r.Header.Get("X-Forwarded-For"); fwd != "" {
+			first, _, _ := strings.Cut(fwd, ",")
+			return strings.TrimSpace(first)
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// setRateLimitHeaders follows the IETF RateLimit header fields draft: the
+// bucket size, the requests left and the seconds until the bucket is full.
+func setRateLimitHeaders(w http.ResponseWriter, limit Limit, tokens float64) {
+	burst := max(limit.Burst, 1)
+	remaining := max(int(math.Floor(tokens)), 0)
+	reset := time.Duration((float64(burst) - tokens) / limit.RPS * float64(time.Second))
+
+	w.Header().Set("RateLimit-Limit", strconv.Itoa(burst))
+	w.Header().Set("RateLimit-Remaining", strconv.Itoa(remaining))
+	w.Header().Set("RateLimit-Reset", strconv.Itoa(ceilSeconds(reset)))
+}
+
+func ceilSeconds(d time.Duration) int {
+	if d <= 0 {
+		return 0
+	}
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,115 @@
+package mw
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter_Middleware(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	limiter := NewRateLimiter(map[RouteClass]Limit{
+		ClassWrite: {RPS: 1, Burst: 2},
+	}, time.Minute, 100, false)
+	limiter.now = func() time.Time { return now }
+
+	mux := http.NewServeMux()
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusNoContent) })
+	mux.Handle("POST /user/{user_id}/cart/{sku_id}", limiter.Middleware(ClassWrite)(ok))
+	mux.Handle("GET /user/{user_id}/cart", limiter.Middleware(ClassRead)(ok))
+
+	do := func(method, path string, ip ...string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		if len(ip) > 0 {
+			req.RemoteAddr = ip[0] + ":1234"
+		}
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := do(http.MethodPost, "/user/1/cart/100")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, "2", rec.Header().Get("RateLimit-Limit"))
+	assert.Equal(t, "1", rec.Header().Get("RateLimit-Remaining"))
+
+	assert.Equal(t, http.StatusNoContent, do(http.MethodPost, "/user/1/cart/100").Code)
+
+	rec = do(http.MethodPost, "/user/1/cart/100")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "1", rec.Header().Get("Retry-After"))
+	assert.Equal(t, "0", rec.Header().Get("RateLimit-Remaining"))
+	assert.Contains(t, rec.Body.String(), `"code":"rate_limited"`)
+
+	// Without a token the client IP is limited, whatever user_id it picks.
+	assert.Equal(t, http.StatusTooManyRequests, do(http.MethodPost, "/user/2/cart/100").Code)
+	// The path user_id is a bucket of its own too, across IPs.
+	assert.Equal(t, http.StatusTooManyRequests, do(http.MethodPost, "/user/1/cart/100", "198.51.100.7").Code)
+	assert.Equal(t, http.StatusNoContent, do(http.MethodPost, "/user/3/cart/100", "198.51.100.7").Code)
+	// Reads are not limited.
+	assert.Equal(t, http.StatusNoContent, do(http.MethodGet, "/user/1/cart").Code)
+
+	now = now.Add(time.Second)
+	assert.Equal(t, http.StatusNoContent, do(http.MethodPost, "/user/1/cart/100").Code)
+}
+
+func TestRateLimiter_Evict(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	limiter := NewRateLimiter(map[RouteClass]Limit{ClassRead: {RPS: 1, Burst: 1}}, time.Minute, 100, false)
+
+	limiter.limiters(ClassRead, []string{"user:1"}, now)
+	limiter.limiters(ClassRead, []string{"user:2"}, now.Add(50*time.Second))
+
+	limiter.evict(now.Add(90 * time.Second))
+	assert.Len(t, limiter.visitors, 1)
+	assert.Contains(t, limiter.visitors, "read|user:2")
+}
+
+func TestRateLimiter_MaxVisitors(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	limiter := NewRateLimiter(map[RouteClass]Limit{ClassRead: {RPS: 1, Burst: 1}}, time.Minute, 2, false)
+
+	_, _, ok := limiter.limiters(ClassRead, []string{"ip:1", "user:1"}, now)
+	require.True(t, ok)
+	limiters, _, _ := limiter.limiters(ClassRead, []string{"ip:2"}, now)
+	assert.Nil(t, limiters, "a third bucket does not fit")
+	limiters, _, _ = limiter.limiters(ClassRead, []string{"ip:1"}, now)
+	assert.Len(t, limiters, 1, "known callers keep their bucket")
+
+	// Idle buckets make room.
+	limiters, _, _ = limiter.limiters(ClassRead, []string{"ip:2"}, now.Add(2*time.Minute))
+	assert.Len(t, limiters, 1)
+	assert.Len(t, limiter.visitors, 1)
+}
+
+// SetLimits drops every bucket on purpose, so a reload also refills the
+// quota of callers who had used theirs up.
+func TestRateLimiter_SetLimitsResetsBuckets(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	limits := map[RouteClass]Limit{ClassWrite: {RPS: 1, Burst: 1}}
+	limiter := NewRateLimiter(limits, time.Minute, 100, false)
+	limiter.now = func() time.Time { return now }
+	h := limiter.Middleware(ClassWrite)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusNoContent) }))
+	do := func() int {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", nil))
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusNoContent, do())
+	assert.Equal(t, http.StatusTooManyRequests, do())
+
+	// The same limits keep the buckets.
+	limiter.SetLimits(map[RouteClass]Limit{ClassWrite: {RPS: 1, Burst: 1}})
+	assert.Equal(t, http.StatusTooManyRequests, do())
+
+	limiter.SetLimits(map[RouteClass]Limit{ClassWrite: {RPS: 1, Burst: 2}})
+	assert.Len(t, limiter.visitors, 0)
+	assert.Equal(t, http.StatusNoContent, do())
+	assert.Equal(t, http.StatusNoContent, do())
+	assert.Equal(t, http.StatusTooManyRequests, do())
+}
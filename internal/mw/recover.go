@@ -0,0 +1,30 @@
+package mw
+
+import (
+	"errors"
+	"github.com/vestamart/cart/internal/localErr"
+	"github.com/vestamart/cart/internal/problem"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+)
+
+// PanicHTTP turns a panic in a handler into a logged stack trace and a 500
+// problem response. http.ErrAbortHandler is re-raised, as net/http expects.
+func PanicHTTP(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			p := recover()
+			if p == nil {
+				return
+			}
+			if err, ok := p.(error); ok && errors.Is(err, http.ErrAbortHandler) {
+				panic(p)
+			}
+			slog.Error("panic", "request_id", RequestIDFrom(r.Context()), "method", r.Method, "url", r.URL.Path, "err", p, "stack", string(debug.Stack()))
+			problem.Error(w, r, localErr.ErrInternal)
+		}()
+
+		next.ServeHTTP(w, r)
+	})
+}
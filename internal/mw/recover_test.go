@@ -0,0 +1,57 @@
+package mw
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPanicHTTP(t *testing.T) {
+	h := RequestID(PanicHTTP(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic("boom")
+	})))
+
+	req := httptest.NewRequest(http.MethodGet, "/user/1/cart", nil)
+	req.Header.Set(RequestIDHeader, "req-1")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Equal(t, "application/problem+json", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "req-1", rec.Header().Get(RequestIDHeader))
+	assert.Contains(t, rec.Body.String(), `"code":"internal"`)
+}
+
+func TestMaxBytes(t *testing.T) {
+	echo := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	h := MaxBytes(16)(echo)
+
+	tests := []struct {
+		name         string
+		body         string
+		chunked      bool
+		expectedCode int
+	}{
+		{"Within limit", `{"count": 2}`, false, http.StatusNoContent},
+		{"Too large", `{"count": 2, "padding": "xxxxxxxx"}`, false, http.StatusRequestEntityTooLarge},
+		{"Too large without Content-Length", `{"count": 2, "padding": "xxxxxxxx"}`, true, http.StatusRequestEntityTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/user/1/cart/1", strings.NewReader(tt.body))
+			if tt.chunked {
+				req.ContentLength = -1
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedCode, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package mw
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"net/http"
+)
+
+const RequestIDHeader = "X-Request-ID"
+
+// requestIDMetadata is RequestIDHeader as gRPC metadata keys are lower case.
+const requestIDMetadata = "x-request-id"
+
+type requestIDKey struct{}
+
+// RequestID takes the request ID from the X-Request-ID header or generates
+// one, stores it in the context and echoes it in the response.
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestIDHeader)
+		if id == "" || len(id) > 128 {
+			id = newRequestID()
+		}
+		w.Header().Set(RequestIDHeader, id)
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestIDKey{}, id)))
+	})
+}
+
+// RequestIDGRPC is RequestID for unary gRPC calls, with the ID in the
+// x-request-id metadata.
+func RequestIDGRPC(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	var id string
+	if v := metadata.ValueFromIncomingContext(ctx, requestIDMetadata); len(v) > 0 {
+		id = v[0]
+	}
+	if id == "" || len(id) > 128 {
+		id = newRequestID()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDMetadata, id))
+	return handler(context.WithValue(ctx, requestIDKey{}, id), req)
+}
+
+func RequestIDFrom(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+func newRequestID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
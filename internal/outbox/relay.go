@@ -0,0 +1,128 @@
+package outbox
+
+import (
+	"context"
+	"github.com/vestamart/cart/internal/domain"
+	"log/slog"
+	"time"
+)
+
+// Store is the outbox written by the repository together with the cart
+// changes.
+type Store interface {
+	FetchOutbox(ctx context.Context, limit int, now time.Time) ([]domain.OutboxRecord, error)
+	AckOutbox(ctx context.Context, ids ...uint64) error
+	RetryOutbox(ctx context.Context, id uint64, next time.Time, lastErr string) error
+}
+
+type RelayConfig struct {
+	BatchSize   int
+	Interval    time.Duration
+	MaxAttempts int
+	Backoff     time.Duration
+	MaxBackoff  time.Duration
+}
+
+// Relay delivers outbox records to a sink at least once. A failed record is
+// retried with exponential backoff; after MaxAttempts it goes to the
+// dead-letter sink and leaves the outbox.
+type Relay struct {
+	store      Store
+	sink       Sink
+	deadLetter Sink
+	cfg        RelayConfig
+	now        func() time.Time
+}
+
+func NewRelay(store Store, sink, deadLetter Sink, cfg RelayConfig) *Relay {
+	return &Relay{store: store, sink: sink, deadLetter: deadLetter, cfg: cfg, now: time.Now}
+}
+
+// Run relays until ctx is done, then flushes what is due once more.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			r.relayOnce(context.Background())
+			return
+		case <-ticker.C:
+			r.relayOnce(ctx)
+		}
+	}
+}
+
+func (r *Relay) relayOnce(ctx context.Context) {
+	for {
+		n, err := r.relayBatch(ctx)
+		if err != nil {
+			slog.Error("outbox: relay", "err", err)
+			return
+		}
+		if n < r.cfg.BatchSize {
+			return
+		}
+	}
+}
+
+// relayBatch sends one batch and returns how many records it fetched.
+func (r *Relay) relayBatch(ctx context.Context) (int, error) {
+	records, err := r.store.FetchOutbox(ctx, r.cfg.BatchSize, r.now())
+	if err != nil {
+		return 0, err
+	}
+
+	// After a failure the remaining records of that user wait for the
+	// retry, so events of one user stay in order.
+	failed := make(map[uint64]struct{})
+	var delivered []uint64
+	for _, rec := range records {
+		if _, ok := failed[rec.Event.UserID]; ok {
+			continue
+		}
+
+		if err = r.sink.Send(ctx, rec); err == nil {
+			delivered = append(delivered, rec.ID)
+			continue
+		}
+
+		failed[rec.Event.UserID] = struct{}{}
+		if rec.Attempts+1 < r.cfg.MaxAttempts {
+			if err = r.store.RetryOutbox(ctx, rec.ID, r.now().Add(r.backoff(rec.Attempts)), err.Error()); err != nil {
+				return len(records), err
+			}
+			continue
+		}
+
+		slog.Error("outbox: record dead-lettered", "record", rec.ID, "attempts", rec.Attempts+1, "err", err)
+		rec.LastError = err.Error()
+		if r.deadLetter != nil {
+			if dlErr := r.deadLetter.Send(ctx, rec); dlErr != nil {
+				slog.Error("outbox: dead-letter record", "record", rec.ID, "err", dlErr)
+				if err = r.store.RetryOutbox(ctx, rec.ID, r.now().Add(r.cfg.MaxBackoff), rec.LastError); err != nil {
+					return len(records), err
+				}
+				continue
+			}
+		}
+		delivered = append(delivered, rec.ID)
+	}
+
+	if len(delivered) > 0 {
+		if err = r.store.AckOutbox(ctx, delivered...); err != nil {
+			return len(records), err
+		}
+	}
+
+	return len(records), nil
+}
+
+func (r *Relay) backoff(attempts int) time.Duration {
+	d := r.cfg.Backoff
+	for i := 0; i < attempts && d < r.cfg.MaxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, r.cfg.MaxBackoff)
+}
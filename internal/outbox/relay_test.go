@@ -0,0 +1,103 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/cart/internal/domain"
+	"github.com/vestamart/cart/internal/repository"
+)
+
+type fakeSink struct {
+	fail map[uint64]bool
+	sent []domain.OutboxRecord
+}
+
+func (s *fakeSink) Send(_ context.Context, rec domain.OutboxRecord) error {
+	if s.fail[rec.Event.UserID] {
+		return errors.New("broker unavailable")
+	}
+	s.sent = append(s.sent, rec)
+	return nil
+}
+
+func newTestRepo(t *testing.T) *repository.InMemoryCartRepository {
+	t.Helper()
+	repo := repository.NewRepository(10)
+	repo.EnableOutbox()
+	ctx := context.Background()
+	require.NoError(t, repo.AddToCart(ctx, 100, 1, 1))
+	require.NoError(t, repo.AddToCart(ctx, 100, 2, 1))
+	require.NoError(t, repo.CheckoutList(ctx, 1, domain.DefaultList, 77))
+	return repo
+}
+
+func TestRelay_DeliversAndAcks(t *testing.T) {
+	repo := newTestRepo(t)
+	sink := &fakeSink{}
+	relay := NewRelay(repo, sink, nil, RelayConfig{BatchSize: 2, Interval: time.Second, MaxAttempts: 3, Backoff: time.Second, MaxBackoff: time.Minute})
+
+	relay.relayOnce(context.Background())
+
+	require.Len(t, sink.sent, 3)
+	assert.Equal(t, domain.EventItemAdded, sink.sent[0].Event.Type)
+	assert.Equal(t, domain.EventCheckout, sink.sent[2].Event.Type)
+	assert.Equal(t, int64(77), sink.sent[2].Event.OrderID)
+
+	pending, err := repo.FetchOutbox(context.Background(), 10, time.Now())
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+func TestRelay_RetriesInOrderAndDeadLetters(t *testing.T) {
+	repo := newTestRepo(t)
+	sink := &fakeSink{fail: map[uint64]bool{1: true}}
+	deadLetter := &fakeSink{}
+	now := time.Unix(1_700_000_000, 0)
+	relay := NewRelay(repo, sink, deadLetter, RelayConfig{BatchSize: 10, Interval: time.Second, MaxAttempts: 2, Backoff: time.Second, MaxBackoff: time.Minute})
+	relay.now = func() time.Time { return now }
+
+	relay.relayOnce(context.Background())
+
+	// User 2 is delivered; user 1 waits for the retry with both its events.
+	require.Len(t, sink.sent, 1)
+	assert.Equal(t, uint64(2), sink.sent[0].Event.UserID)
+	pending, err := repo.FetchOutbox(context.Background(), 10, now)
+	require.NoError(t, err)
+	assert.Empty(t, pending, "records of user 1 must wait for the backoff")
+
+	now = now.Add(time.Second)
+	relay.relayOnce(context.Background())
+
+	require.Len(t, deadLetter.sent, 1)
+	assert.Equal(t, domain.EventItemAdded, deadLetter.sent[0].Event.Type)
+	assert.Equal(t, "broker unavailable", deadLetter.sent[0].LastError)
+}
+
+func TestJSONLSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	sink, err := NewJSONLSink(path)
+	require.NoError(t, err)
+
+	for _, rec := range []domain.OutboxRecord{
+		{ID: 1, Event: domain.CartEvent{ID: 1, Type: domain.EventItemAdded, UserID: 1, SkuID: 100, Count: 2}},
+		{ID: 2, Event: domain.CartEvent{ID: 2, Type: domain.EventCheckout, UserID: 1, OrderID: 77}},
+	} {
+		require.NoError(t, sink.Send(context.Background(), rec))
+	}
+	require.NoError(t, sink.Close())
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(raw)), "\n")
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[0], `"type":"item_added"`)
+	assert.Contains(t, lines[1], `"order_id":77`)
+}
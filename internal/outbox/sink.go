@@ -0,0 +1,101 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/vestamart/cart/internal/domain"
+	"os"
+	"strconv"
+	"sync"
+)
+
+// Sink receives outbox records. Send must be safe to repeat: a record whose
+// delivery fails half-way is sent again.
+type Sink interface {
+	Send(ctx context.Context, rec domain.OutboxRecord) error
+}
+
+// Producer is the subset of a Kafka client the Kafka sink needs. Any
+// Kafka-compatible producer can be adapted to it.
+type Producer interface {
+	Produce(ctx context.Context, topic string, key, value []byte) error
+}
+
+// KafkaSink publishes events as JSON keyed by user ID, so the events of one
+// user land in the same partition and keep their order.
+//
+// The service ships without a Kafka client, so outbox.sink cannot select
+// it. To publish to Kafka, adapt a client to Producer and build the relay in
+// newRelay of cmd/server with NewKafkaSink in place of the JSONL sink; the
+// relay retries and dead-letters it like any other sink.
+type KafkaSink struct {
+	producer Producer
+	topic    string
+}
+
+func NewKafkaSink(producer Producer, topic string) *KafkaSink {
+	return &KafkaSink{producer: producer, topic: topic}
+}
+
+func (s *KafkaSink) Send(ctx context.Context, rec domain.OutboxRecord) error {
+	value, err := json.Marshal(rec.Event)
+	if err != nil {
+		return err
+	}
+	return s.producer.Produce(ctx, s.topic, []byte(strconv.FormatUint(rec.Event.UserID, 10)), value)
+}
+
+// deadLetterLine is a dead-lettered event with the reason it failed.
+type deadLetterLine struct {
+	domain.CartEvent
+	Attempts  int    `json:"attempts"`
+	LastError string `json:"last_error"`
+}
+
+// JSONLSink appends one JSON object per event to a local file.
+type JSONLSink struct {
+	mu         sync.Mutex
+	file       *os.File
+	deadLetter bool
+}
+
+func NewJSONLSink(path string) (*JSONLSink, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &JSONLSink{file: file}, nil
+}
+
+// NewDeadLetterSink is a JSONL sink that also records the attempts and the
+// last delivery error of each event.
+func NewDeadLetterSink(path string) (*JSONLSink, error) {
+	s, err := NewJSONLSink(path)
+	if err != nil {
+		return nil, err
+	}
+	s.deadLetter = true
+	return s, nil
+}
+
+func (s *JSONLSink) Send(_ context.Context, rec domain.OutboxRecord) error {
+	var v any = rec.Event
+	if s.deadLetter {
+		v = deadLetterLine{CartEvent: rec.Event, Attempts: rec.Attempts + 1, LastError: rec.LastError}
+	}
+	line, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err = s.file.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	return s.file.Sync()
+}
+
+func (s *JSONLSink) Close() error {
+	return s.file.Close()
+}
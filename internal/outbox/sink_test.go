@@ -0,0 +1,70 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/cart/internal/domain"
+	"github.com/vestamart/cart/internal/repository"
+)
+
+type message struct {
+	topic      string
+	key, value []byte
+}
+
+type fakeProducer struct {
+	err  error
+	sent []message
+}
+
+func (p *fakeProducer) Produce(_ context.Context, topic string, key, value []byte) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.sent = append(p.sent, message{topic: topic, key: key, value: value})
+	return nil
+}
+
+func TestKafkaSink_Send(t *testing.T) {
+	producer := &fakeProducer{}
+	sink := NewKafkaSink(producer, "cart-events")
+	event := domain.CartEvent{ID: 3, Type: domain.EventCheckout, UserID: 42, OrderID: 77, Time: time.Unix(1700000000, 0).UTC()}
+
+	require.NoError(t, sink.Send(context.Background(), domain.OutboxRecord{ID: 3, Event: event}))
+
+	require.Len(t, producer.sent, 1)
+	assert.Equal(t, "cart-events", producer.sent[0].topic)
+	assert.Equal(t, "42", string(producer.sent[0].key))
+	var got domain.CartEvent
+	require.NoError(t, json.Unmarshal(producer.sent[0].value, &got))
+	assert.Equal(t, event, got)
+}
+
+func TestKafkaSink_ProducerErrorRetried(t *testing.T) {
+	repo := repository.NewRepository(10)
+	repo.EnableOutbox()
+	require.NoError(t, repo.AddToCart(context.Background(), 100, 1, 1))
+	producer := &fakeProducer{err: errors.New("leader not available")}
+	relay := NewRelay(repo, NewKafkaSink(producer, "cart-events"), nil, RelayConfig{BatchSize: 10, Interval: time.Second, MaxAttempts: 3, Backoff: time.Second, MaxBackoff: time.Minute})
+
+	relay.relayOnce(context.Background())
+
+	assert.Empty(t, producer.sent)
+	pending, err := repo.FetchOutbox(context.Background(), 10, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, "leader not available", pending[0].LastError)
+
+	producer.err = nil
+	relay.now = func() time.Time { return time.Now().Add(time.Minute) }
+	relay.relayOnce(context.Background())
+
+	require.Len(t, producer.sent, 1)
+	assert.Equal(t, "1", string(producer.sent[0].key))
+}
@@ -0,0 +1,78 @@
+package problem
+
+import (
+	"encoding/json"
+	"github.com/vestamart/cart/internal/localErr"
+	"github.com/vestamart/cart/internal/validator"
+	"log/slog"
+	"net/http"
+)
+
+const ContentType = "application/problem+json"
+
+// requestIDHeader is the response header mw.RequestID sets before any
+// handler runs.
+const requestIDHeader = "X-Request-ID"
+
+// serverErrorDetail replaces the detail of 5xx problems, whose causes can
+// name internal hosts or dependencies and are only logged.
+const serverErrorDetail = "the request could not be completed, report it with the request_id"
+
+// Problem is an RFC 7807 problem details object.
+type Problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	Code     string `json:"code"`
+	// RequestID is set on 5xx problems to find the logged cause.
+	RequestID string `json:"request_id,omitempty"`
+
+	Errors validator.Errors `json:"errors,omitempty"`
+}
+
+func New(r *http.Request, status int, code, detail string) Problem {
+	title := http.StatusText(status)
+	if title == "" {
+		title = code
+	}
+	return Problem{
+		Type:     "/problems/" + code,
+		Title:    title,
+		Status:   status,
+		Detail:   detail,
+		Instance: r.URL.Path,
+		Code:     code,
+	}
+}
+
+func Write(w http.ResponseWriter, p Problem) {
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(p.Status)
+	_ = json.NewEncoder(w).Encode(p)
+}
+
+// Validation reports every invalid field of a request at once.
+func Validation(w http.ResponseWriter, r *http.Request, errs validator.Errors) {
+	p := New(r, http.StatusBadRequest, localErr.ErrInvalidArgument.Code, "request validation failed")
+	p.Errors = errs
+	Write(w, p)
+}
+
+// Error maps an error to a problem response by its kind and code. Server
+// errors get a generic detail and the request ID; the error itself is only
+// logged.
+func Error(w http.ResponseWriter, r *http.Request, err error) {
+	httpStatus := localErr.HTTPStatus(err)
+	if httpStatus < http.StatusInternalServerError {
+		Write(w, New(r, httpStatus, localErr.CodeOf(err), err.Error()))
+		return
+	}
+
+	requestID := w.Header().Get(requestIDHeader)
+	slog.Error("request failed", "request_id", requestID, "method", r.Method, "url", r.URL.Path, "err", err)
+	p := New(r, httpStatus, localErr.CodeOf(err), serverErrorDetail)
+	p.RequestID = requestID
+	Write(w, p)
+}
@@ -0,0 +1,82 @@
+package problem
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/cart/internal/localErr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWriteError(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		expectedStatus int
+		expectedCode   string
+	}{
+		{"Sku not exist", localErr.ErrSkuNotExist, http.StatusPreconditionFailed, "sku_not_found"},
+		{"Item not enough", localErr.ItemNotEnoughErr, http.StatusPreconditionFailed, "insufficient_stock"},
+		{"Cart not found", localErr.ErrCartNotFound, http.StatusNotFound, "cart_not_found"},
+		{"Invalid argument with message", localErr.ErrInvalidArgument.WithMsg("bad"), http.StatusBadRequest, "invalid_argument"},
+		{"Deadline exceeded wrapped", fmt.Errorf("call: %w", localErr.ErrDeadlineExceeded), http.StatusGatewayTimeout, "dependency_timeout"},
+		{"Dependency failure", localErr.Errorf(localErr.KindDependency, "status 500"), http.StatusBadGateway, "dependency_failed"},
+		{"gRPC unavailable", status.Error(codes.Unavailable, "down"), http.StatusServiceUnavailable, "dependency_unavailable"},
+		{"gRPC internal", status.Error(codes.Internal, "boom"), http.StatusBadGateway, "dependency_failed"},
+		{"Unknown error", errors.New("boom"), http.StatusInternalServerError, "internal"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/user/1/cart", nil)
+
+			Error(rec, req, tt.err)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, ContentType, rec.Header().Get("Content-Type"))
+
+			var p Problem
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&p))
+			assert.Equal(t, tt.expectedCode, p.Code)
+			assert.Equal(t, tt.expectedStatus, p.Status)
+			assert.Equal(t, "/user/1/cart", p.Instance)
+		})
+	}
+}
+
+func TestError_Detail(t *testing.T) {
+	tests := []struct {
+		name              string
+		err               error
+		expectedDetail    string
+		expectedRequestID string
+	}{
+		{"Client error keeps the cause", localErr.ErrInvalidArgument.WithMsg("count must be positive"), "invalid argument: count must be positive", ""},
+		{"Dependency cause is logged only", localErr.Errorf(localErr.KindDependency, "GET http://product.internal:8080: status 500"), serverErrorDetail, "req-1"},
+		{"Internal cause is logged only", errors.New("repository: lock poisoned"), serverErrorDetail, "req-1"},
+		{"Unavailable cause is logged only", status.Error(codes.Unavailable, "dial loms:50051"), serverErrorDetail, "req-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			rec.Header().Set("X-Request-ID", "req-1")
+			req := httptest.NewRequest(http.MethodGet, "/user/1/cart", nil)
+
+			Error(rec, req, tt.err)
+
+			var p Problem
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&p))
+			assert.Equal(t, tt.expectedDetail, p.Detail)
+			assert.Equal(t, tt.expectedRequestID, p.RequestID)
+		})
+	}
+}
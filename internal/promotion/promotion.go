@@ -0,0 +1,231 @@
+// Package promotion prices carts with the discounts of a rules file.
+package promotion
+
+import (
+	"errors"
+	"fmt"
+	"github.com/vestamart/cart/internal/domain"
+	"gopkg.in/yaml.v3"
+	"math"
+	"os"
+	"strings"
+)
+
+type Type string
+
+const (
+	// Percent and Fixed are coupons: they take Percent or Amount off the
+	// order once the coupon is applied to the cart.
+	Percent Type = "percent"
+	Fixed   Type = "fixed"
+	// BuyXGetY makes Get of every Buy+Get units of SkuID free.
+	BuyXGetY Type = "buy_x_get_y"
+	// Threshold takes Percent or Amount off every order of at least
+	// MinTotal.
+	Threshold Type = "threshold"
+)
+
+// Rule is a promotion of the rules file. MinTotal applies to every order
+// level rule and is compared with the total after the line discounts.
+type Rule struct {
+	ID       string `yaml:"id"`
+	Type     Type   `yaml:"type"`
+	Coupon   string `yaml:"coupon"`
+	Percent  uint32 `yaml:"percent"`
+	Amount   uint32 `yaml:"amount"`
+	MinTotal uint32 `yaml:"min_total"`
+	SkuID    int64  `yaml:"sku_id"`
+	Buy      uint16 `yaml:"buy"`
+	Get      uint16 `yaml:"get"`
+}
+
+// discount returns what the rule takes off an order total.
+func (r Rule) discount(total domain.Money) domain.Money {
+	if total.Amount < int64(r.MinTotal) {
+		return domain.Money{}
+	}
+	if r.Percent > 0 {
+		return total.Percent(r.Percent)
+	}
+	return domain.NewMoney(min(int64(r.Amount), total.Amount), total.Currency)
+}
+
+// lineDiscount returns what a BuyXGetY rule takes off a line.
+func (r Rule) lineDiscount(item domain.CartItem) (domain.Money, error) {
+	if item.Sku != r.SkuID {
+		return domain.Money{}, nil
+	}
+	// In uint32, as buy+get may not fit in the uint16 counts.
+	free := uint32(item.Count) / (uint32(r.Buy) + uint32(r.Get)) * uint32(r.Get)
+	return item.Price.Mul(int64(free))
+}
+
+func (r Rule) validate() error {
+	switch r.Type {
+	case Percent:
+		if r.Coupon == "" || r.Percent < 1 || r.Percent > 100 {
+			return errors.New("a percent coupon needs a coupon code and a percent of 1 to 100")
+		}
+	case Fixed:
+		if r.Coupon == "" || r.Amount == 0 {
+			return errors.New("a fixed coupon needs a coupon code and an amount")
+		}
+	case BuyXGetY:
+		if r.SkuID < 1 || r.Buy == 0 || r.Get == 0 {
+			return errors.New("buy_x_get_y needs sku_id, buy and get")
+		}
+		if uint32(r.Buy)+uint32(r.Get) > math.MaxUint16 {
+			return errors.New("buy_x_get_y needs buy+get of at most 65535 items")
+		}
+	case Threshold:
+		if r.MinTotal == 0 || (r.Percent == 0) == (r.Amount == 0) || r.Percent > 100 {
+			return errors.New("threshold needs min_total and either a percent of 1 to 100 or an amount")
+		}
+	default:
+		return fmt.Errorf("unknown type %q", r.Type)
+	}
+	return nil
+}
+
+// Engine applies a set of rules. It is safe for concurrent use.
+type Engine struct {
+	lines      []Rule
+	thresholds []Rule
+	coupons    map[string]Rule
+}
+
+// New validates rules and builds an engine. Coupon codes are case
+// insensitive.
+func New(rules []Rule) (*Engine, error) {
+	e := &Engine{coupons: make(map[string]Rule)}
+	ids := make(map[string]struct{}, len(rules))
+	var errs []error
+	for i, r := range rules {
+		if r.ID == "" {
+			errs = append(errs, fmt.Errorf("promotions[%d]: id is required", i))
+			continue
+		}
+		if _, ok := ids[r.ID]; ok {
+			errs = append(errs, fmt.Errorf("promotions[%d]: duplicate id %q", i, r.ID))
+			continue
+		}
+		ids[r.ID] = struct{}{}
+		if err := r.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("promotions[%d] %s: %w", i, r.ID, err))
+			continue
+		}
+
+		switch r.Type {
+		case Percent, Fixed:
+			code := strings.ToUpper(r.Coupon)
+			if _, ok := e.coupons[code]; ok {
+				errs = append(errs, fmt.Errorf("promotions[%d] %s: duplicate coupon %q", i, r.ID, r.Coupon))
+				continue
+			}
+			e.coupons[code] = r
+		case BuyXGetY:
+			e.lines = append(e.lines, r)
+		case Threshold:
+			e.thresholds = append(e.thresholds, r)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return e, nil
+}
+
+// Load reads the rules file at path:
+//
+//	promotions:
+//	  - id: spring10
+//	    type: percent
+//	    coupon: SPRING10
+//	    percent: 10
+func Load(path string) (*Engine, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file struct {
+		Promotions []Rule `yaml:"promotions"`
+	}
+	if err = yaml.Unmarshal(raw, &file); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	e, err := New(file.Promotions)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return e, nil
+}
+
+// Coupon returns the canonical code of a coupon, false if there is none.
+func (e *Engine) Coupon(code string) (string, bool) {
+	code = strings.ToUpper(code)
+	_, ok := e.coupons[code]
+	return code, ok
+}
+
+// Apply sets the discounts of cart for coupon, "" for none. Each line gets
+// its best BuyXGetY rule, then the best threshold and the coupon are taken
+// off the rest. A coupon that no longer exists is ignored. Amounts of the
+// rules are in the unit of the cart amounts, whole rubles.
+func (e *Engine) Apply(cart *domain.UserCart, coupon string) error {
+	cart.Discounts = nil
+	cart.Coupon = coupon
+
+	subtotal, lines := domain.NewMoney(0, cart.Subtotal.Currency), domain.Money{}
+	for i, item := range cart.Items {
+		line, err := item.Price.Mul(int64(item.Count))
+		if err != nil {
+			return err
+		}
+		if subtotal, err = subtotal.Add(line); err != nil {
+			return err
+		}
+		item.Discount, item.Promotion = domain.Money{}, ""
+		for _, r := range e.lines {
+			d, err := r.lineDiscount(item)
+			if err != nil {
+				return err
+			}
+			if d.Amount > item.Discount.Amount {
+				item.Discount, item.Promotion = d, r.ID
+			}
+		}
+		if lines, err = lines.Add(item.Discount); err != nil {
+			return err
+		}
+		cart.Items[i] = item
+	}
+	cart.Subtotal = subtotal
+	total, err := subtotal.Sub(lines)
+	if err != nil {
+		return err
+	}
+
+	var best domain.Discount
+	for _, r := range e.thresholds {
+		if d := r.discount(total); d.Amount > best.Amount.Amount {
+			best = domain.Discount{Promotion: r.ID, Amount: d}
+		}
+	}
+	if !best.Amount.IsZero() {
+		cart.Discounts = append(cart.Discounts, best)
+		if total, err = total.Sub(best.Amount); err != nil {
+			return err
+		}
+	}
+
+	if r, ok := e.coupons[coupon]; ok {
+		if d := r.discount(total); !d.IsZero() {
+			cart.Discounts = append(cart.Discounts, domain.Discount{Promotion: r.ID, Amount: d})
+			if total, err = total.Sub(d); err != nil {
+				return err
+			}
+		}
+	}
+	cart.TotalPrice = total
+	return nil
+}
@@ -0,0 +1,132 @@
+package promotion
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/cart/internal/domain"
+)
+
+var rules = []Rule{
+	{ID: "spring10", Type: Percent, Coupon: "SPRING10", Percent: 10},
+	{ID: "minus500", Type: Fixed, Coupon: "minus500", Amount: 500, MinTotal: 2000},
+	{ID: "three-for-two", Type: BuyXGetY, SkuID: 1, Buy: 2, Get: 1},
+	{ID: "big", Type: Threshold, MinTotal: 3000, Amount: 300},
+	{ID: "bigger", Type: Threshold, MinTotal: 3000, Percent: 20},
+}
+
+func rub(amount int64) domain.Money {
+	return domain.NewMoney(amount, domain.DefaultCurrency)
+}
+
+func TestEngine_Apply(t *testing.T) {
+	engine, err := New(rules)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name              string
+		items             []domain.CartItem
+		coupon            string
+		expectedDiscounts []int64
+		expected          []domain.Discount
+		expectedTotal     int64
+	}{
+		{
+			name:              "No promotion - plain sum",
+			items:             []domain.CartItem{{Sku: 2, Count: 3, Price: rub(100)}},
+			expectedDiscounts: []int64{0},
+			expectedTotal:     300,
+		},
+		{
+			name:              "Buy two get one - a third of seven free",
+			items:             []domain.CartItem{{Sku: 1, Count: 7, Price: rub(100)}, {Sku: 2, Count: 1, Price: rub(50)}},
+			expectedDiscounts: []int64{200, 0},
+			expectedTotal:     550,
+		},
+		{
+			name:              "Threshold after line discounts - best one",
+			items:             []domain.CartItem{{Sku: 1, Count: 6, Price: rub(1000)}},
+			expectedDiscounts: []int64{2000},
+			expected:          []domain.Discount{{Promotion: "bigger", Amount: rub(800)}},
+			expectedTotal:     3200,
+		},
+		{
+			name:              "Percent coupon - after the threshold",
+			items:             []domain.CartItem{{Sku: 2, Count: 4, Price: rub(1000)}},
+			coupon:            "SPRING10",
+			expectedDiscounts: []int64{0},
+			expected:          []domain.Discount{{Promotion: "bigger", Amount: rub(800)}, {Promotion: "spring10", Amount: rub(320)}},
+			expectedTotal:     2880,
+		},
+		{
+			name:              "Fixed coupon below its minimum - ignored",
+			items:             []domain.CartItem{{Sku: 2, Count: 1, Price: rub(1500)}},
+			coupon:            "MINUS500",
+			expectedDiscounts: []int64{0},
+			expectedTotal:     1500,
+		},
+		{
+			name:              "Unknown coupon - ignored",
+			items:             []domain.CartItem{{Sku: 2, Count: 1, Price: rub(100)}},
+			coupon:            "GONE",
+			expectedDiscounts: []int64{0},
+			expectedTotal:     100,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cart := &domain.UserCart{Items: tt.items}
+			require.NoError(t, engine.Apply(cart, tt.coupon))
+
+			discounts := make([]int64, 0, len(cart.Items))
+			for _, item := range cart.Items {
+				discounts = append(discounts, item.Discount.Amount)
+			}
+			assert.Equal(t, tt.expectedDiscounts, discounts)
+			assert.Equal(t, tt.expected, cart.Discounts)
+			assert.Equal(t, rub(tt.expectedTotal), cart.TotalPrice)
+			assert.Equal(t, tt.coupon, cart.Coupon)
+		})
+	}
+}
+
+func TestRule_LineDiscount_LargeBuy(t *testing.T) {
+	r := Rule{ID: "bulk", Type: BuyXGetY, SkuID: 1, Buy: 65534, Get: 1}
+	d, err := r.lineDiscount(domain.CartItem{Sku: 1, Count: 65535, Price: rub(2)})
+	require.NoError(t, err)
+	assert.Equal(t, rub(2), d)
+}
+
+func TestNew_Invalid(t *testing.T) {
+	_, err := New([]Rule{
+		{ID: "a", Type: Percent, Coupon: "A", Percent: 150},
+		{ID: "a", Type: Fixed, Coupon: "B", Amount: 1},
+		{ID: "b", Type: Fixed, Coupon: "a", Amount: 1},
+		{ID: "c", Type: Fixed, Coupon: "A", Amount: 1},
+		{ID: "d", Type: Threshold, MinTotal: 10, Amount: 1, Percent: 5},
+		{ID: "e", Type: "bogo"},
+		{ID: "f", Type: BuyXGetY, SkuID: 1, Buy: 65535, Get: 1},
+	})
+	require.Error(t, err)
+	assert.Equal(t, `promotions[0] a: a percent coupon needs a coupon code and a percent of 1 to 100
+promotions[1]: duplicate id "a"
+promotions[3] c: duplicate coupon "A"
+promotions[4] d: threshold needs min_total and either a percent of 1 to 100 or an amount
+promotions[5] e: unknown type "bogo"
+promotions[6] f: buy_x_get_y needs buy+get of at most 65535 items`, err.Error())
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "promotions.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("promotions:\n  - id: spring10\n    type: percent\n    coupon: Spring10\n    percent: 10\n"), 0o600))
+
+	engine, err := Load(path)
+	require.NoError(t, err)
+	code, ok := engine.Coupon("spring10")
+	assert.True(t, ok)
+	assert.Equal(t, "SPRING10", code)
+}
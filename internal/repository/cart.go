@@ -3,62 +3,421 @@ package repository
 import (
 	"context"
 	"errors"
+	"github.com/vestamart/cart/internal/domain"
+	"github.com/vestamart/cart/internal/localErr"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 )
 
-// map[userID]map[skuID]count
-type CartStorage = map[uint64]map[int64]uint16
+// map[userID]map[list]map[skuID]count
+type CartStorage = map[uint64]map[string]map[int64]uint16
 
+const defaultMaxLists = 10
+
+// InMemoryCartRepository keeps named lists per user, domain.DefaultList being
+// the cart, and the coupon of the cart. A version per user is bumped by every
+// change of any of the user's lists or of the coupon. Versions survive
+// ClearCart, so a version is never reused for a different content. Changes
+// are recorded in the outbox if it is enabled.
 type InMemoryCartRepository struct {
+	mu          sync.RWMutex
 	cartStorage CartStorage
+	versions    map[uint64]uint64
+	coupons     map[uint64]string
+	updatedAt   map[uint64]time.Time
+	now         func() time.Time
+	maxLists    int
+
+	outboxEnabled bool
+	outboxSeq     uint64
+	outbox        []domain.OutboxRecord
 }
 
 func NewRepository(cap int) *InMemoryCartRepository {
-	return &InMemoryCartRepository{cartStorage: make(CartStorage, cap)}
+	return &InMemoryCartRepository{
+		cartStorage: make(CartStorage, cap),
+		versions:    make(map[uint64]uint64, cap),
+		coupons:     make(map[uint64]string),
+		updatedAt:   make(map[uint64]time.Time, cap),
+		now:         time.Now,
+		maxLists:    defaultMaxLists,
+	}
 }
 
-func (r *InMemoryCartRepository) AddToCart(_ context.Context, skuID int64, userID uint64, count uint16) error {
-	userCart, ok := r.cartStorage[userID]
-	if !ok {
-		userCart = make(map[int64]uint16)
+// SetMaxLists limits the number of lists per user, the cart included. The
+// cart itself can always be created. It must be called before the
+// repository is used.
+func (r *InMemoryCartRepository) SetMaxLists(n int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.maxLists = n
+}
+
+func (r *InMemoryCartRepository) AddToCart(ctx context.Context, skuID int64, userID uint64, count uint16) error {
+	return r.AddToList(ctx, userID, domain.DefaultList, skuID, count)
+}
+
+func (r *InMemoryCartRepository) AddToList(ctx context.Context, userID uint64, list string, skuID int64, count uint16) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := domain.CheckVersion(ctx, r.versions[userID]); err != nil {
+		return err
 	}
 
-	if _, ok := userCart[skuID]; ok {
-		userCart[skuID] += count
-	} else {
-		userCart[skuID] = count
+	sum, err := domain.AddCount(r.cartStorage[userID][list][skuID], count)
+	if err != nil {
+		return err
 	}
+	items, err := r.openList(userID, list)
+	if err != nil {
+		return err
+	}
+	items[skuID] = sum
 
-	r.cartStorage[userID] = userCart
+	r.touch(userID)
+	r.appendOutbox(domain.CartEvent{Type: domain.EventItemAdded, UserID: userID, List: list, SkuID: skuID, Count: count})
 	return nil
 }
 
-func (r *InMemoryCartRepository) RemoveFromCart(_ context.Context, skuID int64, userID uint64) error {
-	userCart, ok := r.cartStorage[userID]
+func (r *InMemoryCartRepository) RemoveFromCart(ctx context.Context, skuID int64, userID uint64) error {
+	return r.RemoveFromList(ctx, userID, domain.DefaultList, skuID)
+}
+
+func (r *InMemoryCartRepository) RemoveFromList(ctx context.Context, userID uint64, list string, skuID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := domain.CheckVersion(ctx, r.versions[userID]); err != nil {
+		return err
+	}
+
+	items, ok := r.cartStorage[userID][list]
 	if !ok {
-		userCart = make(map[int64]uint16)
+		return nil
 	}
 
-	delete(userCart, skuID)
+	if _, ok := items[skuID]; ok {
+		delete(items, skuID)
+		r.touch(userID)
+		r.appendOutbox(domain.CartEvent{Type: domain.EventItemRemoved, UserID: userID, List: list, SkuID: skuID})
+	}
 
 	return nil
 }
 
-func (r *InMemoryCartRepository) ClearCart(_ context.Context, userID uint64) error {
-	_, ok := r.cartStorage[userID]
+// MoveItem moves count of skuID from one list of the user to another, all of
+// it if count is 0 or more than the list holds. It returns the moved count.
+func (r *InMemoryCartRepository) MoveItem(ctx context.Context, userID uint64, from, to string, skuID int64, count uint16) (uint16, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := domain.CheckVersion(ctx, r.versions[userID]); err != nil {
+		return 0, err
+	}
+
+	src, ok := r.cartStorage[userID][from]
+	if !ok {
+		return 0, listNotFound(from)
+	}
+	have, ok := src[skuID]
 	if !ok {
-		return errors.New("user not found")
+		return 0, domain.ErrItemNotInList.WithMsg("sku %d is not in list %q", skuID, from)
+	}
+	if count == 0 || count > have {
+		count = have
+	}
+	sum, err := domain.AddCount(r.cartStorage[userID][to][skuID], count)
+	if err != nil {
+		return 0, err
+	}
+	dst, err := r.openList(userID, to)
+	if err != nil {
+		return 0, err
+	}
+
+	if count == have {
+		delete(src, skuID)
 	} else {
+		src[skuID] = have - count
+	}
+	dst[skuID] = sum
+
+	r.touch(userID)
+	r.appendOutbox(domain.CartEvent{Type: domain.EventItemMoved, UserID: userID, List: from, ToList: to, SkuID: skuID, Count: count})
+	return count, nil
+}
+
+func (r *InMemoryCartRepository) ClearCart(ctx context.Context, userID uint64) error {
+	return r.DeleteList(ctx, userID, domain.DefaultList)
+}
+
+// DeleteList removes a list with its items. Deleting the cart clears it.
+func (r *InMemoryCartRepository) DeleteList(ctx context.Context, userID uint64, list string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.deleteList(ctx, userID, list, domain.CartEvent{Type: domain.EventCartCleared, UserID: userID, List: list})
+}
+
+// CheckoutList removes the list of a placed order and records the checkout
+// event.
+func (r *InMemoryCartRepository) CheckoutList(ctx context.Context, userID uint64, list string, orderID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.deleteList(ctx, userID, list, domain.CartEvent{Type: domain.EventCheckout, UserID: userID, List: list, OrderID: orderID})
+}
+
+// CheckoutItems removes the purchased counts of a partial checkout from the
+// list and records the checkout event. Counts above what the list holds
+// remove the sku; a list left empty is removed.
+func (r *InMemoryCartRepository) CheckoutItems(ctx context.Context, userID uint64, list string, items map[int64]uint16, orderID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := domain.CheckVersion(ctx, r.versions[userID]); err != nil {
+		return err
+	}
+
+	lists := r.cartStorage[userID]
+	current, ok := lists[list]
+	if !ok {
+		return listNotFound(list)
+	}
+	for skuID, count := range items {
+		if have := current[skuID]; have > count {
+			current[skuID] = have - count
+		} else {
+			delete(current, skuID)
+		}
+	}
+	if len(current) == 0 {
+		r.dropList(userID, list)
+	}
+
+	r.touch(userID)
+	if len(lists) == 0 {
+		delete(r.cartStorage, userID)
+		delete(r.updatedAt, userID)
+	}
+	r.appendOutbox(domain.CartEvent{Type: domain.EventCheckout, UserID: userID, List: list, OrderID: orderID, Items: maps.Clone(items)})
+	return nil
+}
+
+func (r *InMemoryCartRepository) deleteList(ctx context.Context, userID uint64, list string, event domain.CartEvent) error {
+	if err := domain.CheckVersion(ctx, r.versions[userID]); err != nil {
+		return err
+	}
+
+	lists := r.cartStorage[userID]
+	if _, ok := lists[list]; !ok {
+		return listNotFound(list)
+	}
+
+	r.dropList(userID, list)
+	r.touch(userID)
+	if len(lists) == 0 {
 		delete(r.cartStorage, userID)
+		delete(r.updatedAt, userID)
+	}
+	r.appendOutbox(event)
+	return nil
+}
+
+// dropList removes a list of the user and, with the cart, its coupon. It
+// must be called with r.mu held.
+func (r *InMemoryCartRepository) dropList(userID uint64, list string) {
+	delete(r.cartStorage[userID], list)
+	if list == domain.DefaultList {
+		delete(r.coupons, userID)
+	}
+}
+
+// SetCoupon applies a coupon to the cart, replacing the previous one.
+func (r *InMemoryCartRepository) SetCoupon(ctx context.Context, userID uint64, code string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := domain.CheckVersion(ctx, r.versions[userID]); err != nil {
+		return err
+	}
+	if _, ok := r.cartStorage[userID][domain.DefaultList]; !ok {
+		return localErr.ErrCartNotFound
 	}
 
+	r.coupons[userID] = code
+	r.touch(userID)
+	r.appendOutbox(domain.CartEvent{Type: domain.EventCouponApplied, UserID: userID, List: domain.DefaultList, Coupon: code})
 	return nil
 }
 
-func (r *InMemoryCartRepository) GetCart(_ context.Context, userID uint64) (map[int64]uint16, error) {
-	_, ok := r.cartStorage[userID]
+// RemoveCoupon removes the coupon of the cart, if any.
+func (r *InMemoryCartRepository) RemoveCoupon(ctx context.Context, userID uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := domain.CheckVersion(ctx, r.versions[userID]); err != nil {
+		return err
+	}
+
+	code, ok := r.coupons[userID]
 	if !ok {
+		return nil
+	}
+	delete(r.coupons, userID)
+	r.touch(userID)
+	r.appendOutbox(domain.CartEvent{Type: domain.EventCouponRemoved, UserID: userID, List: domain.DefaultList, Coupon: code})
+	return nil
+}
+
+// GetCoupon returns the coupon of the cart, "" if there is none.
+func (r *InMemoryCartRepository) GetCoupon(_ context.Context, userID uint64) (string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.coupons[userID], nil
+}
+
+// MergeCart sets the count of every sku in items in the cart, removing the
+// skus with a zero count, and records a single cart_merged event.
+func (r *InMemoryCartRepository) MergeCart(ctx context.Context, userID uint64, items map[int64]uint16) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := domain.CheckVersion(ctx, r.versions[userID]); err != nil {
+		return err
+	}
+
+	userCart, err := r.openList(userID, domain.DefaultList)
+	if err != nil {
+		return err
+	}
+	for skuID, count := range items {
+		if count == 0 {
+			delete(userCart, skuID)
+		} else {
+			userCart[skuID] = count
+		}
+	}
+
+	r.touch(userID)
+	r.appendOutbox(domain.CartEvent{Type: domain.EventCartMerged, UserID: userID, List: domain.DefaultList})
+	return nil
+}
+
+// GetCart returns nil for a user without a cart.
+func (r *InMemoryCartRepository) GetCart(ctx context.Context, userID uint64) (map[int64]uint16, error) {
+	items, err := r.GetList(ctx, userID, domain.DefaultList)
+	if errors.Is(err, localErr.ErrCartNotFound) {
 		return nil, nil
 	}
+	return items, err
+}
+
+func (r *InMemoryCartRepository) GetList(_ context.Context, userID uint64, list string) (map[int64]uint16, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	items, ok := r.cartStorage[userID][list]
+	if !ok {
+		return nil, listNotFound(list)
+	}
+
+	return maps.Clone(items), nil
+}
+
+// Lists returns the lists of the user sorted by name. The cart is always
+// listed, even if the user has none.
+func (r *InMemoryCartRepository) Lists(_ context.Context, userID uint64) ([]domain.ListSummary, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	lists := r.cartStorage[userID]
+	summaries := make([]domain.ListSummary, 0, len(lists)+1)
+	if _, ok := lists[domain.DefaultList]; !ok {
+		summaries = append(summaries, domain.ListSummary{Name: domain.DefaultList})
+	}
+	for name, items := range lists {
+		summary := domain.ListSummary{Name: name, Items: len(items)}
+		for _, count := range items {
+			summary.Count += int(count)
+		}
+		summaries = append(summaries, summary)
+	}
+	slices.SortFunc(summaries, func(a, b domain.ListSummary) int { return strings.Compare(a.Name, b.Name) })
+
+	return summaries, nil
+}
+
+// openList returns the list of the user, creating it if the user has fewer
+// than maxLists lists. The cart is always opened, so that a user who has
+// used up the limit on named lists keeps a cart. It must be called with r.mu
+// held.
+func (r *InMemoryCartRepository) openList(userID uint64, list string) (map[int64]uint16, error) {
+	lists, ok := r.cartStorage[userID]
+	if !ok {
+		lists = make(map[string]map[int64]uint16)
+		r.cartStorage[userID] = lists
+	}
+	items, ok := lists[list]
+	if ok {
+		return items, nil
+	}
+	if list != domain.DefaultList && len(lists) >= r.maxLists {
+		return nil, domain.ErrTooManyLists.WithMsg("a user may have at most %d lists", r.maxLists)
+	}
+	items = make(map[int64]uint16)
+	lists[list] = items
+	return items, nil
+}
+
+// listNotFound keeps ErrCartNotFound for the cart, as before named lists.
+func listNotFound(list string) error {
+	if list == domain.DefaultList {
+		return localErr.ErrCartNotFound
+	}
+	return domain.ErrListNotFound.WithMsg("list %q not found", list)
+}
+
+// touch records a change of the cart of userID. It must be called with r.mu
+// held.
+func (r *InMemoryCartRepository) touch(userID uint64) {
+	r.versions[userID]++
+	r.updatedAt[userID] = r.now()
+}
+
+// IdleCarts returns the non-empty carts that have not changed since before.
+func (r *InMemoryCartRepository) IdleCarts(_ context.Context, before time.Time) ([]domain.IdleCart, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var idle []domain.IdleCart
+	for userID, lists := range r.cartStorage {
+		items := lists[domain.DefaultList]
+		updatedAt := r.updatedAt[userID]
+		if len(items) == 0 || !updatedAt.Before(before) {
+			continue
+		}
+		idle = append(idle, domain.IdleCart{
+			UserID:    userID,
+			Items:     maps.Clone(items),
+			Version:   r.versions[userID],
+			UpdatedAt: updatedAt,
+		})
+	}
+
+	return idle, nil
+}
+
+// GetVersion returns the cart version of userID, 0 for a user that never had
+// a cart.
+func (r *InMemoryCartRepository) GetVersion(_ context.Context, userID uint64) (uint64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 
-	return r.cartStorage[userID], nil
+	return r.versions[userID], nil
 }
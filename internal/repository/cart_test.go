@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"github.com/vestamart/cart/internal/app/cart"
+	"github.com/vestamart/cart/internal/domain"
+	"github.com/vestamart/cart/internal/localErr"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -50,6 +53,17 @@ func TestInMemoryRepository_AddToCart(t *testing.T) {
 			expectedCart: map[int64]uint16{123: 2, 789: 1},
 			expectedErr:  nil,
 		},
+		{
+			name:   "Count overflow - error, count kept",
+			skuID:  123,
+			userID: 456,
+			count:  2,
+			prepareCart: func(ctx context.Context, repo cart.Repository) {
+				_ = repo.AddToCart(ctx, 123, 456, 65535)
+			},
+			expectedCart: map[int64]uint16{123: 65535},
+			expectedErr:  domain.ErrCountOverflow,
+		},
 	}
 
 	for _, tt := range tests {
@@ -62,7 +76,7 @@ func TestInMemoryRepository_AddToCart(t *testing.T) {
 			}
 
 			err := repo.AddToCart(ctx, tt.skuID, tt.userID, tt.count)
-			assert.NoError(t, err)
+			assert.ErrorIs(t, err, tt.expectedErr)
 
 			cart, err := repo.GetCart(ctx, tt.userID)
 			assert.NoError(t, err)
@@ -240,3 +254,167 @@ func BenchmarkHandler_RemoveFromCart(b *testing.B) {
 		_ = repo.AddToCart(ctx, skuID, userID, 1)
 	}
 }
+
+func TestInMemoryRepository_Version(t *testing.T) {
+	repo := NewRepository(10)
+	ctx := context.Background()
+
+	version := func() uint64 {
+		v, err := repo.GetVersion(ctx, 456)
+		assert.NoError(t, err)
+		return v
+	}
+
+	assert.Equal(t, uint64(0), version())
+
+	assert.NoError(t, repo.AddToCart(ctx, 123, 456, 1))
+	assert.Equal(t, uint64(1), version())
+
+	// Removing an item that is not in the cart changes nothing.
+	assert.NoError(t, repo.RemoveFromCart(ctx, 789, 456))
+	assert.Equal(t, uint64(1), version())
+
+	err := repo.AddToCart(domain.WithIfMatch(ctx, 0), 123, 456, 1)
+	assert.ErrorIs(t, err, domain.ErrVersionMismatch)
+	assert.Equal(t, uint64(1), version())
+
+	assert.NoError(t, repo.AddToCart(domain.WithIfMatch(ctx, 0, 1), 123, 456, 1))
+	assert.Equal(t, uint64(2), version())
+
+	// The version is not reused after the cart is cleared.
+	assert.NoError(t, repo.ClearCart(domain.WithIfMatch(ctx, 2), 456))
+	assert.Equal(t, uint64(3), version())
+}
+
+func TestInMemoryRepository_IdleCarts(t *testing.T) {
+	repo := NewRepository(10)
+	ctx := context.Background()
+	now := time.Unix(1_700_000_000, 0)
+	repo.now = func() time.Time { return now }
+
+	assert.NoError(t, repo.AddToCart(ctx, 123, 1, 2))
+	assert.NoError(t, repo.AddToCart(ctx, 123, 2, 1))
+	assert.NoError(t, repo.AddToCart(ctx, 123, 3, 1))
+	assert.NoError(t, repo.ClearCart(ctx, 3))
+
+	now = now.Add(time.Hour)
+	assert.NoError(t, repo.AddToCart(ctx, 456, 2, 1))
+
+	idle, err := repo.IdleCarts(ctx, now.Add(-30*time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.IdleCart{{
+		UserID:    1,
+		Items:     map[int64]uint16{123: 2},
+		Version:   1,
+		UpdatedAt: now.Add(-time.Hour),
+	}}, idle)
+}
+
+func TestInMemoryRepository_MergeCart(t *testing.T) {
+	repo := NewRepository(10)
+	ctx := context.Background()
+
+	assert.NoError(t, repo.AddToCart(ctx, 123, 456, 2))
+	assert.NoError(t, repo.AddToCart(ctx, 789, 456, 1))
+
+	err := repo.MergeCart(domain.WithIfMatch(ctx, 1), 456, map[int64]uint16{123: 5})
+	assert.ErrorIs(t, err, domain.ErrVersionMismatch)
+
+	assert.NoError(t, repo.MergeCart(domain.WithIfMatch(ctx, 2), 456, map[int64]uint16{123: 5, 789: 0, 1011: 1}))
+	cart, err := repo.GetCart(ctx, 456)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64]uint16{123: 5, 1011: 1}, cart)
+}
+
+func TestInMemoryRepository_Lists(t *testing.T) {
+	repo := NewRepository(10)
+	repo.SetMaxLists(3)
+	ctx := context.Background()
+
+	assert.NoError(t, repo.AddToCart(ctx, 123, 456, 3))
+	assert.NoError(t, repo.AddToList(ctx, 456, "office", 789, 1))
+
+	// Save two of the three for later.
+	moved, err := repo.MoveItem(ctx, 456, domain.DefaultList, domain.SavedList, 123, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(2), moved)
+
+	_, err = repo.MoveItem(ctx, 456, "office", domain.SavedList, 123, 0)
+	assert.ErrorIs(t, err, domain.ErrItemNotInList)
+	assert.ErrorIs(t, repo.AddToList(ctx, 456, "home", 123, 1), domain.ErrTooManyLists)
+
+	lists, err := repo.Lists(ctx, 456)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.ListSummary{
+		{Name: domain.DefaultList, Items: 1, Count: 1},
+		{Name: "office", Items: 1, Count: 1},
+		{Name: domain.SavedList, Items: 1, Count: 2},
+	}, lists)
+
+	assert.NoError(t, repo.CheckoutList(ctx, 456, "office", 77))
+	_, err = repo.GetList(ctx, 456, "office")
+	assert.ErrorIs(t, err, domain.ErrListNotFound)
+
+	// The cart stays a cart: its items are untouched by the other lists.
+	cart, err := repo.GetCart(ctx, 456)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64]uint16{123: 1}, cart)
+}
+
+func TestInMemoryRepository_MaxListsKeepsCart(t *testing.T) {
+	repo := NewRepository(10)
+	repo.SetMaxLists(2)
+	ctx := context.Background()
+
+	assert.NoError(t, repo.AddToList(ctx, 456, "office", 789, 1))
+	assert.NoError(t, repo.AddToList(ctx, 456, "home", 789, 1))
+	assert.ErrorIs(t, repo.AddToList(ctx, 456, "garage", 789, 1), domain.ErrTooManyLists)
+
+	assert.NoError(t, repo.AddToCart(ctx, 123, 456, 1))
+	assert.NoError(t, repo.MergeCart(ctx, 456, map[int64]uint16{124: 2}))
+	cart, err := repo.GetCart(ctx, 456)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64]uint16{123: 1, 124: 2}, cart)
+}
+
+func TestInMemoryRepository_CheckoutItems(t *testing.T) {
+	repo := NewRepository(10)
+	ctx := context.Background()
+
+	assert.NoError(t, repo.AddToCart(ctx, 123, 456, 3))
+	assert.NoError(t, repo.AddToCart(ctx, 789, 456, 1))
+
+	// Only the purchased counts leave the cart.
+	assert.NoError(t, repo.CheckoutItems(ctx, 456, domain.DefaultList, map[int64]uint16{123: 2, 789: 1}, 77))
+	cart, err := repo.GetCart(ctx, 456)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64]uint16{123: 1}, cart)
+
+	assert.NoError(t, repo.CheckoutItems(ctx, 456, domain.DefaultList, map[int64]uint16{123: 1}, 78))
+	cart, err = repo.GetCart(ctx, 456)
+	assert.NoError(t, err)
+	assert.Nil(t, cart)
+}
+
+func TestInMemoryRepository_Coupon(t *testing.T) {
+	repo := NewRepository(10)
+	ctx := context.Background()
+
+	assert.ErrorIs(t, repo.SetCoupon(ctx, 456, "SPRING10"), localErr.ErrCartNotFound)
+
+	assert.NoError(t, repo.AddToCart(ctx, 123, 456, 2))
+	assert.NoError(t, repo.SetCoupon(ctx, 456, "SPRING10"))
+	coupon, err := repo.GetCoupon(ctx, 456)
+	assert.NoError(t, err)
+	assert.Equal(t, "SPRING10", coupon)
+	version, _ := repo.GetVersion(ctx, 456)
+	assert.Equal(t, uint64(2), version)
+
+	// The coupon goes with the cart.
+	assert.NoError(t, repo.CheckoutList(ctx, 456, domain.DefaultList, 77))
+	coupon, err = repo.GetCoupon(ctx, 456)
+	assert.NoError(t, err)
+	assert.Empty(t, coupon)
+
+	assert.NoError(t, repo.RemoveCoupon(ctx, 456))
+}
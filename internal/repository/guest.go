@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"github.com/vestamart/cart/internal/domain"
+	"maps"
+	"sync"
+	"time"
+)
+
+type guestCart struct {
+	items     map[int64]uint16
+	updatedAt time.Time
+}
+
+// InMemoryGuestRepository keeps the carts of anonymous users by token. A
+// guest cart expires ttl after its last change. At most maxCarts live at a
+// time, as anyone can create them.
+type InMemoryGuestRepository struct {
+	mu       sync.Mutex
+	carts    map[string]*guestCart
+	ttl      time.Duration
+	maxCarts int
+	now      func() time.Time
+}
+
+func NewGuestRepository(ttl time.Duration, maxCarts int) *InMemoryGuestRepository {
+	return &InMemoryGuestRepository{
+		carts:    make(map[string]*guestCart),
+		ttl:      ttl,
+		maxCarts: maxCarts,
+		now:      time.Now,
+	}
+}
+
+func (r *InMemoryGuestRepository) AddToGuestCart(_ context.Context, token string, skuID int64, count uint16) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cart, ok := r.get(token)
+	if !ok {
+		if len(r.carts) >= r.maxCarts {
+			r.pruneLocked(r.now())
+		}
+		if len(r.carts) >= r.maxCarts {
+			return domain.ErrTooManyGuestCarts
+		}
+		cart = &guestCart{items: make(map[int64]uint16)}
+		r.carts[token] = cart
+	}
+	sum, err := domain.AddCount(cart.items[skuID], count)
+	if err != nil {
+		return err
+	}
+	cart.items[skuID] = sum
+	cart.updatedAt = r.now()
+	return nil
+}
+
+func (r *InMemoryGuestRepository) RemoveFromGuestCart(_ context.Context, token string, skuID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cart, ok := r.get(token)
+	if !ok {
+		return domain.ErrGuestCartNotFound
+	}
+	if _, ok := cart.items[skuID]; ok {
+		delete(cart.items, skuID)
+		cart.updatedAt = r.now()
+	}
+	return nil
+}
+
+// GetGuestCart returns ErrGuestCartNotFound for unknown and expired tokens.
+func (r *InMemoryGuestRepository) GetGuestCart(_ context.Context, token string) (map[int64]uint16, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cart, ok := r.get(token)
+	if !ok {
+		return nil, domain.ErrGuestCartNotFound
+	}
+	return maps.Clone(cart.items), nil
+}
+
+func (r *InMemoryGuestRepository) DeleteGuestCart(_ context.Context, token string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.carts, token)
+	return nil
+}
+
+// get returns the live cart of token. It must be called with r.mu held.
+func (r *InMemoryGuestRepository) get(token string) (*guestCart, bool) {
+	cart, ok := r.carts[token]
+	if !ok {
+		return nil, false
+	}
+	if r.expired(cart, r.now()) {
+		delete(r.carts, token)
+		return nil, false
+	}
+	return cart, true
+}
+
+func (r *InMemoryGuestRepository) expired(cart *guestCart, now time.Time) bool {
+	return now.Sub(cart.updatedAt) > r.ttl
+}
+
+// Run drops expired guest carts until ctx is done.
+func (r *InMemoryGuestRepository) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.ttl)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.prune(r.now())
+		}
+	}
+}
+
+func (r *InMemoryGuestRepository) prune(now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.pruneLocked(now)
+}
+
+// pruneLocked must be called with r.mu held.
+func (r *InMemoryGuestRepository) pruneLocked(now time.Time) {
+	for token, cart := range r.carts {
+		if r.expired(cart, now) {
+			delete(r.carts, token)
+		}
+	}
+}
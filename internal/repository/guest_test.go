@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vestamart/cart/internal/domain"
+)
+
+func TestInMemoryGuestRepository_Expiry(t *testing.T) {
+	repo := NewGuestRepository(time.Hour, 10)
+	ctx := context.Background()
+	now := time.Unix(1_700_000_000, 0)
+	repo.now = func() time.Time { return now }
+
+	assert.NoError(t, repo.AddToGuestCart(ctx, "a", 123, 1))
+	assert.NoError(t, repo.AddToGuestCart(ctx, "a", 123, 2))
+	assert.NoError(t, repo.AddToGuestCart(ctx, "b", 456, 1))
+
+	now = now.Add(45 * time.Minute)
+	assert.NoError(t, repo.AddToGuestCart(ctx, "b", 789, 1))
+
+	// a was last changed 75 minutes ago, b 30 minutes ago.
+	now = now.Add(30 * time.Minute)
+	_, err := repo.GetGuestCart(ctx, "a")
+	assert.ErrorIs(t, err, domain.ErrGuestCartNotFound)
+
+	cart, err := repo.GetGuestCart(ctx, "b")
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64]uint16{456: 1, 789: 1}, cart)
+
+	now = now.Add(2 * time.Hour)
+	repo.prune(now)
+	assert.Empty(t, repo.carts)
+}
+
+func TestInMemoryGuestRepository_MaxCarts(t *testing.T) {
+	repo := NewGuestRepository(time.Hour, 2)
+	ctx := context.Background()
+	now := time.Unix(1_700_000_000, 0)
+	repo.now = func() time.Time { return now }
+
+	assert.NoError(t, repo.AddToGuestCart(ctx, "a", 123, 1))
+	assert.NoError(t, repo.AddToGuestCart(ctx, "b", 123, 1))
+	assert.ErrorIs(t, repo.AddToGuestCart(ctx, "c", 123, 1), domain.ErrTooManyGuestCarts)
+	// Existing carts still take items.
+	assert.NoError(t, repo.AddToGuestCart(ctx, "a", 456, 1))
+
+	// An expired cart makes room.
+	now = now.Add(30 * time.Minute)
+	assert.NoError(t, repo.AddToGuestCart(ctx, "b", 456, 1))
+	now = now.Add(45 * time.Minute)
+	assert.NoError(t, repo.AddToGuestCart(ctx, "c", 123, 1))
+}
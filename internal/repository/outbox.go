@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"github.com/vestamart/cart/internal/domain"
+	"time"
+)
+
+// EnableOutbox makes every cart change also write its event to the outbox,
+// under the same lock as the change itself. It must be called before the
+// repository is used.
+func (r *InMemoryCartRepository) EnableOutbox() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.outboxEnabled = true
+}
+
+// appendOutbox must be called with r.mu held.
+func (r *InMemoryCartRepository) appendOutbox(event domain.CartEvent) {
+	if !r.outboxEnabled {
+		return
+	}
+	r.outboxSeq++
+	event.ID = r.outboxSeq
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+	r.outbox = append(r.outbox, domain.OutboxRecord{ID: r.outboxSeq, Event: event})
+}
+
+// FetchOutbox returns up to limit records that are due at now, oldest first.
+// Records of a user that has an earlier record waiting for a retry are held
+// back, so events of one user are delivered in order.
+func (r *InMemoryCartRepository) FetchOutbox(_ context.Context, limit int, now time.Time) ([]domain.OutboxRecord, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var due []domain.OutboxRecord
+	blocked := make(map[uint64]struct{})
+	for _, rec := range r.outbox {
+		if len(due) == limit {
+			break
+		}
+		if _, ok := blocked[rec.Event.UserID]; ok {
+			continue
+		}
+		if rec.NextAttempt.After(now) {
+			blocked[rec.Event.UserID] = struct{}{}
+			continue
+		}
+		due = append(due, rec)
+	}
+
+	return due, nil
+}
+
+// AckOutbox removes delivered records.
+func (r *InMemoryCartRepository) AckOutbox(_ context.Context, ids ...uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	acked := make(map[uint64]struct{}, len(ids))
+	for _, id := range ids {
+		acked[id] = struct{}{}
+	}
+	kept := r.outbox[:0]
+	for _, rec := range r.outbox {
+		if _, ok := acked[rec.ID]; !ok {
+			kept = append(kept, rec)
+		}
+	}
+	clear(r.outbox[len(kept):])
+	r.outbox = kept
+
+	return nil
+}
+
+// RetryOutbox records a failed delivery and when to try again.
+func (r *InMemoryCartRepository) RetryOutbox(_ context.Context, id uint64, next time.Time, lastErr string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := range r.outbox {
+		if r.outbox[i].ID == id {
+			r.outbox[i].Attempts++
+			r.outbox[i].NextAttempt = next
+			r.outbox[i].LastError = lastErr
+			break
+		}
+	}
+
+	return nil
+}
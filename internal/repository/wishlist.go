@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"github.com/vestamart/cart/internal/domain"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+)
+
+// InMemoryWishlistRepository keeps the wishlists of all users.
+type InMemoryWishlistRepository struct {
+	mu       sync.Mutex
+	items    map[uint64]map[int64]*domain.WishlistItem
+	maxItems int
+	now      func() time.Time
+}
+
+func NewWishlistRepository(maxItems int) *InMemoryWishlistRepository {
+	return &InMemoryWishlistRepository{
+		items:    make(map[uint64]map[int64]*domain.WishlistItem),
+		maxItems: maxItems,
+		now:      time.Now,
+	}
+}
+
+// AddToWishlist adds skuID to the wishlist of the user. Adding a sku that is
+// already there returns the existing item.
+func (r *InMemoryWishlistRepository) AddToWishlist(_ context.Context, userID uint64, skuID int64) (domain.WishlistItem, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	wishlist, ok := r.items[userID]
+	if !ok {
+		wishlist = make(map[int64]*domain.WishlistItem)
+		r.items[userID] = wishlist
+	}
+	if item, ok := wishlist[skuID]; ok {
+		return *item, nil
+	}
+	if len(wishlist) >= r.maxItems {
+		return domain.WishlistItem{}, domain.ErrWishlistFull.WithMsg("a wishlist holds at most %d skus", r.maxItems)
+	}
+
+	item := &domain.WishlistItem{SkuID: skuID, AddedAt: r.now()}
+	wishlist[skuID] = item
+	return *item, nil
+}
+
+func (r *InMemoryWishlistRepository) RemoveFromWishlist(_ context.Context, userID uint64, skuID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.items[userID][skuID]; !ok {
+		return domain.ErrNotInWishlist.WithMsg("sku %d is not in the wishlist", skuID)
+	}
+	delete(r.items[userID], skuID)
+	if len(r.items[userID]) == 0 {
+		delete(r.items, userID)
+	}
+	return nil
+}
+
+// GetWishlist returns the wishlist of the user, oldest item first.
+func (r *InMemoryWishlistRepository) GetWishlist(_ context.Context, userID uint64) ([]domain.WishlistItem, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	items := make([]domain.WishlistItem, 0, len(r.items[userID]))
+	for _, item := range r.items[userID] {
+		items = append(items, *item)
+	}
+	slices.SortFunc(items, func(a, b domain.WishlistItem) int {
+		if c := a.AddedAt.Compare(b.AddedAt); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.SkuID, b.SkuID)
+	})
+	return items, nil
+}
+
+// WishlistedSkus returns every sku on some wishlist.
+func (r *InMemoryWishlistRepository) WishlistedSkus(_ context.Context) ([]int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	seen := make(map[int64]struct{})
+	for _, wishlist := range r.items {
+		for skuID := range wishlist {
+			seen[skuID] = struct{}{}
+		}
+	}
+	return slices.Sorted(maps.Keys(seen)), nil
+}
+
+// MarkAvailability records whether skuID is in stock. When it is, the users
+// that were not notified yet are returned and marked as notified; when it is
+// not, all users are waiting again.
+func (r *InMemoryWishlistRepository) MarkAvailability(_ context.Context, skuID int64, inStock bool) ([]uint64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var notify []uint64
+	now := r.now()
+	for userID, wishlist := range r.items {
+		item, ok := wishlist[skuID]
+		if !ok {
+			continue
+		}
+		switch {
+		case !inStock:
+			item.NotifiedAt = time.Time{}
+		case item.NotifiedAt.IsZero():
+			item.NotifiedAt = now
+			notify = append(notify, userID)
+		}
+	}
+	slices.Sort(notify)
+	return notify, nil
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vestamart/cart/internal/domain"
+)
+
+func TestInMemoryWishlistRepository(t *testing.T) {
+	repo := NewWishlistRepository(2)
+	ctx := context.Background()
+
+	first, err := repo.AddToWishlist(ctx, 1, 100)
+	assert.NoError(t, err)
+	again, err := repo.AddToWishlist(ctx, 1, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, first, again, "adding twice keeps the item")
+
+	_, err = repo.AddToWishlist(ctx, 1, 200)
+	assert.NoError(t, err)
+	_, err = repo.AddToWishlist(ctx, 1, 300)
+	assert.ErrorIs(t, err, domain.ErrWishlistFull)
+
+	assert.ErrorIs(t, repo.RemoveFromWishlist(ctx, 1, 300), domain.ErrNotInWishlist)
+	assert.NoError(t, repo.RemoveFromWishlist(ctx, 1, 100))
+
+	items, err := repo.GetWishlist(ctx, 1)
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, int64(200), items[0].SkuID)
+
+	skus, err := repo.WishlistedSkus(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{200}, skus)
+}
@@ -0,0 +1,185 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// FieldError describes why a single field is invalid.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Errors aggregates field errors of one request.
+type Errors []FieldError
+
+func (e Errors) Error() string {
+	parts := make([]string, 0, len(e))
+	for _, fe := range e {
+		parts = append(parts, fe.Field+": "+fe.Message)
+	}
+	return strings.Join(parts, "; ")
+}
+
+// Add appends an error for field.
+func (e *Errors) Add(field, format string, args ...any) {
+	*e = append(*e, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// Has reports whether field already has an error.
+func (e Errors) Has(field string) bool {
+	for _, fe := range e {
+		if fe.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
+// Struct checks v against the `validate` tags of its fields. Supported rules:
+//
+//	required  the value must not be zero
+//	min=N     numbers must be >= N, strings, slices and maps must have len >= N
+//	max=N     numbers must be <= N, strings, slices and maps must have len <= N
+//
+// Nested structs and slices of structs are validated too. Field names are
+// taken from the json or path tag.
+func Struct(v any) Errors {
+	var errs Errors
+	validateValue("", reflect.ValueOf(v), &errs)
+	return errs
+}
+
+func validateValue(prefix string, v reflect.Value, errs *Errors) {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := FieldName(field)
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+		value := v.Field(i)
+
+		if tag := field.Tag.Get("validate"); tag != "" {
+			for _, rule := range strings.Split(tag, ",") {
+				if msg := check(rule, value); msg != "" {
+					errs.Add(name, "%s", msg)
+					break
+				}
+			}
+		}
+
+		validateNested(name, value, errs)
+	}
+}
+
+func validateNested(name string, v reflect.Value, errs *Errors) {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		validateValue(name, v, errs)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			validateNested(fmt.Sprintf("%s[%d]", name, i), v.Index(i), errs)
+		}
+	}
+}
+
+// FieldName returns the external name of a field.
+func FieldName(field reflect.StructField) string {
+	for _, key := range []string{"json", "path", "query", "header"} {
+		if name, _, _ := strings.Cut(field.Tag.Get(key), ","); name != "" && name != "-" {
+			return name
+		}
+	}
+	return field.Name
+}
+
+func check(rule string, v reflect.Value) string {
+	key, arg, _ := strings.Cut(strings.TrimSpace(rule), "=")
+
+	switch key {
+	case "required":
+		if v.IsZero() {
+			return "is required"
+		}
+		return ""
+	case "min", "max":
+	default:
+		panic(fmt.Sprintf("validator: unknown rule %q", rule))
+	}
+
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		bound := mustParseInt(rule, arg)
+		if key == "min" && v.Int() < bound {
+			return fmt.Sprintf("must be at least %d", bound)
+		}
+		if key == "max" && v.Int() > bound {
+			return fmt.Sprintf("must be at most %d", bound)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		bound := uint64(mustParseInt(rule, arg))
+		if key == "min" && v.Uint() < bound {
+			return fmt.Sprintf("must be at least %d", bound)
+		}
+		if key == "max" && v.Uint() > bound {
+			return fmt.Sprintf("must be at most %d", bound)
+		}
+	case reflect.String:
+		bound := int(mustParseInt(rule, arg))
+		if key == "min" && len(v.String()) < bound {
+			return fmt.Sprintf("must be at least %d characters long", bound)
+		}
+		if key == "max" && len(v.String()) > bound {
+			return fmt.Sprintf("must be at most %d characters long", bound)
+		}
+	case reflect.Slice, reflect.Map, reflect.Array:
+		bound := int(mustParseInt(rule, arg))
+		if key == "min" && v.Len() < bound {
+			return fmt.Sprintf("must have at least %d elements", bound)
+		}
+		if key == "max" && v.Len() > bound {
+			return fmt.Sprintf("must have at most %d elements", bound)
+		}
+	}
+
+	return ""
+}
+
+func mustParseInt(rule, arg string) int64 {
+	n, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		panic(fmt.Sprintf("validator: bad argument in rule %q", rule))
+	}
+	return n
+}
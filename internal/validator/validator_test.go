@@ -0,0 +1,59 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type item struct {
+	Sku   int64  `json:"sku" validate:"min=1"`
+	Count uint16 `json:"count" validate:"min=1,max=10"`
+}
+
+type request struct {
+	UserID uint64  `path:"user_id" validate:"min=1"`
+	Name   string  `json:"name" validate:"required,max=5"`
+	Items  []item  `json:"items" validate:"min=1"`
+	Note   *string `json:"note" validate:"max=3"`
+}
+
+func TestStruct(t *testing.T) {
+	long := "longer"
+
+	tests := []struct {
+		name     string
+		value    request
+		expected Errors
+	}{
+		{
+			name:  "Valid request - no errors",
+			value: request{UserID: 1, Name: "home", Items: []item{{Sku: 1, Count: 2}}},
+		},
+		{
+			name:  "Every rule broken - all fields reported",
+			value: request{UserID: 0, Name: "", Items: nil, Note: &long},
+			expected: Errors{
+				{Field: "user_id", Message: "must be at least 1"},
+				{Field: "name", Message: "is required"},
+				{Field: "items", Message: "must have at least 1 elements"},
+				{Field: "note", Message: "must be at most 3 characters long"},
+			},
+		},
+		{
+			name:  "Nested items - indexed field names",
+			value: request{UserID: 1, Name: "office", Items: []item{{Sku: 1, Count: 1}, {Sku: 0, Count: 11}}},
+			expected: Errors{
+				{Field: "name", Message: "must be at most 5 characters long"},
+				{Field: "items[1].sku", Message: "must be at least 1"},
+				{Field: "items[1].count", Message: "must be at most 10"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Struct(&tt.value))
+		})
+	}
+}
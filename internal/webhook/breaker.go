@@ -0,0 +1,66 @@
+package webhook
+
+import (
+	"sync"
+	"time"
+)
+
+// breaker opens after threshold consecutive failures of an endpoint. While
+// open, deliveries wait; after cooldown a single trial is let through and its
+// result closes or reopens the breaker.
+type breaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	openUntil time.Time
+	trial     bool
+}
+
+func newBreaker(threshold int, cooldown time.Duration) *breaker {
+	return &breaker{threshold: threshold, cooldown: cooldown}
+}
+
+// allow reports whether a delivery may be attempted at now, and if not, when
+// to try again.
+func (b *breaker) allow(now time.Time) (bool, time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.threshold {
+		return true, time.Time{}
+	}
+	if now.Before(b.openUntil) || b.trial {
+		return false, maxTime(b.openUntil, now.Add(b.cooldown/4))
+	}
+	b.trial = true
+	return true, time.Time{}
+}
+
+func (b *breaker) record(ok bool, now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.trial = false
+	if ok {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openUntil = now.Add(b.cooldown)
+	}
+}
+
+func (b *breaker) open() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.failures >= b.threshold
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
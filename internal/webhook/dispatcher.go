@@ -0,0 +1,262 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/vestamart/cart/internal/domain"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Event is the JSON body posted to webhook endpoints.
+type Event struct {
+	ID   string    `json:"id"`
+	Type string    `json:"type"`
+	Time time.Time `json:"time"`
+	Data any       `json:"data"`
+}
+
+type Config struct {
+	Timeout          time.Duration
+	MaxAttempts      int
+	Backoff          time.Duration
+	MaxBackoff       time.Duration
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+	QueueSize        int
+	Workers          int
+}
+
+type job struct {
+	sub     Subscription
+	event   Event
+	body    []byte
+	attempt int
+}
+
+// Dispatcher delivers events to the matching subscriptions. Each delivery is
+// retried with exponential backoff; an endpoint that keeps failing trips its
+// circuit breaker, which holds its deliveries back until it recovers.
+type Dispatcher struct {
+	registry *Registry
+	log      *DeliveryLog
+	client   *http.Client
+	cfg      Config
+	queue    chan job
+	now      func() time.Time
+
+	mu       sync.Mutex
+	breakers map[string]*breaker
+}
+
+func NewDispatcher(registry *Registry, deliveries *DeliveryLog, cfg Config) *Dispatcher {
+	return &Dispatcher{
+		registry: registry,
+		log:      deliveries,
+		client:   &http.Client{Timeout: cfg.Timeout},
+		cfg:      cfg,
+		queue:    make(chan job, cfg.QueueSize),
+		now:      time.Now,
+		breakers: make(map[string]*breaker),
+	}
+}
+
+// Publish implements cart.Publisher and triggers checkout webhooks.
+func (d *Dispatcher) Publish(event domain.CartEvent) {
+	if event.Type != domain.EventCheckout {
+		return
+	}
+	d.Dispatch(EventCheckout, event)
+}
+
+// NotifyAbandoned triggers cart_abandoned webhooks.
+func (d *Dispatcher) NotifyAbandoned(_ context.Context, cart domain.AbandonedCart) {
+	d.Dispatch(EventCartAbandoned, cart)
+}
+
+// NotifyBackInStock triggers back_in_stock webhooks.
+func (d *Dispatcher) NotifyBackInStock(_ context.Context, event domain.BackInStock) {
+	d.Dispatch(EventBackInStock, event)
+}
+
+// Dispatch queues data as an event of type for every subscription that
+// wants it. It never blocks: if the queue is full the delivery is logged as
+// dropped.
+func (d *Dispatcher) Dispatch(eventType string, data any) {
+	event := Event{ID: randomHex(8), Type: eventType, Time: d.now(), Data: data}
+	body, err := json.Marshal(event)
+	if err != nil {
+		slog.Error("webhook: marshal event", "type", eventType, "err", err)
+		return
+	}
+
+	for _, sub := range d.registry.List() {
+		if sub.Wants(eventType) {
+			d.enqueue(job{sub: sub, event: event, body: body, attempt: 1})
+		}
+	}
+}
+
+// Run delivers queued events with cfg.Workers workers until ctx is done.
+func (d *Dispatcher) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i := 0; i < d.cfg.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case j := <-d.queue:
+					d.deliver(ctx, j)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// DeleteSubscription deletes a subscription and its circuit breaker. Its
+// queued deliveries are dropped when their turn comes.
+func (d *Dispatcher) DeleteSubscription(id string) error {
+	if err := d.registry.Delete(id); err != nil {
+		return err
+	}
+	d.mu.Lock()
+	delete(d.breakers, id)
+	d.mu.Unlock()
+	return nil
+}
+
+// Open reports whether the circuit breaker of a subscription is open.
+func (d *Dispatcher) Open(subscriptionID string) bool {
+	d.mu.Lock()
+	b, ok := d.breakers[subscriptionID]
+	d.mu.Unlock()
+	return ok && b.open()
+}
+
+func (d *Dispatcher) enqueue(j job) {
+	select {
+	case d.queue <- j:
+		return
+	default:
+	}
+	slog.Warn("webhook: queue full, delivery dropped", "type", j.event.Type, "subscription", j.sub.ID)
+	d.log.Add(Delivery{
+		ID: randomHex(8), SubscriptionID: j.sub.ID, Event: j.event.Type, EventID: j.event.ID,
+		Attempt: j.attempt, Error: "dropped: queue full", Time: d.now(),
+	})
+}
+
+// later queues j again after delay, unless the dispatcher stops first.
+func (d *Dispatcher) later(ctx context.Context, j job, delay time.Duration) {
+	time.AfterFunc(delay, func() {
+		if ctx.Err() == nil {
+			d.enqueue(j)
+		}
+	})
+}
+
+// hold queues j again when the open breaker of its subscription lets
+// deliveries through. Holding uses up an attempt, so that a dead endpoint
+// does not keep timers and jobs alive for as long as events come in; the
+// last attempt is logged as dropped.
+func (d *Dispatcher) hold(ctx context.Context, j job, retryAt time.Time) {
+	if j.attempt >= d.cfg.MaxAttempts {
+		slog.Warn("webhook: circuit open, delivery dropped", "type", j.event.Type, "subscription", j.sub.ID, "attempts", j.attempt)
+		d.log.Add(Delivery{
+			ID: randomHex(8), SubscriptionID: j.sub.ID, Event: j.event.Type, EventID: j.event.ID,
+			Attempt: j.attempt, Error: "dropped: circuit open", Time: d.now(),
+		})
+		return
+	}
+	j.attempt++
+	d.later(ctx, j, retryAt.Sub(d.now()))
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, j job) {
+	if _, err := d.registry.Get(j.sub.ID); err != nil {
+		return
+	}
+
+	b := d.breaker(j.sub.ID)
+	if ok, retryAt := b.allow(d.now()); !ok {
+		d.hold(ctx, j, retryAt)
+		return
+	}
+
+	start := d.now()
+	status, err := d.post(ctx, j)
+	entry := Delivery{
+		ID:             randomHex(8),
+		SubscriptionID: j.sub.ID,
+		Event:          j.event.Type,
+		EventID:        j.event.ID,
+		Attempt:        j.attempt,
+		StatusCode:     status,
+		Success:        err == nil,
+		DurationMs:     d.now().Sub(start).Milliseconds(),
+		Time:           start,
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	d.log.Add(entry)
+	b.record(err == nil, d.now())
+
+	if err != nil && j.attempt < d.cfg.MaxAttempts {
+		delay := d.backoff(j.attempt)
+		j.attempt++
+		d.later(ctx, j, delay)
+	}
+}
+
+func (d *Dispatcher) post(ctx context.Context, j job) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, j.sub.URL, bytes.NewReader(j.body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, j.event.Type)
+	req.Header.Set(DeliveryHeader, j.event.ID)
+	req.Header.Set(SignatureHeader, Sign(j.sub.Secret, d.now(), j.body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("endpoint answered %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+func (d *Dispatcher) breaker(subscriptionID string) *breaker {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	b, ok := d.breakers[subscriptionID]
+	if !ok {
+		b = newBreaker(d.cfg.BreakerThreshold, d.cfg.BreakerCooldown)
+		d.breakers[subscriptionID] = b
+	}
+	return b
+}
+
+func (d *Dispatcher) backoff(attempt int) time.Duration {
+	delay := d.cfg.Backoff
+	for i := 1; i < attempt && delay < d.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, d.cfg.MaxBackoff)
+}
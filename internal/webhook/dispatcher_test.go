@@ -0,0 +1,135 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vestamart/cart/internal/domain"
+)
+
+func testConfig() Config {
+	return Config{
+		Timeout:          time.Second,
+		MaxAttempts:      3,
+		Backoff:          time.Millisecond,
+		MaxBackoff:       10 * time.Millisecond,
+		BreakerThreshold: 10,
+		BreakerCooldown:  time.Minute,
+		QueueSize:        10,
+		Workers:          1,
+	}
+}
+
+func TestDispatcher_SignsAndRetries(t *testing.T) {
+	var calls atomic.Int32
+	received := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		received <- r
+		bodies <- body
+	}))
+	defer srv.Close()
+
+	registry := NewRegistry()
+	sub, err := registry.Create(srv.URL, []string{EventCheckout}, "s3cret")
+	require.NoError(t, err)
+	_, err = registry.Create(srv.URL, []string{EventCartAbandoned}, "")
+	require.NoError(t, err)
+
+	deliveries := NewDeliveryLog(10)
+	d := NewDispatcher(registry, deliveries, testConfig())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go d.Run(ctx)
+
+	d.Publish(domain.CartEvent{Type: domain.EventItemAdded, UserID: 1})
+	d.Publish(domain.CartEvent{Type: domain.EventCheckout, UserID: 1, OrderID: 77})
+
+	select {
+	case r := <-received:
+		body := <-bodies
+		assert.Equal(t, EventCheckout, r.Header.Get(EventHeader))
+		assert.True(t, Verify("s3cret", r.Header.Get(SignatureHeader), body, time.Now(), time.Minute))
+		assert.False(t, Verify("other", r.Header.Get(SignatureHeader), body, time.Now(), time.Minute))
+		assert.Contains(t, string(body), `"order_id":77`)
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook was not delivered")
+	}
+
+	require.Eventually(t, func() bool { return len(deliveries.List(sub.ID)) == 3 }, time.Second, 5*time.Millisecond)
+	log := deliveries.List(sub.ID)
+	assert.True(t, log[0].Success)
+	assert.Equal(t, 3, log[0].Attempt)
+	assert.Equal(t, http.StatusServiceUnavailable, log[2].StatusCode)
+}
+
+func TestBreaker(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	b := newBreaker(2, time.Minute)
+
+	b.record(false, now)
+	ok, _ := b.allow(now)
+	assert.True(t, ok, "closed below the threshold")
+
+	b.record(false, now)
+	ok, retryAt := b.allow(now)
+	assert.False(t, ok, "open after the threshold")
+	assert.Equal(t, now.Add(time.Minute), retryAt)
+
+	now = now.Add(time.Minute)
+	ok, _ = b.allow(now)
+	assert.True(t, ok, "a trial after the cooldown")
+	ok, _ = b.allow(now)
+	assert.False(t, ok, "only one trial at a time")
+
+	b.record(true, now)
+	ok, _ = b.allow(now)
+	assert.True(t, ok, "closed after a successful trial")
+}
+
+func TestDeliveryLog_Bounded(t *testing.T) {
+	l := NewDeliveryLog(2)
+	for i := 1; i <= 3; i++ {
+		l.Add(Delivery{SubscriptionID: "a", Attempt: i})
+	}
+
+	got := l.List("a")
+	require.Len(t, got, 2)
+	assert.Equal(t, 3, got[0].Attempt)
+	assert.Equal(t, 2, got[1].Attempt)
+}
+
+func TestDispatcher_HeldDeliveriesUseAttempts(t *testing.T) {
+	registry := NewRegistry()
+	sub, err := registry.Create("https://partner.example.com/hooks", []string{EventCheckout}, "")
+	require.NoError(t, err)
+	deliveries := NewDeliveryLog(10)
+	cfg := testConfig()
+	cfg.BreakerThreshold = 1
+	d := NewDispatcher(registry, deliveries, cfg)
+	d.breaker(sub.ID).record(false, time.Now())
+	require.True(t, d.Open(sub.ID))
+
+	d.deliver(context.Background(), job{sub: sub, event: Event{ID: "e1", Type: EventCheckout}, attempt: cfg.MaxAttempts})
+
+	log := deliveries.List(sub.ID)
+	require.Len(t, log, 1)
+	assert.Equal(t, "dropped: circuit open", log[0].Error)
+	assert.Equal(t, cfg.MaxAttempts, log[0].Attempt)
+
+	require.NoError(t, d.DeleteSubscription(sub.ID))
+	assert.Empty(t, d.breakers)
+	assert.ErrorIs(t, d.DeleteSubscription(sub.ID), ErrSubscriptionNotFound)
+}
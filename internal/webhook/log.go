@@ -0,0 +1,59 @@
+package webhook
+
+import (
+	"sync"
+	"time"
+)
+
+// Delivery is one attempt to deliver an event to a subscription.
+type Delivery struct {
+	ID             string    `json:"id"`
+	SubscriptionID string    `json:"subscription_id"`
+	Event          string    `json:"event"`
+	EventID        string    `json:"event_id"`
+	Attempt        int       `json:"attempt"`
+	StatusCode     int       `json:"status_code,omitempty"`
+	Error          string    `json:"error,omitempty"`
+	Success        bool      `json:"success"`
+	DurationMs     int64     `json:"duration_ms"`
+	Time           time.Time `json:"time"`
+}
+
+// DeliveryLog keeps the last size deliveries.
+type DeliveryLog struct {
+	mu      sync.Mutex
+	entries []Delivery
+	next    int
+	size    int
+}
+
+func NewDeliveryLog(size int) *DeliveryLog {
+	return &DeliveryLog{entries: make([]Delivery, 0, size), size: size}
+}
+
+func (l *DeliveryLog) Add(d Delivery) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.entries) < l.size {
+		l.entries = append(l.entries, d)
+		return
+	}
+	l.entries[l.next] = d
+	l.next = (l.next + 1) % l.size
+}
+
+// List returns the deliveries of subscriptionID, newest first.
+func (l *DeliveryLog) List(subscriptionID string) []Delivery {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	out := make([]Delivery, 0)
+	for i := len(l.entries) - 1; i >= 0; i-- {
+		d := l.entries[(l.next+i)%len(l.entries)]
+		if d.SubscriptionID == subscriptionID {
+			out = append(out, d)
+		}
+	}
+	return out
+}
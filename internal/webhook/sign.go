@@ -0,0 +1,56 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	SignatureHeader = "X-Cart-Signature"
+	EventHeader     = "X-Cart-Event"
+	DeliveryHeader  = "X-Cart-Delivery"
+)
+
+// Sign returns the signature header value for body sent at ts:
+// "t=<unix seconds>,v1=<hex HMAC-SHA256 of "<t>.<body>">". The timestamp is
+// signed too, so receivers can reject replays.
+func Sign(secret string, ts time.Time, body []byte) string {
+	t := strconv.FormatInt(ts.Unix(), 10)
+	return "t=" + t + ",v1=" + hex.EncodeToString(mac(secret, t, body))
+}
+
+// Verify checks a signature header produced by Sign and that it is not older
+// than tolerance.
+func Verify(secret, header string, body []byte, now time.Time, tolerance time.Duration) bool {
+	var t, v1 string
+	for _, part := range strings.Split(header, ",") {
+		k, v, _ := strings.Cut(part, "=")
+		switch k {
+		case "t":
+			t = v
+		case "v1":
+			v1 = v
+		}
+	}
+	unix, err := strconv.ParseInt(t, 10, 64)
+	if err != nil || now.Sub(time.Unix(unix, 0)) > tolerance {
+		return false
+	}
+	sig, err := hex.DecodeString(v1)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(sig, mac(secret, t, body))
+}
+
+func mac(secret, t string, body []byte) []byte {
+	m := hmac.New(sha256.New, []byte(secret))
+	m.Write([]byte(t))
+	m.Write([]byte("."))
+	m.Write(body)
+	return m.Sum(nil)
+}
@@ -0,0 +1,120 @@
+package webhook
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"github.com/vestamart/cart/internal/localErr"
+	"net/url"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	EventCheckout      = "checkout"
+	EventCartAbandoned = "cart_abandoned"
+	EventBackInStock   = "back_in_stock"
+)
+
+var ErrSubscriptionNotFound = localErr.New(localErr.KindNotFound, "webhook_not_found", "webhook subscription not found")
+
+// Subscription is a partner endpoint and the events it wants.
+type Subscription struct {
+	ID        string    `json:"id"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	Secret    string    `json:"-"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (s Subscription) Wants(event string) bool {
+	return slices.Contains(s.Events, event)
+}
+
+// Registry keeps the webhook subscriptions in memory.
+type Registry struct {
+	mu   sync.RWMutex
+	subs map[string]Subscription
+}
+
+func NewRegistry() *Registry {
+	return &Registry{subs: make(map[string]Subscription)}
+}
+
+// Create validates and stores a subscription. A secret is generated if none
+// is given; the stored subscription is returned with its secret.
+func (r *Registry) Create(rawURL string, events []string, secret string) (Subscription, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return Subscription{}, localErr.ErrInvalidArgument.WithMsg("url: %q is not an http(s) URL", rawURL)
+	}
+	for _, e := range events {
+		if e != EventCheckout && e != EventCartAbandoned && e != EventBackInStock {
+			return Subscription{}, localErr.ErrInvalidArgument.WithMsg("events: unknown event %q", e)
+		}
+	}
+	if secret == "" {
+		secret = randomHex(32)
+	}
+
+	sub := Subscription{
+		ID:        randomHex(8),
+		URL:       u.String(),
+		Events:    slices.Compact(slices.Sorted(slices.Values(events))),
+		Secret:    secret,
+		CreatedAt: time.Now(),
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.subs[sub.ID] = sub
+
+	return sub, nil
+}
+
+func (r *Registry) Get(id string) (Subscription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	sub, ok := r.subs[id]
+	if !ok {
+		return Subscription{}, ErrSubscriptionNotFound
+	}
+	return sub, nil
+}
+
+// List returns the subscriptions ordered by creation time.
+func (r *Registry) List() []Subscription {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	subs := make([]Subscription, 0, len(r.subs))
+	for _, sub := range r.subs {
+		subs = append(subs, sub)
+	}
+	sort.Slice(subs, func(i, j int) bool {
+		if subs[i].CreatedAt.Equal(subs[j].CreatedAt) {
+			return subs[i].ID < subs[j].ID
+		}
+		return subs[i].CreatedAt.Before(subs[j].CreatedAt)
+	})
+	return subs
+}
+
+func (r *Registry) Delete(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.subs[id]; !ok {
+		return ErrSubscriptionNotFound
+	}
+	delete(r.subs, id)
+	return nil
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}